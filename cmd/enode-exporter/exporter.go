@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/devices"
+	"github.com/addihorn/enode-gosdk/pkg/metrics"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/users"
+)
+
+// LABELS are the labels of the device gauges.
+var LABELS = []string{"user_id", "device_id", "type", "vendor"}
+
+// exporter polls the devices of users and keeps their state in gauges.
+type exporter struct {
+	sess *session.Session
+	// users are the users polled, all users of the client if empty
+	users  []string
+	stderr io.Writer
+
+	reachable    *metrics.GaugeVec
+	batteryLevel *metrics.GaugeVec
+	chargeRate   *metrics.GaugeVec
+	rangeKm      *metrics.GaugeVec
+	pluggedIn    *metrics.GaugeVec
+	production   *metrics.GaugeVec
+	power        *metrics.GaugeVec
+
+	lastPoll *metrics.GaugeVec
+	duration *metrics.GaugeVec
+	failures *metrics.CounterVec
+
+	// series holds the label values set by the previous poll per gauge, so
+	// the gauges of devices which are gone can be removed
+	series map[*metrics.GaugeVec]map[string][]string
+}
+
+func newExporter(sess *session.Session, userIds []string, registry *metrics.Registry, stderr io.Writer) *exporter {
+	return &exporter{
+		sess:   sess,
+		users:  userIds,
+		stderr: stderr,
+
+		reachable:    registry.Gauge("enode_device_reachable", "Whether the device is reachable by its vendor, 1 or 0.", LABELS...),
+		batteryLevel: registry.Gauge("enode_device_battery_level_percent", "Battery level of vehicles and batteries in percent.", LABELS...),
+		chargeRate:   registry.Gauge("enode_device_charge_rate_kw", "Charge rate of vehicles, chargers and batteries in kW.", LABELS...),
+		rangeKm:      registry.Gauge("enode_vehicle_range_km", "Estimated range of vehicles in km.", LABELS...),
+		pluggedIn:    registry.Gauge("enode_device_plugged_in", "Whether a vehicle is plugged into a charger, 1 or 0.", LABELS...),
+		production:   registry.Gauge("enode_inverter_production_kw", "Production rate of inverters in kW.", LABELS...),
+		power:        registry.Gauge("enode_meter_power_kw", "Power measured by meters in kW, negative when exporting to the grid.", LABELS...),
+
+		lastPoll: registry.Gauge("enode_exporter_last_poll_timestamp_seconds", "Unix time of the last completed poll."),
+		duration: registry.Gauge("enode_exporter_poll_duration_seconds", "Duration of the last poll."),
+		failures: registry.Counter("enode_exporter_poll_errors_total", "Users whose devices could not be listed completely.", "user_id"),
+
+		series: map[*metrics.GaugeVec]map[string][]string{},
+	}
+}
+
+// poll updates the gauges with the current state of the devices of all users.
+// The gauges of users whose devices could not be listed completely keep their
+// previous values.
+func (e *exporter) poll(ctx context.Context) error {
+	start := time.Now()
+	userIds, err := e.userIds(ctx)
+	if err != nil {
+		return err
+	}
+
+	current := map[*metrics.GaugeVec]map[string][]string{}
+	failed := map[string]bool{}
+	for _, userId := range userIds {
+		list, err := devices.ListUserDevices(ctx, e.sess, userId)
+		if err != nil {
+			failed[userId] = true
+			e.failures.Inc(userId)
+			fmt.Fprintf(e.stderr, "enode-exporter: user %s: %s\n", userId, err)
+		}
+		for _, device := range list {
+			e.record(current, userId, device)
+		}
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	for gauge, previous := range e.series {
+		if current[gauge] == nil {
+			current[gauge] = map[string][]string{}
+		}
+		for key, labels := range previous {
+			if _, ok := current[gauge][key]; ok {
+				continue
+			}
+			if failed[labels[0]] {
+				current[gauge][key] = labels
+				continue
+			}
+			gauge.Delete(labels...)
+		}
+	}
+	e.series = current
+
+	e.lastPoll.Set(float64(time.Now().Unix()))
+	e.duration.Set(time.Since(start).Seconds())
+	return nil
+}
+
+// userIds returns the configured users, or all users of the client if none are configured.
+func (e *exporter) userIds(ctx context.Context) ([]string, error) {
+	if len(e.users) > 0 {
+		return e.users, nil
+	}
+	var all []string
+	err := users.ListUserPages(ctx, e.sess, 0, func(page []models.UsersListEntry) error {
+		for _, user := range page {
+			all = append(all, user.Id)
+		}
+		return nil
+	})
+	return all, err
+}
+
+// record sets the gauges of a device of the user.
+func (e *exporter) record(current map[*metrics.GaugeVec]map[string][]string, userId string, device models.Device) {
+	labels := []string{userId, device.DeviceId(), string(device.DeviceVendorType()), device.DeviceVendor()}
+	set := func(gauge *metrics.GaugeVec, value *float64) {
+		if value == nil {
+			return
+		}
+		gauge.Set(*value, labels...)
+		if current[gauge] == nil {
+			current[gauge] = map[string][]string{}
+		}
+		current[gauge][strings.Join(labels, "\xff")] = labels
+	}
+
+	set(e.reachable, boolean(device.DeviceIsReachable()))
+	switch d := device.(type) {
+	case models.VehicleWithLocation:
+		set(e.batteryLevel, d.ChargeState.BatteryLevel)
+		set(e.chargeRate, d.ChargeState.ChargeRate)
+		set(e.rangeKm, d.ChargeState.Range)
+		set(e.pluggedIn, optionalBoolean(d.ChargeState.IsPluggedIn))
+	case models.Charger:
+		set(e.chargeRate, d.ChargeState.ChargeRate)
+		set(e.pluggedIn, optionalBoolean(d.ChargeState.IsPluggedIn))
+	case models.Battery:
+		set(e.batteryLevel, d.ChargeState.BatteryLevel)
+		set(e.chargeRate, d.ChargeState.ChargeRate)
+	case models.Inverter:
+		set(e.production, d.ProductionState.ProductionRate)
+	case models.Meter:
+		set(e.power, d.EnergyState.Power)
+	}
+}
+
+func boolean(value bool) *float64 {
+	result := 0.0
+	if value {
+		result = 1
+	}
+	return &result
+}
+
+func optionalBoolean(value *bool) *float64 {
+	if value == nil {
+		return nil
+	}
+	return boolean(*value)
+}
+
+// run polls every interval until ctx is done.
+func (e *exporter) run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := e.poll(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			fmt.Fprintf(e.stderr, "enode-exporter: %s\n", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Command enode-exporter exposes the state of the devices of an Enode client
+// as Prometheus metrics, e.g. for Grafana dashboards of a fleet.
+//
+// It lists the vehicles, chargers, HVACs, batteries, inverters and meters of
+// the configured users every interval and serves gauges of their battery
+// level, charge rate, range, production, power and reachability on /metrics,
+// together with the request metrics of the SDK.
+//
+// The client is configured like for the enode command, from the environment
+// or a .env file in the working directory:
+//
+//	ENODE_ENVIRONMENT      SANDBOX, PRODUCTION or the URL of the API
+//	ENODE_CLIENT_ID        the client ID
+//	ENODE_CLIENT_SECRET    the client secret
+//	ENODE_PROFILE          profile to use, read from ENODE_PROFILE_<NAME>_* variables
+//	ENODE_PROFILES         JSON file of profiles, see package profiles
+//	ENODE_EXPORTER_USERS   comma separated IDs of the users to poll, all users if empty
+//	ENODE_RATE_LIMIT       quota of the client, e.g. 300/1m, the rate limit of the profile if empty
+//
+// Usage:
+//
+//	enode-exporter [-listen :9464] [-interval 1m] [-profile <name>] [-user <userId>]...
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/metrics"
+	"github.com/addihorn/enode-gosdk/pkg/profiles"
+	"github.com/addihorn/enode-gosdk/pkg/ratelimit"
+	"github.com/addihorn/enode-gosdk/pkg/retry"
+	"github.com/joho/godotenv"
+)
+
+const (
+	DEFAULT_LISTEN   = ":9464"
+	DEFAULT_INTERVAL = time.Minute
+)
+
+func main() {
+	// a .env file is optional, the environment may hold the configuration
+	godotenv.Load()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	err := run(ctx, os.Args[1:], os.Getenv, os.Stderr, nil)
+	stop()
+
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "enode-exporter: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// userList is a flag which may be given several times.
+type userList []string
+
+func (l *userList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *userList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+/*
+Runs the exporter until ctx is done.
+
+Parameters:
+  - ctx: Stops the exporter when done.
+  - args: The command line arguments.
+  - getenv: Looks up environment variables.
+  - stderr: Receives the errors of polls.
+  - ready: Called with the address the metrics are served on, if not nil.
+
+Returns:
+  - An error if the configuration is invalid or the client could not be authenticated.
+*/
+func run(ctx context.Context, args []string, getenv func(string) string, stderr io.Writer, ready func(addr string)) error {
+	fs := flag.NewFlagSet("enode-exporter", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	listen := fs.String("listen", DEFAULT_LISTEN, "address to serve /metrics on")
+	interval := fs.Duration("interval", DEFAULT_INTERVAL, "time between two polls of the devices")
+	profileName := fs.String("profile", "", "profile to use, ENODE_PROFILE if empty")
+	rateLimit := fs.String("rate-limit", getenv("ENODE_RATE_LIMIT"), "quota of the client, e.g. 300/1m or 300/1m,10 with a burst, the rate limit of the profile if empty")
+	var userIds userList
+	fs.Var(&userIds, "user", "ID of a user to poll, may be given several times, ENODE_EXPORTER_USERS if not given")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *interval <= 0 {
+		return fmt.Errorf("invalid interval %s", *interval)
+	}
+	if len(userIds) == 0 {
+		for _, userId := range strings.Split(getenv("ENODE_EXPORTER_USERS"), ",") {
+			if userId = strings.TrimSpace(userId); userId != "" {
+				userIds = append(userIds, userId)
+			}
+		}
+	}
+
+	name := *profileName
+	if name == "" {
+		name = getenv("ENODE_PROFILE")
+	}
+	var profile *profiles.Profile
+	if path := getenv("ENODE_PROFILES"); path != "" {
+		all, err := profiles.Load(path)
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			name = profiles.DEFAULT_PROFILE
+		}
+		if profile = all[name]; profile == nil {
+			return fmt.Errorf("unknown profile %q in %s", name, path)
+		}
+	} else {
+		var err error
+		if profile, err = profiles.FromEnv(name, getenv); err != nil {
+			return err
+		}
+	}
+
+	registry := metrics.NewRegistry()
+	recorder := metrics.NewPrometheus(registry)
+	if *rateLimit != "" {
+		profile.RateLimit = *rateLimit
+	}
+	retrying := retry.NewTransport(metrics.NewTransport(nil, recorder))
+	retrying.OnRetry = metrics.OnRetry(recorder)
+	sess, err := profile.Session(&http.Client{Transport: retrying})
+	if err != nil {
+		return err
+	}
+	if limited, ok := sess.HttpClient.Transport.(*ratelimit.Transport); ok {
+		limited.Limiter.OnWait = recorder.RateLimitWait
+	}
+
+	listener, err := net.Listen("tcp", *listen)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", registry)
+	server := &http.Server{Handler: mux}
+	go server.Serve(listener)
+	defer server.Close()
+	if ready != nil {
+		ready(listener.Addr().String())
+	}
+
+	return newExporter(sess, userIds, registry, stderr).run(ctx, *interval)
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/enodetest"
+	"github.com/addihorn/enode-gosdk/pkg/metrics"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+const emptyPage = `{"data":[],"pagination":{"after":null,"before":null}}`
+
+func scrape(t *testing.T, registry *metrics.Registry) string {
+	t.Helper()
+	var out bytes.Buffer
+	if _, err := registry.WriteTo(&out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return out.String()
+}
+
+func TestExporter_Poll(t *testing.T) {
+	// the responses per path, changed between polls
+	var mu sync.Mutex
+	responses := map[string]string{
+		"/users/user_1/vehicles":  `{"data":[{"id":"vehicle_1","userId":"user_1","vendor":"TESLA","isReachable":true,"chargeState":{"batteryLevel":80,"range":310.5,"isPluggedIn":true,"chargeRate":11}}],"pagination":{"after":null,"before":null}}`,
+		"/users/user_1/chargers":  `{"data":[{"id":"charger_1","userId":"user_1","vendor":"ZAPTEC","isReachable":false,"chargeState":{"isPluggedIn":false,"chargeRate":null}}],"pagination":{"after":null,"before":null}}`,
+		"/users/user_1/hvacs":     emptyPage,
+		"/users/user_1/batteries": `{"data":[{"id":"battery_1","userId":"user_1","vendor":"TESLA","isReachable":true,"chargeState":{"batteryLevel":55,"chargeRate":-2.5}}],"pagination":{"after":null,"before":null}}`,
+		"/users/user_1/inverters": `{"data":[{"id":"inverter_1","userId":"user_1","vendor":"SMA","isReachable":true,"productionState":{"productionRate":4.2}}],"pagination":{"after":null,"before":null}}`,
+		"/users/user_1/meters":    `{"data":[{"id":"meter_1","userId":"user_1","vendor":"TIBBER","isReachable":true,"energyState":{"power":-1.5}}],"pagination":{"after":null,"before":null}}`,
+		"/users/user_2/vehicles":  `{"data":[{"id":"vehicle_2","userId":"user_2","vendor":"AUDI","isReachable":true,"chargeState":{"batteryLevel":40}}],"pagination":{"after":null,"before":null}}`,
+		"/users/user_2/chargers":  emptyPage,
+		"/users/user_2/hvacs":     emptyPage,
+		"/users/user_2/batteries": emptyPage,
+		"/users/user_2/inverters": emptyPage,
+		"/users/user_2/meters":    emptyPage,
+		"/users":                  `{"data":[{"id":"user_1"},{"id":"user_2"}],"pagination":{"after":null,"before":null}}`,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		body, ok := responses[r.URL.Path]
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"type":"https://developers.enode.com/api/problems/server-error","title":"Server Error"}`)
+			return
+		}
+		fmt.Fprint(w, body)
+	}))
+	defer ts.Close()
+
+	sess := session.NewSession(&auth.Authentication{Environment: ts.URL, Access_token: "test_token"})
+	registry := metrics.NewRegistry()
+	var stderr bytes.Buffer
+	e := newExporter(sess, nil, registry, &stderr)
+
+	if err := e.poll(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	out := scrape(t, registry)
+	for _, expected := range []string{
+		`enode_device_reachable{user_id="user_1",device_id="vehicle_1",type="vehicle",vendor="TESLA"} 1`,
+		`enode_device_reachable{user_id="user_1",device_id="charger_1",type="charger",vendor="ZAPTEC"} 0`,
+		`enode_device_battery_level_percent{user_id="user_1",device_id="vehicle_1",type="vehicle",vendor="TESLA"} 80`,
+		`enode_device_battery_level_percent{user_id="user_1",device_id="battery_1",type="battery",vendor="TESLA"} 55`,
+		`enode_device_charge_rate_kw{user_id="user_1",device_id="battery_1",type="battery",vendor="TESLA"} -2.5`,
+		`enode_vehicle_range_km{user_id="user_1",device_id="vehicle_1",type="vehicle",vendor="TESLA"} 310.5`,
+		`enode_device_plugged_in{user_id="user_1",device_id="vehicle_1",type="vehicle",vendor="TESLA"} 1`,
+		`enode_device_plugged_in{user_id="user_1",device_id="charger_1",type="charger",vendor="ZAPTEC"} 0`,
+		`enode_inverter_production_kw{user_id="user_1",device_id="inverter_1",type="inverter",vendor="SMA"} 4.2`,
+		`enode_meter_power_kw{user_id="user_1",device_id="meter_1",type="meter",vendor="TIBBER"} -1.5`,
+		`enode_device_battery_level_percent{user_id="user_2",device_id="vehicle_2",type="vehicle",vendor="AUDI"} 40`,
+	} {
+		if !strings.Contains(out, expected+"\n") {
+			t.Errorf("Expected %s in\n%s", expected, out)
+		}
+	}
+	// unknown values are left out instead of reported as 0
+	if strings.Contains(out, `enode_device_charge_rate_kw{user_id="user_1",device_id="charger_1"`) {
+		t.Errorf("Expected no charge rate of the charger in\n%s", out)
+	}
+	if stderr.Len() != 0 {
+		t.Errorf("Unexpected errors %s", stderr.String())
+	}
+
+	// the battery is gone and the meters of user 2 fail to list
+	mu.Lock()
+	responses["/users/user_1/batteries"] = emptyPage
+	responses["/users/user_2/vehicles"] = `{"data":[{"id":"vehicle_2","userId":"user_2","vendor":"AUDI","isReachable":true,"chargeState":{"batteryLevel":45}}],"pagination":{"after":null,"before":null}}`
+	delete(responses, "/users/user_2/meters")
+	mu.Unlock()
+	if err := e.poll(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	out = scrape(t, registry)
+	if strings.Contains(out, `device_id="battery_1"`) {
+		t.Errorf("Expected the series of the removed battery to be deleted in\n%s", out)
+	}
+	if !strings.Contains(out, `enode_device_battery_level_percent{user_id="user_2",device_id="vehicle_2",type="vehicle",vendor="AUDI"} 45`+"\n") {
+		t.Errorf("Expected the listed devices of a failed user to be updated in\n%s", out)
+	}
+	if !strings.Contains(out, `enode_exporter_poll_errors_total{user_id="user_2"} 1`+"\n") || !strings.Contains(stderr.String(), "user user_2") {
+		t.Errorf("Expected the failed user to be reported, got %s\n%s", stderr.String(), out)
+	}
+
+	// user 2 fails completely, so its devices keep their previous values
+	mu.Lock()
+	delete(responses, "/users/user_2/vehicles")
+	mu.Unlock()
+	if err := e.poll(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	out = scrape(t, registry)
+	if !strings.Contains(out, `enode_device_battery_level_percent{user_id="user_2",device_id="vehicle_2",type="vehicle",vendor="AUDI"} 45`+"\n") {
+		t.Errorf("Expected the devices of a failed user to be kept in\n%s", out)
+	}
+}
+
+func TestRun(t *testing.T) {
+	server := enodetest.NewServer()
+	defer server.Close()
+	vehicle := server.AddVehicle("user_1", models.VehicleWithLocation{})
+	env := map[string]string{
+		"ENODE_ENVIRONMENT":    server.URL,
+		"ENODE_CLIENT_ID":      enodetest.CLIENT_ID,
+		"ENODE_CLIENT_SECRET":  enodetest.CLIENT_SECRET,
+		"ENODE_EXPORTER_USERS": " user_1 ,",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scraped := make(chan string, 1)
+	ready := func(addr string) {
+		go func() {
+			defer cancel()
+			// wait for the first poll to complete
+			for ctx.Err() == nil {
+				resp, err := http.Get("http://" + addr + "/metrics")
+				if err != nil {
+					scraped <- err.Error()
+					return
+				}
+				body, _ := io.ReadAll(resp.Body)
+				resp.Body.Close()
+				if strings.Contains(string(body), "enode_exporter_last_poll_timestamp_seconds ") {
+					scraped <- string(body)
+					return
+				}
+			}
+		}()
+	}
+
+	var stderr bytes.Buffer
+	if err := run(ctx, []string{"-listen", "127.0.0.1:0"}, func(key string) string { return env[key] }, &stderr, ready); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	out := <-scraped
+	if !strings.Contains(out, fmt.Sprintf(`enode_device_reachable{user_id="user_1",device_id="%s",type="vehicle"`, vehicle.Id)) {
+		t.Errorf("Expected the vehicle in\n%s", out)
+	}
+	if !strings.Contains(out, `enode_sdk_requests_total{endpoint="/users/{userId}/vehicles"`) {
+		t.Errorf("Expected the request metrics of the SDK in\n%s", out)
+	}
+}
+
+func TestRun_InvalidInterval(t *testing.T) {
+	var stderr bytes.Buffer
+	if err := run(context.Background(), []string{"-interval", "0s"}, func(string) string { return "" }, &stderr, nil); err == nil {
+		t.Error("Expected an error for an invalid interval")
+	}
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// restErrors lists the error constants of every generated package, in the
+// form used by the handwritten users package.
+var restErrors = []struct {
+	Suffix  string
+	Message string
+}{
+	{"TRANSFER", "could not transfer request"},
+	{"READ", "could not read response body"},
+	{"PARSE", "unable to parse response data"},
+	{"PAYLOAD", "unable to create request payload"},
+	{"UNAUTHORIZED", "unauthorized access"},
+	{"FORBIDDEN", "access to the resource is forbidden"},
+	{"NOT_FOUND", "requested resource not found"},
+	{"VALIDATION", "invalid request payload input"},
+	{"CONFLICT", "request conflicts with the current state of the resource"},
+	{"UNPROCESSABLE", "request could not be processed for the target"},
+	{"RATE_LIMIT", "too many requests"},
+	{"GENERAL", "some kind of error occurred"},
+}
+
+// statusErrors maps response status codes to the error constants above.
+var statusErrors = []struct {
+	Status string
+	Suffix string
+}{
+	{"http.StatusBadRequest", "VALIDATION"},
+	{"http.StatusUnauthorized", "UNAUTHORIZED"},
+	{"http.StatusForbidden", "FORBIDDEN"},
+	{"http.StatusNotFound", "NOT_FOUND"},
+	{"http.StatusConflict", "CONFLICT"},
+	{"http.StatusUnprocessableEntity", "UNPROCESSABLE"},
+	{"http.StatusTooManyRequests", "RATE_LIMIT"},
+	{"http.StatusBadGateway", "TRANSFER"},
+}
+
+func errorName(pkg apiPackage, suffix string) string {
+	return fmt.Sprintf("REST_%s_%s_ERROR", pkg.Prefix, suffix)
+}
+
+// renderPackage writes the declarations shared by the operations of a package.
+func renderPackage(f *file, pkg apiPackage, types []*namedType) {
+	f.use("context")
+	f.use("encoding/json")
+	f.use("errors")
+	f.use("io")
+	f.use("net/http")
+	f.use("net/url")
+	f.use(f.module + "/internal/rest")
+	f.use(f.module + "/pkg/session")
+
+	f.p("const (")
+	for _, e := range restErrors {
+		f.p("\t%s string = %q", errorName(pkg, e.Suffix), pkg.Name+": "+e.Message)
+	}
+	f.p(")")
+	f.p("")
+
+	for _, t := range types {
+		renderEnum(f, t)
+	}
+
+	f.p("// do executes a request and decodes a successful response into result, if set.")
+	f.p("func do(ctx context.Context, sess *session.Session, method, path string, query url.Values, payload, result any) error {")
+	f.p("\treq, err := rest.NewRequest(ctx, sess, method, path, query, payload)")
+	f.p("\tif err != nil {")
+	f.p("\t\treturn errors.Join(errors.New(%s), err)", errorName(pkg, "PAYLOAD"))
+	f.p("\t}")
+	f.p("")
+	f.p("\tresp, err := sess.Do(req)")
+	f.p("\tif err != nil {")
+	f.p("\t\treturn errors.Join(errors.New(%s), err)", errorName(pkg, "TRANSFER"))
+	f.p("\t}")
+	f.p("\tdefer resp.Body.Close()")
+	f.p("")
+	f.p("\tbody, err := io.ReadAll(resp.Body)")
+	f.p("\tif err != nil {")
+	f.p("\t\treturn errors.Join(errors.New(%s), err)", errorName(pkg, "READ"))
+	f.p("\t}")
+	f.p("")
+	f.p("\tswitch resp.StatusCode {")
+	f.p("\tdefault:")
+	f.p("\t\treturn errors.Join(errors.New(%s), rest.Problem(resp, body))", errorName(pkg, "GENERAL"))
+	for _, s := range statusErrors {
+		f.p("\tcase %s:", s.Status)
+		f.p("\t\treturn errors.Join(errors.New(%s), rest.Problem(resp, body))", errorName(pkg, s.Suffix))
+	}
+	f.p("\tcase http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent:")
+	f.p("\t\tif result == nil {")
+	f.p("\t\t\treturn nil")
+	f.p("\t\t}")
+	f.p("\t\tif len(body) == 0 {")
+	f.p("\t\t\treturn errors.Join(errors.New(%s), io.EOF)", errorName(pkg, "READ"))
+	f.p("\t\t}")
+	f.p("\t\tif err := json.Unmarshal(body, result); err != nil {")
+	f.p("\t\t\treturn errors.Join(errors.New(%s), err)", errorName(pkg, "PARSE"))
+	f.p("\t\t}")
+	f.p("\t\treturn nil")
+	f.p("\t}")
+	f.p("}")
+}
+
+var pathParam = regexp.MustCompile(`\{([^}]+)\}`)
+
+// renderOperation writes the function calling an endpoint.
+func renderOperation(f *file, o *operation) {
+	f.use("context")
+	f.use("net/http")
+	f.use(f.module + "/pkg/session")
+
+	if o.Query != nil && o.Query.Pkg == f.pkg {
+		renderParamGroup(f, o.Query)
+	}
+
+	var doc strings.Builder
+	text := o.Description
+	if text == "" {
+		text = o.Summary
+	}
+	doc.WriteString(strings.ReplaceAll(strings.TrimSpace(text), "*/", "* /"))
+	doc.WriteString("\n\nParameters:\n")
+	doc.WriteString("  - ctx: The context of the request, used for cancellation and deadlines.\n")
+	doc.WriteString("  - sess: A pointer to the session object containing authentication and environment details.\n")
+
+	args := []string{"ctx context.Context", "sess *session.Session"}
+	for _, p := range o.PathParams {
+		args = append(args, p.GoName+" "+p.Type.expr(f))
+		doc.WriteString(paramDoc(p.GoName, p.Doc, "The "+p.Name+" of the request."))
+	}
+	query := "nil"
+	if o.Query != nil {
+		args = append(args, "params *"+o.Query.expr(f))
+		if o.Query.required() {
+			doc.WriteString("  - params: The query parameters of the request.\n")
+		} else {
+			doc.WriteString("  - params: The optional query parameters of the request, may be nil.\n")
+		}
+		query = "params.Values()"
+	}
+	payload := "nil"
+	if o.Body != nil {
+		if o.Body.isStruct() {
+			args = append(args, "payload *"+o.Body.expr(f))
+		} else {
+			args = append(args, "payload "+o.Body.expr(f))
+		}
+		doc.WriteString("  - payload: The request body sent to the API.\n")
+		payload = "payload"
+	}
+
+	doc.WriteString("\nReturns:\n")
+	results := "error"
+	if o.Result != nil {
+		if o.Result.isStruct() {
+			results = "(*" + o.Result.expr(f) + ", error)"
+			doc.WriteString("  - A pointer to the " + o.Result.expr(f) + " object returned by the API.\n")
+		} else {
+			results = "(" + o.Result.expr(f) + ", error)"
+			doc.WriteString("  - The " + o.Result.expr(f) + " returned by the API.\n")
+		}
+		doc.WriteString("  - An error if any occurred during the request.")
+	} else {
+		doc.WriteString("  - An error if any occurred during the request. If the request is successful, it returns nil.")
+	}
+	if o.Deprecated {
+		doc.WriteString("\n\nDeprecated: " + o.Name + " is deprecated by the Enode API.")
+	}
+
+	f.p("/*")
+	f.p("%s", doc.String())
+	f.p("*/")
+	f.p("func %s(%s) %s {", o.Name, strings.Join(args, ", "), results)
+
+	if len(o.PathParams) > 0 {
+		f.use("fmt")
+		f.use("net/url")
+		var values []string
+		for _, name := range pathParam.FindAllStringSubmatch(o.Path, -1) {
+			p := o.pathParam(name[1])
+			value := p.GoName
+			if p.Type.Named != nil {
+				value = "string(" + value + ")"
+			}
+			values = append(values, "url.PathEscape("+value+")")
+		}
+		f.p("\tpath := fmt.Sprintf(%q, %s)", pathParam.ReplaceAllString(o.Path, "%s"), strings.Join(values, ", "))
+	} else {
+		f.p("\tpath := %q", o.Path)
+	}
+	f.p("")
+
+	switch {
+	case o.Result == nil:
+		f.p("\treturn do(ctx, sess, http.Method%s, path, %s, %s, nil)", methodName(o.Method), query, payload)
+	case o.Result.isStruct():
+		f.p("\tvar result %s", o.Result.expr(f))
+		f.p("\tif err := do(ctx, sess, http.Method%s, path, %s, %s, &result); err != nil {", methodName(o.Method), query, payload)
+		f.p("\t\treturn nil, err")
+		f.p("\t}")
+		f.p("\treturn &result, nil")
+	default:
+		f.p("\tvar result %s", o.Result.expr(f))
+		f.p("\tif err := do(ctx, sess, http.Method%s, path, %s, %s, &result); err != nil {", methodName(o.Method), query, payload)
+		f.p("\t\treturn nil, err")
+		f.p("\t}")
+		f.p("\treturn result, nil")
+	}
+	f.p("}")
+}
+
+func (o *operation) pathParam(name string) *param {
+	for _, p := range o.PathParams {
+		if p.Name == name {
+			return p
+		}
+	}
+	panic(fmt.Sprintf("enode-gen: %s: path parameter %s is not declared", o.Id, name))
+}
+
+func (group *paramGroup) expr(f *file) string {
+	if group.Pkg != f.pkg {
+		f.use(f.module + "/pkg/" + group.Pkg)
+		return group.Pkg + "." + group.Name
+	}
+	return group.Name
+}
+
+func (group *paramGroup) required() bool {
+	for _, p := range group.Params {
+		if p.Required {
+			return true
+		}
+	}
+	return false
+}
+
+func paramDoc(name, doc, fallback string) string {
+	if doc == "" {
+		doc = fallback
+	}
+	doc = strings.Join(strings.Fields(doc), " ")
+	return "  - " + name + ": " + doc + "\n"
+}
+
+func methodName(method string) string {
+	return method[:1] + strings.ToLower(method[1:])
+}
@@ -0,0 +1,342 @@
+// Command enode-gen generates the models and endpoint packages of this module
+// from the OpenAPI specification of the Enode API.
+//
+// It is run through go:generate in pkg/models:
+//
+//	go generate ./pkg/models
+//
+// With -check, nothing is written and the command exits with status 1 if the
+// generated files are missing, out of date or no longer generated.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const generatedHeader = "// Code generated by enode-gen from "
+
+func main() {
+	specPath := flag.String("spec", "refs/openapi3_1.json", "path of the OpenAPI specification")
+	out := flag.String("out", ".", "root directory of the module")
+	check := flag.Bool("check", false, "verify the generated files instead of writing them")
+	flag.Parse()
+
+	files, err := generate(*specPath, *out)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	if *check {
+		stale, err := compare(*out, files)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		if len(stale) > 0 {
+			for _, problem := range stale {
+				fmt.Fprintln(os.Stderr, problem)
+			}
+			fmt.Fprintln(os.Stderr, "enode-gen: generated code is out of date, run go generate ./pkg/models")
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err := write(*out, files); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+}
+
+// generate returns the contents of all generated files, keyed by their path
+// relative to the module root.
+func generate(specPath, root string) (files map[string][]byte, err error) {
+	raw, err := os.ReadFile(specPath)
+	if err != nil {
+		return nil, err
+	}
+	module, err := modulePath(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+
+	var spec Spec
+	if err := json.Unmarshal(raw, &spec); err != nil {
+		return nil, fmt.Errorf("enode-gen: %s: %w", specPath, err)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+				return
+			}
+			panic(r)
+		}
+	}()
+
+	g := &generator{
+		spec:      &spec,
+		module:    module,
+		taken:     map[string]bool{},
+		refs:      map[string]*typeRef{},
+		resolving: map[string]bool{},
+		inline:    map[string]*namedType{},
+		canon:     map[string]string{},
+		overrides: map[string]string{},
+		schemaRef: map[string]bool{},
+		groups:    map[string]*paramGroup{},
+	}
+	g.collectSchemaRefs(raw)
+	for _, name := range spec.Components.Schemas.Keys {
+		s := spec.Components.Schemas.Values[name]
+		if g.transparent(name, s) {
+			continue
+		}
+		if _, ok := g.canon[s.canonical()]; !ok {
+			g.canon[s.canonical()] = name
+		}
+	}
+	if err := g.loadOverrides(raw); err != nil {
+		return nil, err
+	}
+
+	for _, name := range spec.Components.Schemas.Keys {
+		g.ref(name)
+	}
+	g.collectOperations()
+
+	source := specPath
+	if rel, err := filepath.Rel(root, specPath); err == nil {
+		source = rel
+	}
+	return g.render(filepath.ToSlash(source))
+}
+
+// loadOverrides resolves the JSON pointers of typeNames to the canonical form
+// of the schemas they address.
+func (g *generator) loadOverrides(raw []byte) error {
+	var document any
+	if err := json.Unmarshal(raw, &document); err != nil {
+		return err
+	}
+	for pointer, name := range typeNames {
+		value, err := lookup(document, pointer)
+		if err != nil {
+			return err
+		}
+		data, _ := json.Marshal(value)
+		var s Schema
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		g.overrides[s.canonical()] = name
+	}
+	return nil
+}
+
+func lookup(document any, pointer string) (any, error) {
+	value := document
+	for _, segment := range strings.Split(strings.TrimPrefix(pointer, "#/"), "/") {
+		segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("enode-gen: %s does not address a schema", pointer)
+		}
+		if value, ok = object[segment]; !ok {
+			return nil, fmt.Errorf("enode-gen: %s does not address a schema", pointer)
+		}
+	}
+	return value, nil
+}
+
+func (g *generator) render(source string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	emit := func(path string, f *file, doc string) error {
+		data, err := f.bytes(doc)
+		if err != nil {
+			return fmt.Errorf("enode-gen: %s: %w", path, err)
+		}
+		files[path] = data
+		return nil
+	}
+	models := "pkg/" + modelsPackage + "/"
+
+	structs := newFile(modelsPackage, g.module, source)
+	structs.p("// API_VERSION is the version of the Enode API the models are generated from.")
+	structs.p("const API_VERSION = %q", g.spec.Info.Version)
+	structs.p("")
+	enums := newFile(modelsPackage, g.module, source)
+	unions := newFile(modelsPackage, g.module, source)
+	apiEnums := map[string][]*namedType{}
+	hasUnions := false
+	for _, t := range g.types {
+		if t.Pkg != modelsPackage {
+			apiEnums[t.Pkg] = append(apiEnums[t.Pkg], t)
+			continue
+		}
+		switch t.Kind {
+		case kindStruct:
+			renderStruct(structs, t)
+		case kindDefined:
+			renderDefined(structs, t)
+		case kindEnum:
+			renderEnum(enums, t)
+		case kindUnion:
+			renderUnion(unions, t)
+			hasUnions = true
+		}
+	}
+	if hasUnions {
+		renderUnionHelpers(unions)
+	}
+	if err := emit(models+"models_gen.go", structs, ""); err != nil {
+		return nil, err
+	}
+	if err := emit(models+"enums_gen.go", enums, ""); err != nil {
+		return nil, err
+	}
+	if err := emit(models+"unions_gen.go", unions, ""); err != nil {
+		return nil, err
+	}
+
+	if len(g.groups) > 0 {
+		params := newFile(modelsPackage, g.module, source)
+		var names []string
+		for name := range g.groups {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			renderParamGroup(params, g.groups[name])
+		}
+		if err := emit(models+"params_gen.go", params, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	endpoints := newFile(endpointsPackage, g.module, source)
+	renderEndpoints(endpoints, g.endpoints)
+	if err := emit("pkg/"+endpointsPackage+"/"+endpointsPackage+"_gen.go", endpoints, ""); err != nil {
+		return nil, err
+	}
+
+	packages := map[string]apiPackage{}
+	for _, o := range g.operations {
+		packages[o.Pkg.Name] = o.Pkg
+		f := newFile(o.Pkg.Name, g.module, source)
+		renderOperation(f, o)
+		if err := emit("pkg/"+o.Pkg.Name+"/"+o.Name+"_gen.go", f, ""); err != nil {
+			return nil, err
+		}
+	}
+	for name, pkg := range packages {
+		f := newFile(name, g.module, source)
+		renderPackage(f, pkg, apiEnums[name])
+		doc := fmt.Sprintf("// Package %s wraps the endpoints of the Enode API for the %s.", name, pkg.Doc)
+		if err := emit("pkg/"+name+"/"+name+"_gen.go", f, doc); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func modulePath(gomod string) (string, error) {
+	data, err := os.ReadFile(gomod)
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if module, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+			return strings.TrimSpace(module), nil
+		}
+	}
+	return "", errors.New("enode-gen: no module directive in " + gomod)
+}
+
+// existing returns the generated files currently present below pkg/.
+func existing(root string) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(filepath.Join(root, "pkg"), func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, "_gen.go") {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if bytes.HasPrefix(data, []byte(generatedHeader)) {
+			rel, _ := filepath.Rel(root, path)
+			paths = append(paths, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	return paths, err
+}
+
+// compare reports the differences between the generated and the present files.
+func compare(root string, files map[string][]byte) ([]string, error) {
+	var problems []string
+	var paths []string
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		current, err := os.ReadFile(filepath.Join(root, path))
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			problems = append(problems, path+": missing")
+		case err != nil:
+			return nil, err
+		case !bytes.Equal(current, files[path]):
+			problems = append(problems, path+": out of date")
+		}
+	}
+
+	present, err := existing(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range present {
+		if _, ok := files[path]; !ok {
+			problems = append(problems, path+": no longer generated")
+		}
+	}
+	return problems, nil
+}
+
+// write stores the generated files and removes those no longer generated.
+func write(root string, files map[string][]byte) error {
+	present, err := existing(root)
+	if err != nil {
+		return err
+	}
+	for _, path := range present {
+		if _, ok := files[path]; !ok {
+			if err := os.Remove(filepath.Join(root, path)); err != nil {
+				return err
+			}
+		}
+	}
+	for path, data := range files {
+		target := filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(target, data, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
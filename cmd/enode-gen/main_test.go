@@ -0,0 +1,49 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestGenerate_UpToDate(t *testing.T) {
+	files, err := generate("../../refs/openapi3_1.json", "../..")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	stale, err := compare("../..", files)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, problem := range stale {
+		t.Errorf("%s, run go generate ./pkg/models", problem)
+	}
+}
+
+func TestScreaming(t *testing.T) {
+	cases := map[string]string{
+		"ActionState":                 "ACTION_STATE",
+		"HVACSetPermanentHoldPayload": "HVAC_SET_PERMANENT_HOLD_PAYLOAD",
+		"ChargerSmartChargingStatus":  "CHARGER_SMART_CHARGING_STATUS",
+		"Vehicle2Grid":                "VEHICLE2_GRID",
+	}
+	for name, expected := range cases {
+		if got := screaming(name); got != expected {
+			t.Errorf("screaming(%q): expected %s, but got %s", name, expected, got)
+		}
+	}
+}
+
+func TestEnumConstant(t *testing.T) {
+	cases := map[string]string{
+		"PLAN:EXECUTING:STOPPED":  "PLAN_EXECUTING_STOPPED",
+		"user:vehicle:discovered": "USER_VEHICLE_DISCOVERED",
+		"*":                       "ALL",
+		"Mercedes-Benz":           "MERCEDES_BENZ",
+		"ELEVATED_ERROR_RATE":     "ELEVATED_ERROR_RATE",
+	}
+	for value, expected := range cases {
+		if got := enumConstant(value); got != expected {
+			t.Errorf("enumConstant(%q): expected %s, but got %s", value, expected, got)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+// pascal turns an identifier of the specification into an exported Go name,
+// e.g. "setBatteryOperationModePayload" becomes "SetBatteryOperationModePayload".
+func pascal(name string) string {
+	var out strings.Builder
+	upperNext := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upperNext = true
+			continue
+		}
+		if upperNext {
+			r = unicode.ToUpper(r)
+			upperNext = false
+		}
+		out.WriteRune(r)
+	}
+	result := out.String()
+	if result == "" || unicode.IsDigit(rune(result[0])) {
+		result = "N" + result
+	}
+	return result
+}
+
+// camel turns an identifier into an unexported Go name.
+func camel(name string) string {
+	p := pascal(name)
+	runes := []rune(p)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+// screaming turns a Go type name into the upper snake case used for the
+// constants of this module, e.g. "HVACSetPermanentHold" becomes "HVAC_SET_PERMANENT_HOLD".
+func screaming(name string) string {
+	runes := []rune(name)
+	var out strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				out.WriteRune('_')
+			}
+		}
+		out.WriteRune(unicode.ToUpper(r))
+	}
+	return out.String()
+}
+
+// enumConstant turns an enum value into the identifier part of its constant,
+// e.g. "PLAN:EXECUTING:STOPPED" becomes "PLAN_EXECUTING_STOPPED".
+func enumConstant(value string) string {
+	if name, ok := enumValueNames[value]; ok {
+		return name
+	}
+	var out strings.Builder
+	underscore := false
+	for _, r := range value {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if underscore && out.Len() > 0 {
+				out.WriteRune('_')
+			}
+			underscore = false
+			out.WriteRune(unicode.ToUpper(r))
+			continue
+		}
+		underscore = true
+	}
+	return out.String()
+}
+
+// singular strips a plural "s" from a property name, used to name array items.
+func singular(name string) string {
+	if strings.HasSuffix(name, "ies") {
+		return strings.TrimSuffix(name, "ies") + "y"
+	}
+	if strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss") {
+		return strings.TrimSuffix(name, "s")
+	}
+	return name
+}
+
+// pointerEscape escapes a JSON pointer segment.
+func pointerEscape(segment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1")
+}
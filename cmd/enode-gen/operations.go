@@ -0,0 +1,194 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// operation is an endpoint of the API, emitted as a function of its tag's package.
+type operation struct {
+	Id          string
+	Name        string
+	Method      string
+	Path        string
+	Summary     string
+	Description string
+	Deprecated  bool
+	Pkg         apiPackage
+
+	PathParams []*param
+	Query      *paramGroup
+	Body       *typeRef
+	Result     *typeRef
+}
+
+type param struct {
+	Name     string // name in the specification
+	GoName   string
+	Doc      string
+	Type     *typeRef
+	Required bool
+}
+
+// endpoint is an operation of the API as listed in pkg/endpoints.
+type endpoint struct {
+	Id     string
+	Method string
+	Path   string
+}
+
+// paramGroup is the struct holding the query parameters of an operation.
+type paramGroup struct {
+	Name   string
+	Pkg    string
+	Doc    string
+	Params []*param
+}
+
+var methods = map[string]string{
+	"get":    http.MethodGet,
+	"put":    http.MethodPut,
+	"post":   http.MethodPost,
+	"patch":  http.MethodPatch,
+	"delete": http.MethodDelete,
+}
+
+func (g *generator) collectOperations() {
+	for _, path := range g.spec.Paths.Keys {
+		item := g.spec.Paths.Values[path]
+
+		var shared []*Parameter
+		if raw, ok := item.Values["parameters"]; ok {
+			if err := json.Unmarshal(raw, &shared); err != nil {
+				g.fail("parameters of %s: %v", path, err)
+			}
+		}
+
+		for _, method := range item.Keys {
+			if _, ok := methods[method]; !ok {
+				continue
+			}
+			var op Operation
+			if err := json.Unmarshal(item.Values[method], &op); err != nil {
+				g.fail("%s %s: %v", method, path, err)
+			}
+			g.endpoints = append(g.endpoints, endpoint{Id: op.OperationId, Method: methods[method], Path: path})
+			if len(op.Tags) == 0 || handwrittenTags[op.Tags[0]] {
+				continue
+			}
+			pkg, ok := tagPackages[op.Tags[0]]
+			if !ok {
+				g.fail("no package configured for tag %q", op.Tags[0])
+			}
+			g.operations = append(g.operations, g.operation(path, method, &op, append(shared, op.Parameters...), pkg))
+		}
+	}
+}
+
+func (g *generator) operation(path, method string, op *Operation, parameters []*Parameter, pkg apiPackage) *operation {
+	o := &operation{
+		Id:          op.OperationId,
+		Name:        pascal(op.OperationId),
+		Method:      methods[method],
+		Path:        path,
+		Summary:     op.Summary,
+		Description: op.Description,
+		Deprecated:  op.Deprecated,
+		Pkg:         pkg,
+	}
+	if name, ok := operationNames[op.OperationId]; ok {
+		o.Name = name
+	}
+	pointer := "#/paths/" + pointerEscape(path) + "/" + method
+
+	var query []*param
+	var queryRefs []string
+	for _, p := range parameters {
+		component := ""
+		if p.Ref != "" {
+			component = refName(p.Ref)
+			p = g.spec.Components.Parameters.Values[component]
+		}
+		doc := p.Description
+		if doc == "" && p.Schema != nil {
+			doc = description(p.Schema)
+		}
+		at := site{Name: pascal(p.Name), Alt: o.Name + pascal(p.Name), Path: pointer + "/parameters/" + p.Name, Pkg: pkg.Name}
+		if p.In == "path" {
+			at.Pkg = modelsPackage
+		}
+		converted := &param{Name: p.Name, GoName: camel(p.Name), Doc: doc, Type: g.resolve(p.Schema, at), Required: p.Required}
+
+		switch p.In {
+		case "path":
+			o.PathParams = append(o.PathParams, converted)
+		case "query":
+			converted.GoName = pascal(p.Name)
+			query = append(query, converted)
+			queryRefs = append(queryRefs, component)
+		default:
+			g.fail("%s: unsupported parameter location %q", op.OperationId, p.In)
+		}
+	}
+	if len(query) > 0 {
+		o.Query = g.queryGroup(o, query, queryRefs)
+	}
+
+	if op.RequestBody != nil {
+		if media, ok := op.RequestBody.Content.Values["application/json"]; ok {
+			o.Body = g.resolve(media.Schema, site{
+				Name: o.Name + "Payload",
+				Path: pointer + "/requestBody/content/application~1json/schema",
+				Pkg:  modelsPackage,
+			})
+		}
+	}
+
+	for _, status := range op.Responses.Keys {
+		if !strings.HasPrefix(status, "2") {
+			continue
+		}
+		media, ok := op.Responses.Values[status].Content.Values["application/json"]
+		if !ok || media.Schema == nil {
+			continue
+		}
+		o.Result = g.resolve(media.Schema, site{
+			Name: o.Name + "Response",
+			Path: pointer + "/responses/" + status + "/content/application~1json/schema",
+			Pkg:  modelsPackage,
+		})
+		if !o.Result.isStruct() && o.Result.base().Elem == nil {
+			g.fail("%s: unsupported result type", op.OperationId)
+		}
+		break
+	}
+	return o
+}
+
+// queryGroup returns the shared parameter group matching the query
+// parameters, or a group of the operation itself.
+func (g *generator) queryGroup(o *operation, query []*param, refs []string) *paramGroup {
+	sorted := append([]string{}, refs...)
+	sort.Strings(sorted)
+	for _, group := range parameterGroups {
+		expected := append([]string{}, group.Parameters...)
+		sort.Strings(expected)
+		if strings.Join(sorted, ",") != strings.Join(expected, ",") {
+			continue
+		}
+		if existing, ok := g.groups[group.Name]; ok {
+			return existing
+		}
+		shared := &paramGroup{Name: group.Name, Pkg: modelsPackage, Doc: group.Doc, Params: query}
+		g.groups[group.Name] = shared
+		return shared
+	}
+	return &paramGroup{
+		Name:   o.Name + "Params",
+		Pkg:    o.Pkg.Name,
+		Doc:    o.Name + "Params holds the query parameters of " + o.Name + ".",
+		Params: query,
+	}
+}
@@ -0,0 +1,359 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// file collects the declarations and imports of one generated Go file.
+type file struct {
+	pkg     string
+	module  string
+	source  string
+	imports map[string]bool
+	body    bytes.Buffer
+}
+
+func newFile(pkg, module, source string) *file {
+	return &file{pkg: pkg, module: module, source: source, imports: map[string]bool{}}
+}
+
+func (f *file) use(path string) {
+	f.imports[path] = true
+}
+
+func (f *file) p(format string, args ...any) {
+	fmt.Fprintf(&f.body, format, args...)
+	f.body.WriteByte('\n')
+}
+
+// comment writes documentation as line comments, one per line of text.
+func (f *file) comment(indent, text string) {
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			f.p("%s//", indent)
+			continue
+		}
+		f.p("%s// %s", indent, line)
+	}
+}
+
+func (f *file) bytes(doc string) ([]byte, error) {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by enode-gen from %s. DO NOT EDIT.\n\n", f.source)
+	if doc != "" {
+		fmt.Fprintf(&out, "%s\n", doc)
+	}
+	fmt.Fprintf(&out, "package %s\n\n", f.pkg)
+
+	if len(f.imports) > 0 {
+		var paths []string
+		for path := range f.imports {
+			paths = append(paths, path)
+		}
+		// Standard library imports come first, separated from module imports.
+		sort.SliceStable(paths, func(i, j int) bool {
+			si, sj := isStandard(paths[i]), isStandard(paths[j])
+			if si != sj {
+				return si
+			}
+			return paths[i] < paths[j]
+		})
+		out.WriteString("import (\n")
+		for i, path := range paths {
+			if i > 0 && isStandard(paths[i-1]) != isStandard(path) {
+				out.WriteString("\n")
+			}
+			fmt.Fprintf(&out, "\t%q\n", path)
+		}
+		out.WriteString(")\n\n")
+	}
+	out.Write(f.body.Bytes())
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%w\n%s", err, out.Bytes())
+	}
+	return formatted, nil
+}
+
+func isStandard(path string) bool {
+	return !strings.Contains(strings.Split(path, "/")[0], ".")
+}
+
+// typeDoc writes the documentation of a type declaration.
+func (f *file) typeDoc(t *namedType) {
+	if t.Doc != "" {
+		f.comment("", t.Name+": "+t.Doc)
+	}
+	if t.Deprecated {
+		if t.Doc != "" {
+			f.p("//")
+		}
+		f.p("// Deprecated: %s is deprecated by the Enode API.", t.Name)
+	}
+}
+
+func renderStruct(f *file, t *namedType) {
+	f.typeDoc(t)
+	f.p("type %s struct {", t.Name)
+	for _, embedded := range t.Embeds {
+		f.p("\t%s", embedded.Name)
+	}
+	for _, field := range t.Fields {
+		if field.Doc != "" {
+			f.comment("\t", field.Doc)
+		}
+		if field.Deprecated {
+			if field.Doc != "" {
+				f.p("\t//")
+			}
+			f.p("\t// Deprecated: %s is deprecated by the Enode API.", field.Name)
+		}
+		tag := field.JSON
+		if !field.Required {
+			tag += ",omitempty"
+		}
+		f.p("\t%s %s `json:%q`", field.Name, field.Type.fieldExpr(f, field.Required), tag)
+	}
+	f.p("}")
+	f.p("")
+}
+
+func renderEnum(f *file, t *namedType) {
+	f.typeDoc(t)
+	f.p("type %s string", t.Name)
+	f.p("")
+	f.p("const (")
+	prefix := screaming(t.Name)
+	for _, value := range t.Values {
+		f.p("\t%s_%s %s = %q", prefix, enumConstant(value), t.Name, value)
+	}
+	f.p(")")
+	f.p("")
+}
+
+func renderDefined(f *file, t *namedType) {
+	f.typeDoc(t)
+	if t.Underlying.Named != nil {
+		f.p("type %s = %s", t.Name, t.Underlying.expr(f))
+	} else {
+		f.p("type %s %s", t.Name, t.Underlying.expr(f))
+	}
+	f.p("")
+}
+
+func renderUnion(f *file, t *namedType) {
+	f.use("encoding/json")
+	f.use("fmt")
+
+	f.typeDoc(t)
+	if t.Doc != "" {
+		f.p("//")
+	}
+	f.p("// Exactly one of the variants is set, depending on the decoded value.")
+	f.p("type %s struct {", t.Name)
+	for _, v := range t.Variants {
+		f.p("\t%s *%s", v.Name, v.Type.expr(f))
+	}
+	f.p("}")
+	f.p("")
+
+	f.p("func (u %s) MarshalJSON() ([]byte, error) {", t.Name)
+	f.p("\tswitch {")
+	for _, v := range t.Variants {
+		f.p("\tcase u.%s != nil:", v.Name)
+		f.p("\t\treturn json.Marshal(u.%s)", v.Name)
+	}
+	f.p("\t}")
+	f.p("\treturn []byte(\"null\"), nil")
+	f.p("}")
+	f.p("")
+
+	f.p("func (u *%s) UnmarshalJSON(data []byte) error {", t.Name)
+	f.p("\t*u = %s{}", t.Name)
+	f.p("\tif isNull(data) {")
+	f.p("\t\treturn nil")
+	f.p("\t}")
+	if t.Discriminator != "" {
+		f.p("")
+		f.p("\tvar probe struct {")
+		f.p("\t\tValue string `json:%q`", t.Discriminator)
+		f.p("\t}")
+		f.p("\tif err := json.Unmarshal(data, &probe); err != nil {")
+		f.p("\t\treturn err")
+		f.p("\t}")
+		f.p("\tswitch probe.Value {")
+		for _, v := range t.Variants {
+			var values []string
+			for _, entry := range t.Mapping {
+				if entry.Variant == v {
+					values = append(values, strconv.Quote(entry.Value))
+				}
+			}
+			if len(values) == 0 {
+				continue
+			}
+			f.p("\tcase %s:", strings.Join(values, ", "))
+			f.p("\t\tu.%s = new(%s)", v.Name, v.Type.expr(f))
+			f.p("\t\treturn json.Unmarshal(data, u.%s)", v.Name)
+		}
+		f.p("\t}")
+		f.p("\treturn fmt.Errorf(\"models: unknown %s %%q of %s\", probe.Value)", t.Discriminator, t.Name)
+		f.p("}")
+		f.p("")
+		return
+	}
+
+	// Variants are tried strictly first, rejecting unknown properties where
+	// the schema does, and leniently afterwards to tolerate additions to the API.
+	for _, strict := range []bool{true, false} {
+		f.p("")
+		for _, v := range t.Variants {
+			if !strict && !isStrict(v) {
+				continue
+			}
+			f.p("\tif v := new(%s); %s == nil {", v.Type.expr(f), decodeCall(v, strict))
+			f.p("\t\tu.%s = v", v.Name)
+			f.p("\t\treturn nil")
+			f.p("\t}")
+		}
+	}
+	f.p("\treturn fmt.Errorf(\"models: value does not match any variant of %s\")", t.Name)
+	f.p("}")
+	f.p("")
+}
+
+func isStrict(v *variant) bool {
+	return v.Type.Named != nil && v.Type.Named.Kind == kindStruct && v.Type.Named.Strict
+}
+
+func decodeCall(v *variant, strict bool) string {
+	if v.Type.Named == nil || v.Type.Named.Kind != kindStruct {
+		return "json.Unmarshal(data, v)"
+	}
+	args := []string{"data", "v", strconv.FormatBool(strict && isStrict(v))}
+	for _, key := range requiredKeys(v.Type.Named) {
+		args = append(args, strconv.Quote(key))
+	}
+	return "decodeVariant(" + strings.Join(args, ", ") + ")"
+}
+
+// renderUnionHelpers writes the functions shared by the union decoders.
+func renderUnionHelpers(f *file) {
+	f.use("bytes")
+	f.use("encoding/json")
+	f.use("fmt")
+
+	f.p("func isNull(data []byte) bool {")
+	f.p("\treturn string(bytes.TrimSpace(data)) == \"null\"")
+	f.p("}")
+	f.p("")
+	f.p("// decodeVariant decodes data into target if it carries all required")
+	f.p("// properties and, when strict, no properties unknown to the target.")
+	f.p("func decodeVariant(data []byte, target any, strict bool, required ...string) error {")
+	f.p("\tvar properties map[string]json.RawMessage")
+	f.p("\tif err := json.Unmarshal(data, &properties); err != nil {")
+	f.p("\t\treturn err")
+	f.p("\t}")
+	f.p("\tfor _, name := range required {")
+	f.p("\t\tif _, ok := properties[name]; !ok {")
+	f.p("\t\t\treturn fmt.Errorf(\"models: missing property %%q\", name)")
+	f.p("\t\t}")
+	f.p("\t}")
+	f.p("")
+	f.p("\tdecoder := json.NewDecoder(bytes.NewReader(data))")
+	f.p("\tif strict {")
+	f.p("\t\tdecoder.DisallowUnknownFields()")
+	f.p("\t}")
+	f.p("\treturn decoder.Decode(target)")
+	f.p("}")
+}
+
+// renderEndpoints writes the table of the operations and their path templates.
+func renderEndpoints(f *file, endpoints []endpoint) {
+	f.p("// ENDPOINTS lists the operations of the API in the order of the specification.")
+	f.p("var ENDPOINTS = []Endpoint{")
+	for _, e := range endpoints {
+		f.p("\t{OperationId: %q, Method: %q, Template: %q},", e.Id, e.Method, e.Path)
+	}
+	f.p("}")
+}
+
+// renderParamGroup writes a struct of query parameters and its encoder.
+func renderParamGroup(f *file, group *paramGroup) {
+	f.use("net/url")
+
+	f.comment("", group.Doc)
+	f.p("type %s struct {", group.Name)
+	for _, p := range group.Params {
+		if p.Doc != "" {
+			f.comment("\t", p.Doc)
+		}
+		f.p("\t%s %s", p.GoName, p.Type.fieldExpr(f, p.Required))
+	}
+	f.p("}")
+	f.p("")
+
+	f.p("// Values encodes the parameters as URL query values.")
+	f.p("func (params *%s) Values() url.Values {", group.Name)
+	f.p("\tquery := url.Values{}")
+	f.p("\tif params == nil {")
+	f.p("\t\treturn query")
+	f.p("\t}")
+	for _, p := range group.Params {
+		value := "params." + p.GoName
+		if p.Type.isReference() {
+			f.p("\tfor _, value := range %s {", value)
+			f.p("\t\tquery.Add(%q, %s)", p.Name, queryValue(f, p.Type.Elem, "value"))
+			f.p("\t}")
+			continue
+		}
+		if !p.Required || p.Type.Nullable {
+			f.p("\tif %s != nil {", value)
+			f.p("\t\tquery.Set(%q, %s)", p.Name, queryValue(f, p.Type, "*"+value))
+			f.p("\t}")
+			continue
+		}
+		f.p("\tquery.Set(%q, %s)", p.Name, queryValue(f, p.Type, value))
+	}
+	f.p("\treturn query")
+	f.p("}")
+	f.p("")
+}
+
+// queryValue returns the expression formatting a parameter value as string.
+func queryValue(f *file, t *typeRef, value string) string {
+	base := t.base()
+	if base.Named != nil && base.Named.Kind == kindEnum {
+		return "string(" + value + ")"
+	}
+	switch base.Builtin {
+	case "string":
+		if t.Named != nil {
+			return "string(" + value + ")"
+		}
+		return value
+	case "time.Time":
+		f.use("time")
+		if strings.HasPrefix(value, "*") {
+			value = "(" + value + ")"
+		}
+		return value + ".Format(time.RFC3339)"
+	case "int":
+		f.use("strconv")
+		return "strconv.Itoa(int(" + value + "))"
+	case "float64":
+		f.use("strconv")
+		return "strconv.FormatFloat(float64(" + value + "), 'f', -1, 64)"
+	case "bool":
+		f.use("strconv")
+		return "strconv.FormatBool(bool(" + value + "))"
+	}
+	panic("enode-gen: unsupported query parameter type " + t.expr(f))
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ordered keeps the key order of a JSON object, so that the generated code
+// follows the order of the specification instead of Go's map iteration order.
+type ordered[T any] struct {
+	Keys   []string
+	Values map[string]T
+}
+
+func (o *ordered[T]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return errors.New("spec: expected a JSON object")
+	}
+
+	o.Values = make(map[string]T)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key := tok.(string)
+
+		var value T
+		if err := dec.Decode(&value); err != nil {
+			return fmt.Errorf("spec: %s: %w", key, err)
+		}
+		o.Keys = append(o.Keys, key)
+		o.Values[key] = value
+	}
+
+	_, err = dec.Token()
+	return err
+}
+
+type Spec struct {
+	Info struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	} `json:"info"`
+	Paths      ordered[ordered[json.RawMessage]] `json:"paths"`
+	Components struct {
+		Schemas    ordered[*Schema]    `json:"schemas"`
+		Parameters ordered[*Parameter] `json:"parameters"`
+	} `json:"components"`
+}
+
+type Operation struct {
+	OperationId string       `json:"operationId"`
+	Summary     string       `json:"summary"`
+	Description string       `json:"description"`
+	Tags        []string     `json:"tags"`
+	Deprecated  bool         `json:"deprecated"`
+	Parameters  []*Parameter `json:"parameters"`
+	RequestBody *struct {
+		Required bool                `json:"required"`
+		Content  ordered[*MediaType] `json:"content"`
+	} `json:"requestBody"`
+	Responses ordered[*Response] `json:"responses"`
+}
+
+type Parameter struct {
+	Ref         string  `json:"$ref"`
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+type Response struct {
+	Description string              `json:"description"`
+	Content     ordered[*MediaType] `json:"content"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// typeList accepts both the single type and the type array notation of OpenAPI 3.1.
+type typeList []string
+
+func (t *typeList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = typeList{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*t = list
+	return nil
+}
+
+func (t typeList) has(name string) bool {
+	for _, typ := range t {
+		if typ == name {
+			return true
+		}
+	}
+	return false
+}
+
+// primary returns the first non-null type of the list.
+func (t typeList) primary() string {
+	for _, typ := range t {
+		if typ != "null" {
+			return typ
+		}
+	}
+	return ""
+}
+
+type Schema struct {
+	Ref                  string           `json:"$ref"`
+	Type                 typeList         `json:"type"`
+	Format               string           `json:"format"`
+	Description          string           `json:"description"`
+	Title                string           `json:"title"`
+	Deprecated           bool             `json:"deprecated"`
+	Enum                 []any            `json:"enum"`
+	Properties           ordered[*Schema] `json:"properties"`
+	Required             []string         `json:"required"`
+	AdditionalProperties json.RawMessage  `json:"additionalProperties"`
+	Items                *Schema          `json:"items"`
+	AllOf                []*Schema        `json:"allOf"`
+	AnyOf                []*Schema        `json:"anyOf"`
+	OneOf                []*Schema        `json:"oneOf"`
+	Discriminator        *Discriminator   `json:"discriminator"`
+	Raw                  json.RawMessage  `json:"-"`
+}
+
+type Discriminator struct {
+	PropertyName string          `json:"propertyName"`
+	Mapping      ordered[string] `json:"mapping"`
+}
+
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	type plain Schema
+	if err := json.Unmarshal(data, (*plain)(s)); err != nil {
+		return err
+	}
+	s.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// refName returns the component name a reference points to.
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+func (s *Schema) isNullable() bool {
+	if s.Type.has("null") {
+		return true
+	}
+	for _, value := range s.Enum {
+		if value == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// isNull reports whether the schema only describes the null value.
+func (s *Schema) isNull() bool {
+	return len(s.Type) == 1 && s.Type[0] == "null"
+}
+
+// isAnnotation reports whether the schema only carries documentation or
+// nullability and does not contribute any structure when used inside allOf.
+func (s *Schema) isAnnotation() bool {
+	return s.Ref == "" && len(s.Properties.Keys) == 0 && len(s.Required) == 0 &&
+		s.Items == nil && len(s.Enum) == 0 && len(s.AllOf) == 0 && len(s.AnyOf) == 0 && len(s.OneOf) == 0
+}
+
+func (s *Schema) isRequired(property string) bool {
+	for _, name := range s.Required {
+		if name == property {
+			return true
+		}
+	}
+	return false
+}
+
+// forbidsAdditional reports whether unknown properties are rejected by the schema.
+func (s *Schema) forbidsAdditional() bool {
+	return string(s.AdditionalProperties) == "false"
+}
+
+// additionalSchema returns the schema of additional properties for map-like objects.
+func (s *Schema) additionalSchema() *Schema {
+	if len(s.AdditionalProperties) == 0 || s.AdditionalProperties[0] != '{' {
+		return nil
+	}
+	var additional Schema
+	if err := json.Unmarshal(s.AdditionalProperties, &additional); err != nil {
+		return nil
+	}
+	return &additional
+}
+
+// canonical returns a representation of the schema without documentation
+// keywords, used to detect structurally identical inline schemas.
+func (s *Schema) canonical() string {
+	var value any
+	if err := json.Unmarshal(s.Raw, &value); err != nil {
+		return string(s.Raw)
+	}
+	data, _ := json.Marshal(stripDocs(value))
+	return string(data)
+}
+
+func stripDocs(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			switch key {
+			case "description", "example", "title", "x-format", "deprecated", "default":
+				continue
+			}
+			out[key] = stripDocs(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = stripDocs(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
@@ -0,0 +1,669 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type kind int
+
+const (
+	kindStruct kind = iota
+	kindEnum
+	kindDefined
+	kindUnion
+)
+
+// namedType is a type declaration emitted into one of the generated packages.
+type namedType struct {
+	Name       string
+	Pkg        string
+	Doc        string
+	Kind       kind
+	Deprecated bool
+	Component  string // name of the component schema, empty for inline schemas
+
+	// kindStruct
+	Embeds []*namedType
+	Fields []*field
+	Strict bool // the schema rejects unknown properties
+
+	// kindEnum
+	Values []string
+
+	// kindDefined
+	Underlying *typeRef
+
+	// kindUnion
+	Variants      []*variant
+	Discriminator string
+	Mapping       []mappingEntry
+}
+
+type field struct {
+	Name       string
+	JSON       string
+	Doc        string
+	Type       *typeRef
+	Required   bool
+	Deprecated bool
+}
+
+type variant struct {
+	Name string
+	Type *typeRef
+}
+
+type mappingEntry struct {
+	Value   string
+	Variant *variant
+}
+
+// typeRef is the use of a type, e.g. as a field, element or parameter.
+type typeRef struct {
+	Builtin  string
+	Named    *namedType
+	Elem     *typeRef
+	MapValue *typeRef
+	Nullable bool
+}
+
+func (t *typeRef) withNullable(nullable bool) *typeRef {
+	if !nullable || t.Nullable {
+		return t
+	}
+	c := *t
+	c.Nullable = true
+	return &c
+}
+
+// isReference reports whether the Go type can already represent an absent value.
+func (t *typeRef) isReference() bool {
+	return t.Elem != nil || t.MapValue != nil || t.Builtin == "any"
+}
+
+// isStruct reports whether values of the type are passed around by pointer.
+func (t *typeRef) isStruct() bool {
+	return t.Named != nil && (t.Named.Kind == kindStruct || t.Named.Kind == kindUnion)
+}
+
+// base returns the underlying builtin or named kind, following defined types.
+func (t *typeRef) base() *typeRef {
+	if t.Named != nil && t.Named.Kind == kindDefined {
+		return t.Named.Underlying.base()
+	}
+	return t
+}
+
+func (t *typeRef) expr(f *file) string {
+	switch {
+	case t.Elem != nil:
+		return "[]" + t.Elem.expr(f)
+	case t.MapValue != nil:
+		return "map[string]" + t.MapValue.expr(f)
+	case t.Named != nil:
+		if t.Named.Pkg != f.pkg {
+			f.use(f.module + "/pkg/" + t.Named.Pkg)
+			return t.Named.Pkg + "." + t.Named.Name
+		}
+		return t.Named.Name
+	case t.Builtin == "time.Time":
+		f.use("time")
+	case t.Builtin == "json.RawMessage":
+		f.use("encoding/json")
+	}
+	return t.Builtin
+}
+
+// fieldExpr returns the type of a struct field or parameter, using a pointer
+// where the value is optional or nullable.
+func (t *typeRef) fieldExpr(f *file, required bool) string {
+	if t.isReference() || (required && !t.Nullable) {
+		return t.expr(f)
+	}
+	return "*" + t.expr(f)
+}
+
+// site describes where a schema is used, which determines the name of the
+// type generated for it.
+type site struct {
+	Name      string // name for a type generated at this site
+	Alt       string // alternative name if Name is already taken
+	ItemName  string // name for array items at this site
+	Path      string // JSON pointer of the schema
+	Pkg       string
+	Component string // set when the site is the definition of a component schema
+}
+
+func (at site) items() site {
+	name := at.ItemName
+	if name == "" {
+		name = at.Name + "Item"
+	}
+	return site{Name: name, Path: at.Path + "/items", Pkg: at.Pkg}
+}
+
+func (at site) property(owner, key string) site {
+	return site{
+		Name:     owner + pascal(key),
+		ItemName: owner + pascal(singular(key)),
+		Path:     at.Path + "/properties/" + pointerEscape(key),
+		Pkg:      at.Pkg,
+	}
+}
+
+type generator struct {
+	spec   *Spec
+	module string
+
+	types     []*namedType
+	taken     map[string]bool
+	refs      map[string]*typeRef
+	resolving map[string]bool
+	inline    map[string]*namedType
+	canon     map[string]string // canonical schema -> component name
+	overrides map[string]string // canonical schema -> configured type name
+	schemaRef map[string]bool   // components referenced by other schemas or bodies
+
+	operations []*operation
+	groups     map[string]*paramGroup
+	// endpoints lists all operations, including those maintained by hand
+	endpoints []endpoint
+}
+
+func (g *generator) fail(format string, args ...any) {
+	panic(fmt.Errorf("enode-gen: "+format, args...))
+}
+
+func (g *generator) add(t *namedType) {
+	g.taken[t.Pkg+"."+t.Name] = true
+	g.types = append(g.types, t)
+}
+
+func (g *generator) unique(at site) string {
+	if !g.taken[at.Pkg+"."+at.Name] {
+		return at.Name
+	}
+	if at.Alt != "" && !g.taken[at.Pkg+"."+at.Alt] {
+		return at.Alt
+	}
+	for i := 2; ; i++ {
+		name := fmt.Sprintf("%s%d", at.Name, i)
+		if !g.taken[at.Pkg+"."+name] {
+			return name
+		}
+	}
+}
+
+// ref returns the type of a component schema, generating it on first use.
+func (g *generator) ref(name string) *typeRef {
+	if t, ok := g.refs[name]; ok {
+		return t
+	}
+	if g.resolving[name] {
+		g.fail("recursive schema %s is not supported", name)
+	}
+	s, ok := g.spec.Components.Schemas.Values[name]
+	if !ok {
+		g.fail("unknown schema %s", name)
+	}
+	g.resolving[name] = true
+	defer delete(g.resolving, name)
+
+	at := site{Name: pascal(name), Path: "#/components/schemas/" + pointerEscape(name), Pkg: modelsPackage, Component: name}
+	if g.transparent(name, s) {
+		at.Component = ""
+		t := g.resolve(s, at)
+		g.refs[name] = t
+		return t
+	}
+
+	t := g.resolve(s, at)
+	if t.Named == nil || t.Named.Component != name {
+		defined := &namedType{
+			Name:       at.Name,
+			Pkg:        at.Pkg,
+			Doc:        s.Description,
+			Kind:       kindDefined,
+			Deprecated: s.Deprecated,
+			Component:  name,
+			Underlying: &typeRef{Builtin: t.Builtin, Named: t.Named, Elem: t.Elem, MapValue: t.MapValue},
+		}
+		g.add(defined)
+		t = &typeRef{Named: defined, Nullable: t.Nullable}
+	}
+	g.refs[name] = t
+	return t
+}
+
+// transparent reports whether a component is a plain primitive only used to
+// describe parameters, in which case it does not get a type of its own.
+func (g *generator) transparent(name string, s *Schema) bool {
+	if g.schemaRef[name] || len(s.Enum) > 0 {
+		return false
+	}
+	switch s.Type.primary() {
+	case "string", "integer", "number", "boolean":
+		return true
+	}
+	if len(s.AnyOf) == 0 || !g.stringLike(s) {
+		return false
+	}
+	for _, v := range s.AnyOf {
+		if v.Ref != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *generator) resolve(s *Schema, at site) *typeRef {
+	switch {
+	case s == nil:
+		return &typeRef{Builtin: "any"}
+	case s.Ref != "":
+		return g.ref(refName(s.Ref))
+	case len(s.AllOf) > 0:
+		return g.allOf(s, at)
+	case len(s.AnyOf) > 0 || len(s.OneOf) > 0:
+		return g.union(s, at)
+	}
+
+	nullable := s.isNullable()
+	switch s.Type.primary() {
+	case "string":
+		if len(enumStrings(s)) > 0 {
+			return g.named(s, at, func(t *namedType) {
+				t.Kind = kindEnum
+				t.Values = enumStrings(s)
+			})
+		}
+		if s.Format == "date-time" {
+			return &typeRef{Builtin: "time.Time", Nullable: nullable}
+		}
+		return &typeRef{Builtin: "string", Nullable: nullable}
+	case "integer":
+		return &typeRef{Builtin: "int", Nullable: nullable}
+	case "number":
+		return &typeRef{Builtin: "float64", Nullable: nullable}
+	case "boolean":
+		return &typeRef{Builtin: "bool", Nullable: nullable}
+	case "array":
+		return &typeRef{Elem: g.resolve(s.Items, at.items()), Nullable: nullable}
+	case "object", "":
+		if len(s.Properties.Keys) == 0 {
+			if additional := s.additionalSchema(); additional != nil {
+				return &typeRef{MapValue: g.resolve(additional, site{Name: at.Name + "Value", Path: at.Path + "/additionalProperties", Pkg: at.Pkg}), Nullable: nullable}
+			}
+			if !s.forbidsAdditional() {
+				if s.Type.primary() == "" {
+					return &typeRef{Builtin: "any"}
+				}
+				return &typeRef{MapValue: &typeRef{Builtin: "any"}, Nullable: nullable}
+			}
+		}
+		return g.named(s, at, func(t *namedType) {
+			t.Kind = kindStruct
+			t.Strict = s.forbidsAdditional()
+			t.Fields = g.fields(t, s, s.isRequired, at)
+		})
+	}
+	g.fail("unsupported schema type %v at %s", s.Type, at.Path)
+	return nil
+}
+
+// named returns the type generated for a schema at the given site. Inline
+// schemas matching a component or a previously generated inline schema reuse
+// the existing type.
+func (g *generator) named(s *Schema, at site, build func(*namedType)) *typeRef {
+	nullable := s.isNullable()
+	canon := s.canonical()
+
+	if at.Component == "" {
+		if component, ok := g.canon[canon]; ok {
+			return g.ref(component).withNullable(nullable)
+		}
+		if t, ok := g.inline[at.Pkg+" "+canon]; ok {
+			return &typeRef{Named: t, Nullable: nullable}
+		}
+		if name, ok := g.overrides[canon]; ok && at.Pkg == modelsPackage {
+			at.Name = name
+		}
+		at.Name = g.unique(at)
+	}
+
+	t := &namedType{Name: at.Name, Pkg: at.Pkg, Doc: s.Description, Deprecated: s.Deprecated, Component: at.Component}
+	if at.Component == "" {
+		g.inline[at.Pkg+" "+canon] = t
+	}
+	g.add(t)
+	build(t)
+	return &typeRef{Named: t, Nullable: nullable}
+}
+
+func (g *generator) fields(t *namedType, s *Schema, required func(string) bool, at site) []*field {
+	var fields []*field
+	for _, key := range s.Properties.Keys {
+		property := s.Properties.Values[key]
+		fields = append(fields, &field{
+			Name:       pascal(key),
+			JSON:       key,
+			Doc:        description(property),
+			Type:       g.resolve(property, at.property(t.Name, key)),
+			Required:   required(key),
+			Deprecated: property.Deprecated,
+		})
+	}
+	return fields
+}
+
+// allOf handles compositions. A single schema with annotations resolves to
+// that schema, otherwise a struct is generated that either embeds the
+// referenced schemas or, when required properties are changed, flattens them.
+func (g *generator) allOf(s *Schema, at site) *typeRef {
+	nullable := s.isNullable()
+	var parts []*Schema
+	for _, part := range s.AllOf {
+		if part.isAnnotation() {
+			nullable = nullable || part.isNullable()
+			continue
+		}
+		parts = append(parts, part)
+	}
+	if len(parts) == 1 && len(s.Properties.Keys) == 0 {
+		return g.resolve(parts[0], at).withNullable(nullable)
+	}
+
+	ref := g.named(s, at, func(t *namedType) {
+		t.Kind = kindStruct
+		if g.flattens(s, parts) {
+			g.flatten(t, s, parts, at)
+		} else {
+			g.embed(t, s, parts, at)
+		}
+	})
+	return ref.withNullable(nullable)
+}
+
+// flattens reports whether a part of the composition requires properties it
+// does not declare itself, which can only be expressed by copying the fields.
+func (g *generator) flattens(s *Schema, parts []*Schema) bool {
+	for _, part := range append([]*Schema{s}, parts...) {
+		if part.Ref != "" {
+			continue
+		}
+		for _, name := range part.Required {
+			if _, ok := part.Properties.Values[name]; !ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (g *generator) flatten(t *namedType, s *Schema, parts []*Schema, at site) {
+	merged := &Schema{Properties: ordered[*Schema]{Values: map[string]*Schema{}}}
+	var collect func(*Schema)
+	collect = func(part *Schema) {
+		if part.Ref != "" {
+			part = g.spec.Components.Schemas.Values[refName(part.Ref)]
+		}
+		if len(part.AnyOf) > 0 || len(part.OneOf) > 0 {
+			g.fail("cannot flatten union into %s", t.Name)
+		}
+		for _, sub := range part.AllOf {
+			collect(sub)
+		}
+		for _, key := range part.Properties.Keys {
+			if _, ok := merged.Properties.Values[key]; !ok {
+				merged.Properties.Keys = append(merged.Properties.Keys, key)
+			}
+			merged.Properties.Values[key] = part.Properties.Values[key]
+		}
+		merged.Required = append(merged.Required, part.Required...)
+		if part.forbidsAdditional() {
+			t.Strict = true
+		}
+	}
+	collect(s)
+	for _, part := range parts {
+		collect(part)
+	}
+	t.Fields = g.fields(t, merged, merged.isRequired, at)
+}
+
+func (g *generator) embed(t *namedType, s *Schema, parts []*Schema, at site) {
+	t.Fields = g.fields(t, s, s.isRequired, at)
+	t.Strict = true
+	for _, part := range parts {
+		if part.Ref == "" {
+			t.Fields = append(t.Fields, g.fields(t, part, part.isRequired, at)...)
+			t.Strict = t.Strict && part.forbidsAdditional()
+			continue
+		}
+		embedded := g.ref(refName(part.Ref))
+		if embedded.Named == nil || embedded.Named.Kind != kindStruct {
+			g.fail("cannot embed %s into %s", part.Ref, t.Name)
+		}
+		t.Embeds = append(t.Embeds, embedded.Named)
+		t.Strict = t.Strict && embedded.Named.Strict
+	}
+}
+
+// union handles anyOf and oneOf. Unions of strings collapse into a string
+// type, everything else becomes a struct holding one pointer per variant.
+func (g *generator) union(s *Schema, at site) *typeRef {
+	variants, keyword := s.AnyOf, "anyOf"
+	if len(s.OneOf) > 0 {
+		variants, keyword = s.OneOf, "oneOf"
+	}
+
+	nullable := s.isNullable()
+	var rest []*Schema
+	for _, v := range variants {
+		if v.isNull() {
+			nullable = true
+			continue
+		}
+		rest = append(rest, v)
+	}
+	if len(rest) == 1 {
+		return g.resolve(rest[0], at).withNullable(nullable)
+	}
+
+	if g.stringLike(s) {
+		if at.Component != "" {
+			return g.named(s, at, func(t *namedType) {
+				// a union of enums, like the vendors of all device types,
+				// lists the values of all of them
+				if values := g.unionValues(s); len(values) > 0 {
+					t.Kind = kindEnum
+					t.Values = values
+					return
+				}
+				t.Kind = kindDefined
+				t.Underlying = &typeRef{Builtin: "string"}
+			})
+		}
+		if component, ok := g.canon[s.canonical()]; ok {
+			return g.ref(component).withNullable(nullable)
+		}
+		return &typeRef{Builtin: "string", Nullable: nullable}
+	}
+
+	resolved := make([]*typeRef, len(rest))
+	same := true
+	for i, v := range rest {
+		name := fmt.Sprintf("%sVariant%d", at.Name, i+1)
+		if v.Title != "" {
+			words := strings.Fields(v.Title)
+			name = strings.TrimSuffix(at.Name, pascal(words[len(words)-1])) + pascal(v.Title)
+		}
+		resolved[i] = g.resolve(v, site{Name: name, Path: fmt.Sprintf("%s/%s/%d", at.Path, keyword, i), Pkg: at.Pkg})
+		if i > 0 && !sameType(resolved[0], resolved[i]) {
+			same = false
+		}
+	}
+	if same {
+		return resolved[0].withNullable(nullable)
+	}
+
+	return g.named(s, at, func(t *namedType) {
+		t.Kind = kindUnion
+		used := map[string]bool{}
+		for i, v := range rest {
+			name := variantName(resolved[i])
+			for n := 2; used[name]; n++ {
+				name = fmt.Sprintf("%s%d", variantName(resolved[i]), n)
+			}
+			used[name] = true
+			variant := &variant{Name: name, Type: &typeRef{Builtin: resolved[i].Builtin, Named: resolved[i].Named, Elem: resolved[i].Elem, MapValue: resolved[i].MapValue}}
+			t.Variants = append(t.Variants, variant)
+
+			if s.Discriminator != nil && v.Ref != "" {
+				for _, value := range s.Discriminator.Mapping.Keys {
+					if refName(s.Discriminator.Mapping.Values[value]) == refName(v.Ref) {
+						t.Mapping = append(t.Mapping, mappingEntry{Value: value, Variant: variant})
+					}
+				}
+			}
+		}
+		if s.Discriminator != nil {
+			t.Discriminator = s.Discriminator.PropertyName
+		}
+	}).withNullable(nullable)
+}
+
+// stringLike reports whether every variant of the schema is a string.
+func (g *generator) stringLike(s *Schema) bool {
+	if s.Ref != "" {
+		return g.stringLike(g.spec.Components.Schemas.Values[refName(s.Ref)])
+	}
+	variants := append(append([]*Schema{}, s.AnyOf...), s.OneOf...)
+	if len(variants) == 0 {
+		return s.Type.primary() == "string" && s.Format != "date-time"
+	}
+	for _, v := range variants {
+		if !v.isNull() && !g.stringLike(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// unionValues returns the values of a union whose variants are all enums of
+// strings, in order of their first occurrence, or nil for any other union.
+func (g *generator) unionValues(s *Schema) []string {
+	if s.Ref != "" {
+		return g.unionValues(g.spec.Components.Schemas.Values[refName(s.Ref)])
+	}
+	variants := append(append([]*Schema{}, s.AnyOf...), s.OneOf...)
+	if len(variants) == 0 {
+		return enumStrings(s)
+	}
+	var values []string
+	seen := map[string]bool{}
+	for _, v := range variants {
+		if v.isNull() {
+			continue
+		}
+		variantValues := g.unionValues(v)
+		if len(variantValues) == 0 {
+			return nil
+		}
+		for _, value := range variantValues {
+			if !seen[value] {
+				seen[value] = true
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+func sameType(a, b *typeRef) bool {
+	switch {
+	case a.Elem != nil || b.Elem != nil:
+		return a.Elem != nil && b.Elem != nil && sameType(a.Elem, b.Elem)
+	case a.MapValue != nil || b.MapValue != nil:
+		return a.MapValue != nil && b.MapValue != nil && sameType(a.MapValue, b.MapValue)
+	}
+	return a.Builtin == b.Builtin && a.Named == b.Named
+}
+
+func variantName(t *typeRef) string {
+	switch {
+	case t.Named != nil:
+		return t.Named.Name
+	case t.Elem != nil:
+		return variantName(t.Elem) + "List"
+	case t.MapValue != nil:
+		return variantName(t.MapValue) + "Map"
+	}
+	return pascal(strings.TrimPrefix(t.Builtin, "time."))
+}
+
+func enumStrings(s *Schema) []string {
+	var values []string
+	for _, value := range s.Enum {
+		if str, ok := value.(string); ok {
+			values = append(values, str)
+		}
+	}
+	return values
+}
+
+// description returns the documentation of a schema, including annotations
+// attached through allOf.
+func description(s *Schema) string {
+	if s.Description != "" {
+		return s.Description
+	}
+	for _, part := range s.AllOf {
+		if part.isAnnotation() && part.Description != "" {
+			return part.Description
+		}
+	}
+	return ""
+}
+
+// requiredKeys returns the JSON properties a struct type always carries.
+func requiredKeys(t *namedType) []string {
+	var keys []string
+	for _, embedded := range t.Embeds {
+		keys = append(keys, requiredKeys(embedded)...)
+	}
+	for _, f := range t.Fields {
+		if f.Required {
+			keys = append(keys, f.JSON)
+		}
+	}
+	return keys
+}
+
+// collectSchemaRefs records every component referenced from schemas, request
+// bodies or responses, as opposed to components only used by parameters.
+func (g *generator) collectSchemaRefs(raw json.RawMessage) {
+	var walk func(value any, inParameter bool)
+	walk = func(value any, inParameter bool) {
+		switch v := value.(type) {
+		case map[string]any:
+			if ref, ok := v["$ref"].(string); ok && !inParameter && strings.HasPrefix(ref, "#/components/schemas/") {
+				g.schemaRef[refName(ref)] = true
+			}
+			for key, item := range v {
+				walk(item, inParameter || key == "parameters")
+			}
+		case []any:
+			for _, item := range v {
+				walk(item, inParameter)
+			}
+		}
+	}
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		g.fail("%v", err)
+	}
+	walk(value, false)
+}
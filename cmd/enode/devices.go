@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/actions"
+	"github.com/addihorn/enode-gosdk/pkg/chargers"
+	"github.com/addihorn/enode-gosdk/pkg/hvacs"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+)
+
+// deviceGroup describes the commands of a device type. Devices without
+// charging support have no charge function.
+type deviceGroup struct {
+	name    string
+	columns []column
+	// list returns the data and the cursor of the next page, of all users if userId is empty.
+	list   func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) (any, *string, error)
+	get    func(ctx context.Context, sess *session.Session, id string) (any, error)
+	charge func(ctx context.Context, sess *session.Session, id string, action models.ChargingAction) (*actions.Action[models.ChargeAction], error)
+}
+
+var actionColumns = []column{
+	{"ID", "id"},
+	{"TARGET", "targetId"},
+	{"KIND", "kind"},
+	{"STATE", "state"},
+	{"FAILURE", "failureReason.type"},
+}
+
+var deviceGroups = map[string]*deviceGroup{
+	"vehicles": {
+		name: "vehicles",
+		columns: []column{
+			{"ID", "id"},
+			{"USER", "userId"},
+			{"VENDOR", "vendor"},
+			{"MODEL", "information.model"},
+			{"REACHABLE", "isReachable"},
+			{"BATTERY", "chargeState.batteryLevel"},
+			{"PLUGGED IN", "chargeState.isPluggedIn"},
+			{"CHARGING", "chargeState.isCharging"},
+		},
+		list: func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) (any, *string, error) {
+			var page *models.PaginatedVehicleList
+			var err error
+			if userId == "" {
+				page, err = vehicles.ListVehicles(ctx, sess, params)
+			} else {
+				page, err = vehicles.ListUserVehicles(ctx, sess, userId, params)
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Data, page.Pagination.After, nil
+		},
+		get: func(ctx context.Context, sess *session.Session, id string) (any, error) {
+			return vehicles.GetVehicle(ctx, sess, id)
+		},
+		charge: func(ctx context.Context, sess *session.Session, id string, action models.ChargingAction) (*actions.Action[models.ChargeAction], error) {
+			created, err := vehicles.ControlVehicleCharging(ctx, sess, id, &models.ControlChargingPayload{Action: action})
+			if err != nil {
+				return nil, err
+			}
+			return actions.VehicleCharging(sess, created), nil
+		},
+	},
+	"chargers": {
+		name: "chargers",
+		columns: []column{
+			{"ID", "id"},
+			{"USER", "userId"},
+			{"VENDOR", "vendor"},
+			{"MODEL", "information.model"},
+			{"REACHABLE", "isReachable"},
+			{"PLUGGED IN", "chargeState.isPluggedIn"},
+			{"CHARGING", "chargeState.isCharging"},
+			{"RATE", "chargeState.chargeRate"},
+		},
+		list: func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) (any, *string, error) {
+			var page *models.PaginatedChargerList
+			var err error
+			if userId == "" {
+				page, err = chargers.ListChargers(ctx, sess, params)
+			} else {
+				page, err = chargers.ListUserChargers(ctx, sess, userId, params)
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Data, page.Pagination.After, nil
+		},
+		get: func(ctx context.Context, sess *session.Session, id string) (any, error) {
+			return chargers.GetCharger(ctx, sess, id)
+		},
+		charge: func(ctx context.Context, sess *session.Session, id string, action models.ChargingAction) (*actions.Action[models.ChargeAction], error) {
+			created, err := chargers.ControlChargerCharging(ctx, sess, id, &models.ControlChargingPayload{Action: action})
+			if err != nil {
+				return nil, err
+			}
+			return actions.ChargerCharging(sess, created), nil
+		},
+	},
+	"hvacs": {
+		name: "hvacs",
+		columns: []column{
+			{"ID", "id"},
+			{"USER", "userId"},
+			{"VENDOR", "vendor"},
+			{"MODEL", "information.model"},
+			{"REACHABLE", "isReachable"},
+			{"MODE", "thermostatState.mode"},
+			{"TEMPERATURE", "temperatureState.currentTemperature"},
+		},
+		list: func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) (any, *string, error) {
+			var page *models.PaginatedHVACList
+			var err error
+			if userId == "" {
+				page, err = hvacs.ListHVACs(ctx, sess, params)
+			} else {
+				page, err = hvacs.ListUserHVACs(ctx, sess, userId, params)
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.Data, page.Pagination.After, nil
+		},
+		get: func(ctx context.Context, sess *session.Session, id string) (any, error) {
+			return hvacs.GetHVAC(ctx, sess, id)
+		},
+	},
+}
+
+func (c *cli) devices(group *deviceGroup, command string, args []string) error {
+	switch command {
+	case "list":
+		return c.listDevices(group, args)
+	case "get":
+		return c.getDevice(group, args)
+	case "charge":
+		return c.chargeDevice(group, command, models.CHARGING_ACTION_START, args)
+	case "stop":
+		return c.chargeDevice(group, command, models.CHARGING_ACTION_STOP, args)
+	}
+	return usagef("unknown command %s %s", group.name, command)
+}
+
+func (c *cli) listDevices(group *deviceGroup, args []string) error {
+	fs := c.flags(group.name + " list")
+	userId := fs.String("user", "", "only list the devices of this user")
+	pageSize := fs.Int("page-size", 50, "number of devices per page")
+	after := fs.String("after", "", "cursor of the page to list, as printed for further pages")
+	if _, err := parse(fs, args); err != nil {
+		return err
+	}
+	sess, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	params := &models.PaginationParams{PageSize: pageSize}
+	if *after != "" {
+		params.After = after
+	}
+	data, next, err := group.list(c.ctx, sess, *userId, params)
+	if err != nil {
+		return err
+	}
+	if err := c.print(data, group.columns); err != nil {
+		return err
+	}
+	if next != nil {
+		fmt.Fprintf(c.stderr, "more %s available, list them with -after %s\n", group.name, *next)
+	}
+	return nil
+}
+
+func (c *cli) getDevice(group *deviceGroup, args []string) error {
+	fs := c.flags(group.name + " get")
+	positional, err := parse(fs, args, "id")
+	if err != nil {
+		return err
+	}
+	sess, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	device, err := group.get(c.ctx, sess, positional[0])
+	if err != nil {
+		return err
+	}
+	return c.print(device, group.columns)
+}
+
+func (c *cli) chargeDevice(group *deviceGroup, command string, kind models.ChargingAction, args []string) error {
+	if group.charge == nil {
+		return usagef("%s do not support charging", group.name)
+	}
+	fs := c.flags(group.name + " " + command)
+	wait := fs.Bool("wait", false, "wait until the action is confirmed, failed or cancelled")
+	timeout := fs.Duration("timeout", 5*time.Minute, "maximum time to wait with -wait")
+	positional, err := parse(fs, args, "id")
+	if err != nil {
+		return err
+	}
+	sess, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	action, err := group.charge(c.ctx, sess, positional[0], kind)
+	if err != nil {
+		return err
+	}
+	if *wait {
+		ctx, cancel := context.WithTimeout(c.ctx, *timeout)
+		defer cancel()
+		_, err = action.Wait(ctx)
+	}
+	if printErr := c.print(action.Current, actionColumns); printErr != nil {
+		return printErr
+	}
+	return err
+}
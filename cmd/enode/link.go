@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"slices"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/batteries"
+	"github.com/addihorn/enode-gosdk/pkg/inverters"
+	"github.com/addihorn/enode-gosdk/pkg/meters"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/users"
+)
+
+const (
+	// DISCOVERY_INTERVAL is the delay between two lookups of devices discovered by a link.
+	DISCOVERY_INTERVAL = 2 * time.Second
+	// DISCOVERY_TIMEOUT is the default time to wait for new devices after the redirect.
+	// Re-linking a vendor which is already linked discovers no new devices.
+	DISCOVERY_TIMEOUT = 30 * time.Second
+)
+
+// LinkResult is the outcome of an interactive link.
+type LinkResult struct {
+	UserId string `json:"userId"`
+	// Status is "success", or "error" if the user left Link UI without linking.
+	Status       string `json:"status"`
+	Error        string `json:"error,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	// Devices lists the devices of the linked type which were not known before.
+	// It is empty if none were discovered in time after the redirect.
+	Devices []DiscoveredDevice `json:"devices"`
+}
+
+type DiscoveredDevice struct {
+	Type   string `json:"type"`
+	Id     string `json:"id"`
+	Vendor string `json:"vendor"`
+}
+
+var discoveredColumns = []column{
+	{"TYPE", "type"},
+	{"ID", "id"},
+	{"VENDOR", "vendor"},
+}
+
+// lister lists a page of the devices of a user, as returned by the ListUser operations.
+type lister func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) (any, *string, error)
+
+// listers holds a lister per vendor type, for the device types without command group.
+var listers = map[string]lister{
+	"battery": func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) (any, *string, error) {
+		page, err := batteries.ListUserBatteries(ctx, sess, userId, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.Data, page.Pagination.After, nil
+	},
+	"inverter": func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) (any, *string, error) {
+		page, err := inverters.ListUserInverters(ctx, sess, userId, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.Data, page.Pagination.After, nil
+	},
+	"meter": func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) (any, *string, error) {
+		page, err := meters.ListUserMeters(ctx, sess, userId, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.Data, page.Pagination.After, nil
+	},
+}
+
+func listerOf(vendorType string) (lister, bool) {
+	if list, ok := listers[vendorType]; ok {
+		return list, true
+	}
+	if group, ok := deviceGroups[vendorType+"s"]; ok {
+		return group.list, true
+	}
+	return nil, false
+}
+
+// openBrowser opens a URL in the default browser of the system.
+func openBrowser(target string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", target).Start()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", target).Start()
+	}
+	return exec.Command("xdg-open", target).Start()
+}
+
+/*
+Links devices to a user interactively: Link UI is opened in the browser and the
+redirect at the end of the flow is captured by a temporary listener on 127.0.0.1.
+
+Parameters:
+  - sess: The session of the client.
+  - user: The user to link devices to.
+  - data: The link request. RedirectUri must point to a free port on localhost,
+    or be empty to pick one.
+  - timeout: The maximum time to wait for the user to complete Link UI.
+  - discovery: The maximum time to wait for new devices after the redirect.
+
+Returns:
+  - The outcome of the link, including the devices discovered after linking.
+  - An error if Link UI could not be started or the user did not complete it in time.
+*/
+func (c *cli) interactiveLink(sess *session.Session, user *users.User, data *users.LinkData, timeout, discovery time.Duration) (*LinkResult, error) {
+	list, ok := listerOf(string(data.VendorType))
+	if !ok {
+		return nil, usagef("unknown vendor type %q", data.VendorType)
+	}
+	known, err := listDevices(c.ctx, sess, list, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := listen(data)
+	if err != nil {
+		return nil, err
+	}
+	redirect, err := url.Parse(data.RedirectUri)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+	redirects := make(chan url.Values, 1)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// other requests, e.g. for /favicon.ico, are not the redirect
+		if r.URL.Path != redirectPath(redirect) {
+			http.NotFound(w, r)
+			return
+		}
+		select {
+		case redirects <- r.URL.Query():
+		default:
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, "<!DOCTYPE html><title>Enode</title><p>Linking finished, you can close this window and return to the terminal.</p>")
+	})}
+	go server.Serve(listener)
+	defer server.Close()
+
+	if err := user.LinkContext(c.ctx, sess, data); err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(c.stderr, "Opening Link UI, complete it in your browser:\n%s\n", data.LinkAccessData.LinkUrl)
+	if err := c.open(data.LinkAccessData.LinkUrl); err != nil {
+		fmt.Fprintf(c.stderr, "could not open the browser, open the URL manually: %s\n", err)
+	}
+
+	ctx, cancel := context.WithTimeout(c.ctx, timeout)
+	defer cancel()
+	var query url.Values
+	select {
+	case query = <-redirects:
+	case <-ctx.Done():
+		return nil, errors.Join(errors.New("link was not completed"), ctx.Err())
+	}
+
+	result := &LinkResult{UserId: user.Id, Status: "success", Devices: []DiscoveredDevice{}}
+	if query.Get("error") != "" {
+		result.Status, result.Error, result.ErrorMessage = "error", query.Get("error"), query.Get("error_message")
+		return result, nil
+	}
+
+	// devices may be discovered shortly after the redirect
+	ctx, cancel = context.WithTimeout(c.ctx, discovery)
+	defer cancel()
+	for {
+		devices, err := listDevices(ctx, sess, list, user.Id)
+		if ctx.Err() != nil {
+			// the discovery timeout ends the link without devices, not with an error
+			return result, nil
+		}
+		if err != nil {
+			return result, err
+		}
+		for _, device := range devices {
+			if !slices.ContainsFunc(known, func(k DiscoveredDevice) bool { return k.Id == device.Id }) {
+				device.Type = string(data.VendorType)
+				result.Devices = append(result.Devices, device)
+			}
+		}
+		if len(result.Devices) > 0 {
+			return result, nil
+		}
+
+		timer := time.NewTimer(DISCOVERY_INTERVAL)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, nil
+		case <-timer.C:
+		}
+	}
+}
+
+// listen opens the listener for the redirect URI, choosing a free port on localhost if it is empty.
+func listen(data *users.LinkData) (net.Listener, error) {
+	if data.RedirectUri == "" {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return nil, err
+		}
+		// localhost may resolve to ::1, which is not listened on
+		data.RedirectUri = fmt.Sprintf("http://127.0.0.1:%d/callback", listener.Addr().(*net.TCPAddr).Port)
+		return listener, nil
+	}
+
+	redirect, err := url.Parse(data.RedirectUri)
+	if err != nil {
+		return nil, err
+	}
+	if host := redirect.Hostname(); host != "localhost" && !net.ParseIP(host).IsLoopback() {
+		return nil, usagef("the redirect URI must point to localhost to capture the redirect, got %s", data.RedirectUri)
+	}
+	port := redirect.Port()
+	if port == "" {
+		port = "80"
+	}
+	return net.Listen("tcp", net.JoinHostPort("127.0.0.1", port))
+}
+
+// redirectPath returns the path the redirect to uri is requested with.
+func redirectPath(uri *url.URL) string {
+	if uri.Path == "" {
+		return "/"
+	}
+	return uri.Path
+}
+
+// listDevices returns all devices of a user listed by list.
+func listDevices(ctx context.Context, sess *session.Session, list lister, userId string) ([]DiscoveredDevice, error) {
+	var devices []DiscoveredDevice
+	params := &models.PaginationParams{}
+	for {
+		data, next, err := list(ctx, sess, userId, params)
+		if err != nil {
+			return nil, err
+		}
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		var page []DiscoveredDevice
+		if err := json.Unmarshal(raw, &page); err != nil {
+			return nil, err
+		}
+		devices = append(devices, page...)
+		if next == nil {
+			return devices, nil
+		}
+		params.After = next
+	}
+}
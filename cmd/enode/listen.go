@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/webhooks"
+)
+
+// WEBHOOK_SECRET_ENV holds the webhook secret if -secret is not given.
+const WEBHOOK_SECRET_ENV = "ENODE_WEBHOOK_SECRET"
+
+// eventLog prints, forwards and persists the events received by webhooks listen.
+type eventLog struct {
+	c       *cli
+	secret  string
+	forward string
+	out     io.Writer
+
+	// mu serializes the output of concurrent deliveries
+	mu sync.Mutex
+}
+
+func (l *eventLog) handle(ctx context.Context, event *webhooks.Event) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.c.printEvent(event); err != nil {
+		return err
+	}
+	if l.out != nil {
+		var line bytes.Buffer
+		if err := json.Compact(&line, event.Raw); err != nil {
+			return err
+		}
+		line.WriteByte('\n')
+		if _, err := line.WriteTo(l.out); err != nil {
+			return err
+		}
+	}
+	if l.forward != "" {
+		// the local application being down must not make Enode redeliver the event
+		if err := deliver(ctx, l.forward, l.secret, event.Raw); err != nil {
+			fmt.Fprintf(l.c.stderr, "could not forward event to %s: %s\n", l.forward, err)
+		}
+	}
+	return nil
+}
+
+// printEvent writes a received event: a summary line and the indented payload
+// as table output, one line per event as JSON, or one document per event as YAML.
+func (c *cli) printEvent(event *webhooks.Event) error {
+	switch c.output {
+	case FORMAT_JSON:
+		var line bytes.Buffer
+		if err := json.Compact(&line, event.Raw); err != nil {
+			return err
+		}
+		line.WriteByte('\n')
+		_, err := line.WriteTo(c.stdout)
+		return err
+	case FORMAT_YAML:
+		generic, err := decode(event.Raw)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(c.stdout, "---")
+		return writeYAML(c.stdout, generic)
+	case FORMAT_TABLE, "":
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, event.Raw, "  ", "  "); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintf(c.stdout, "%s %s user=%s\n  %s\n\n",
+			event.CreatedAt.Format(time.RFC3339), event.Event, cell(event.User.Id), indented.String())
+		return err
+	}
+	return usagef("unknown output format %q", c.output)
+}
+
+// deliver posts an event to a webhook URL, signed like a delivery by Enode.
+func deliver(ctx context.Context, target string, secret string, event json.RawMessage) error {
+	body, err := json.Marshal([]json.RawMessage{event})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhooks.SIGNATURE_HEADER, webhooks.Sign(secret, body))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s responded with %s", target, resp.Status)
+	}
+	return nil
+}
+
+func (c *cli) webhookSecret(secret string) (string, error) {
+	if secret == "" {
+		secret = c.getenv(WEBHOOK_SECRET_ENV)
+	}
+	if secret == "" {
+		return "", usagef("the webhook secret is required, set -secret or %s", WEBHOOK_SECRET_ENV)
+	}
+	return secret, nil
+}
+
+func (c *cli) listenWebhooks(args []string) error {
+	fs := c.flags("webhooks listen")
+	addr := fs.String("addr", "127.0.0.1", "address to listen on, e.g. 0.0.0.0 to accept deliveries from other hosts")
+	port := fs.Int("port", 8080, "port to listen on, a free port if 0")
+	path := fs.String("path", "/", "path of the webhook URL")
+	secret := fs.String("secret", "", "secret of the webhook, "+WEBHOOK_SECRET_ENV+" if empty")
+	forward := fs.String("forward", "", "URL to forward each event to, signed with the same secret")
+	out := fs.String("out", "", "JSONL file the events are appended to, for webhooks replay")
+	if _, err := parse(fs, args); err != nil {
+		return err
+	}
+	key, err := c.webhookSecret(*secret)
+	if err != nil {
+		return err
+	}
+
+	log := &eventLog{c: c, secret: key, forward: *forward}
+	if *out != "" {
+		file, err := os.OpenFile(*out, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		log.out = file
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(*addr, strconv.Itoa(*port)))
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(*path, &webhooks.Receiver{Secret: key, Handle: log.handle})
+	server := &http.Server{Handler: mux}
+
+	fmt.Fprintf(c.stderr, "Listening for webhook events on http://%s%s, press Ctrl+C to stop\n",
+		listener.Addr(), *path)
+	done := make(chan error, 1)
+	go func() { done <- server.Serve(listener) }()
+
+	select {
+	case err := <-done:
+		return err
+	case <-c.ctx.Done():
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return server.Shutdown(ctx)
+}
+
+func (c *cli) replayWebhooks(args []string) error {
+	var filter stringList
+	fs := c.flags("webhooks replay")
+	target := fs.String("url", "", "URL the events are sent to")
+	secret := fs.String("secret", "", "secret the events are signed with, "+WEBHOOK_SECRET_ENV+" if empty")
+	fs.Var(&filter, "event", "event to replay, may be repeated, all events if omitted")
+	positional, err := parse(fs, args, "file")
+	if err != nil {
+		return err
+	}
+	if *target == "" {
+		return usagef("webhooks replay requires -url")
+	}
+	key, err := c.webhookSecret(*secret)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(positional[0])
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	replayed := 0
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, webhooks.MAX_BODY_SIZE)
+	for line := 1; scanner.Scan(); line++ {
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		var event webhooks.Event
+		if err := json.Unmarshal(raw, &event); err != nil {
+			return errors.Join(fmt.Errorf("%s:%d", positional[0], line), err)
+		}
+		if len(filter) > 0 && !slices.Contains(filter, event.Event) {
+			continue
+		}
+		if err := deliver(c.ctx, *target, key, raw); err != nil {
+			return errors.Join(fmt.Errorf("%s:%d", positional[0], line), err)
+		}
+		replayed++
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stderr, "replayed %d events to %s\n", replayed, *target)
+	return nil
+}
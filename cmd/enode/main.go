@@ -0,0 +1,240 @@
+// Command enode manages the users, devices and webhooks of an Enode client
+// from the command line.
+//
+// The client is configured like .env.example, from the environment or a .env
+// file in the working directory:
+//
+//	ENODE_ENVIRONMENT    SANDBOX, PRODUCTION or the URL of the API
+//	ENODE_CLIENT_ID      the client ID
+//	ENODE_CLIENT_SECRET  the client secret
+//
+// Several clients are configured as profiles, selected with -profile or
+// ENODE_PROFILE, see package profiles.
+//
+// Usage:
+//
+//	enode <group> <command> [flags] [arguments]
+//
+// Results are printed as table, or with -o json or -o yaml for scripts.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/profiles"
+	"github.com/addihorn/enode-gosdk/pkg/ratelimit"
+	"github.com/addihorn/enode-gosdk/pkg/retry"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/joho/godotenv"
+)
+
+const usage = `Usage: enode <group> <command> [flags] [arguments]
+
+Groups and commands:
+  users     list | get <userId> | link <userId> | unlink <userId>
+            deauthorize <userId> | disconnect <userId>
+  vehicles  list | get <vehicleId> | charge <vehicleId> | stop <vehicleId>
+  chargers  list | get <chargerId> | charge <chargerId> | stop <chargerId>
+  hvacs     list | get <hvacId>
+  webhooks  list | create | test <webhookId> | delete <webhookId>
+            listen | replay <file>
+
+Run enode <group> <command> -h for the flags of a command.
+
+Environment:
+  ENODE_ENVIRONMENT     SANDBOX (default), PRODUCTION or the URL of the API
+  ENODE_TOKEN_URL       token URL, if it differs from the one of the environment
+  ENODE_CLIENT_ID       client ID
+  ENODE_CLIENT_SECRET   client secret
+  ENODE_API_VERSION     API version, the SDK default if empty
+  ENODE_PROFILE         profile to use, read from ENODE_PROFILE_<NAME>_* variables
+  ENODE_PROFILES        JSON file of profiles, see package profiles
+  ENODE_TOKEN_CACHE     directory of cached tokens, off to disable
+  ENODE_RATE_LIMIT      quota of the client, e.g. 300/1m or 300/1m,10 with a burst,
+                        overrides the rate limit of a profile
+  ENODE_WEBHOOK_SECRET  secret of webhooks listen and replay
+`
+
+// usageError is returned for invalid command lines, which exit with status 2.
+type usageError struct {
+	message string
+}
+
+func (e *usageError) Error() string {
+	return e.message
+}
+
+func usagef(format string, args ...any) error {
+	return &usageError{message: fmt.Sprintf(format, args...)}
+}
+
+func main() {
+	// a .env file is optional, the environment may hold the configuration
+	godotenv.Load()
+
+	// Ctrl+C stops long running commands like webhooks listen
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+
+	c := &cli{ctx: ctx, stdout: os.Stdout, stderr: os.Stderr, getenv: os.Getenv, open: openBrowser}
+	err := c.run(os.Args[1:])
+	stop()
+
+	var usageErr *usageError
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+	case errors.As(err, &usageErr):
+		fmt.Fprintf(os.Stderr, "enode: %s\n\n%s", err, usage)
+		os.Exit(2)
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "enode: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+type cli struct {
+	ctx    context.Context
+	stdout io.Writer
+	stderr io.Writer
+	getenv func(string) string
+	// open shows a URL to the user, usually in the browser
+	open func(url string) error
+
+	// output is the format selected with -o
+	output string
+	// profile is the client profile selected with -profile
+	profile string
+	sess    *session.Session
+}
+
+func (c *cli) run(args []string) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		fmt.Fprint(c.stdout, usage)
+		return nil
+	}
+	if len(args) < 2 {
+		return usagef("missing command for %s", args[0])
+	}
+
+	group, command, args := args[0], args[1], args[2:]
+	switch group {
+	case "users":
+		return c.users(command, args)
+	case "vehicles", "chargers", "hvacs":
+		return c.devices(deviceGroups[group], command, args)
+	case "webhooks":
+		return c.webhooks(command, args)
+	}
+	return usagef("unknown group %q", group)
+}
+
+// flags returns the flag set of a command, including the output flag.
+func (c *cli) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("enode "+name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.StringVar(&c.output, "output", FORMAT_TABLE, "output format: table, json or yaml")
+	fs.StringVar(&c.output, "o", FORMAT_TABLE, "shorthand for -output")
+	fs.StringVar(&c.profile, "profile", "", "profile to use, ENODE_PROFILE if empty")
+	return fs
+}
+
+// parse parses flags and arguments in any order and checks the number of arguments.
+func parse(fs *flag.FlagSet, args []string, names ...string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	if len(positional) != len(names) {
+		expected := "no arguments"
+		if len(names) > 0 {
+			expected = "<" + strings.Join(names, "> <") + ">"
+		}
+		return nil, usagef("%s expects %s", fs.Name(), expected)
+	}
+	return positional, nil
+}
+
+// session authenticates the selected profile on first use, retrying throttled and failed requests.
+func (c *cli) session() (*session.Session, error) {
+	if c.sess != nil {
+		return c.sess, nil
+	}
+	name := c.profile
+	if name == "" {
+		name = c.getenv("ENODE_PROFILE")
+	}
+	if value := c.getenv("ENODE_RATE_LIMIT"); value != "" {
+		if _, err := ratelimit.ParseLimit(value); err != nil {
+			return nil, usagef("invalid ENODE_RATE_LIMIT: %s", err)
+		}
+	}
+
+	var profile *profiles.Profile
+	if path := c.getenv("ENODE_PROFILES"); path != "" {
+		all, err := profiles.Load(path)
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			name = profiles.DEFAULT_PROFILE
+		}
+		if profile = all[name]; profile == nil {
+			return nil, usagef("unknown profile %q in %s", name, path)
+		}
+	} else {
+		var err error
+		if profile, err = profiles.FromEnv(name, c.getenv); err != nil {
+			return nil, err
+		}
+	}
+
+	// short-lived invocations reuse the token of the previous one
+	switch dir := c.getenv("ENODE_TOKEN_CACHE"); dir {
+	case "off":
+	case "":
+		if cache, err := auth.DefaultTokenCache(); err == nil {
+			profile.TokenCache = cache
+		}
+	default:
+		profile.TokenCache = &auth.FileTokenCache{Dir: dir}
+	}
+
+	// requests are throttled on the client only if the quota is configured
+	if value := c.getenv("ENODE_RATE_LIMIT"); value != "" {
+		profile.RateLimit = value
+	}
+	client := &http.Client{Transport: retry.NewTransport(nil)}
+	sess, err := profile.Session(client)
+	if err != nil {
+		return nil, err
+	}
+	c.sess = sess
+	return c.sess, nil
+}
+
+// stringList is a flag which may be given several times.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
@@ -0,0 +1,383 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/enodetest"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/webhooks"
+)
+
+func newCLI(t *testing.T) (*enodetest.Server, *cli, func(args ...string) (string, error)) {
+	server := enodetest.NewServer()
+	t.Cleanup(server.Close)
+	env := map[string]string{
+		"ENODE_ENVIRONMENT":   server.URL,
+		"ENODE_CLIENT_ID":     enodetest.CLIENT_ID,
+		"ENODE_CLIENT_SECRET": enodetest.CLIENT_SECRET,
+		"ENODE_TOKEN_CACHE":   t.TempDir(),
+	}
+
+	c := &cli{ctx: context.Background(), getenv: func(key string) string { return env[key] }, open: completeLink("")}
+	return server, c, func(args ...string) (string, error) {
+		var stdout, stderr bytes.Buffer
+		c.stdout, c.stderr = &stdout, &stderr
+		err := c.run(args)
+		return stdout.String(), err
+	}
+}
+
+func TestCLI_Users(t *testing.T) {
+	server, _, run := newCLI(t)
+	server.AddVehicle("user_1", models.VehicleWithLocation{})
+	server.AddUser("user_2")
+
+	out, err := run("users", "list")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "ID") || !strings.HasPrefix(lines[1], "user_1") {
+		t.Errorf("Unexpected table:\n%s", out)
+	}
+
+	out, err = run("users", "get", "user_1", "-o", "json")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var user struct {
+		Id            string
+		LinkedVendors []struct{ Vendor string }
+	}
+	if err := json.Unmarshal([]byte(out), &user); err != nil || user.Id != "user_1" || len(user.LinkedVendors) != 1 {
+		t.Errorf("Unexpected JSON output %q: %v", out, err)
+	}
+
+	out, err = run("users", "link", "user_3", "-type", "vehicle", "-scope", "vehicle:read:data", "-redirect", "http://localhost:3000", "-url-only", "-o", "yaml")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "linkUrl: http://127.0.0.1") {
+		t.Errorf("Unexpected YAML output:\n%s", out)
+	}
+
+	if _, err := run("users", "unlink", "user_2"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := run("users", "get", "user_2"); err == nil {
+		t.Error("Expected an error for an unlinked user")
+	}
+}
+
+// completeLink returns a browser replacement completing Link UI of the fake
+// server, or leaving it with the given error.
+func TestCLI_RateLimit(t *testing.T) {
+	server, c, run := newCLI(t)
+	server.AddUser("user_1")
+	getenv := c.getenv
+	limit := "300"
+	c.getenv = func(key string) string {
+		if key == "ENODE_RATE_LIMIT" {
+			return limit
+		}
+		return getenv(key)
+	}
+
+	var usageErr *usageError
+	if _, err := run("users", "list"); !errors.As(err, &usageErr) {
+		t.Errorf("Expected a usage error for an invalid limit, got %v", err)
+	}
+	limit = "100/1s,5"
+	if _, err := run("users", "list"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func completeLink(linkError string) func(string) error {
+	return func(linkUrl string) error {
+		if linkError != "" {
+			linkUrl += "?error=" + linkError
+		}
+		go func() {
+			if resp, err := http.Get(linkUrl); err == nil {
+				resp.Body.Close()
+			}
+		}()
+		return nil
+	}
+}
+
+func TestCLI_Link(t *testing.T) {
+	server, _, run := newCLI(t)
+	server.AddVehicle("user_1", models.VehicleWithLocation{})
+
+	out, err := run("users", "link", "user_1", "-type", "vehicle", "-scope", "vehicle:read:data", "-o", "json")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var result LinkResult
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("Unexpected JSON output %q: %v", out, err)
+	}
+	if result.Status != "success" || len(result.Devices) != 1 || result.Devices[0].Type != "vehicle" {
+		t.Errorf("Expected the new vehicle only, got %+v", result)
+	}
+}
+
+func TestCLI_LinkNoNewDevices(t *testing.T) {
+	server, c, run := newCLI(t)
+	server.AddVehicle("user_1", models.VehicleWithLocation{})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	redirect := fmt.Sprintf("http://127.0.0.1:%d/callback", listener.Addr().(*net.TCPAddr).Port)
+	listener.Close()
+
+	// the vendor is linked already: the user is redirected without a new device,
+	// after a stray request which is not the redirect
+	c.open = func(string) error {
+		go func() {
+			for _, target := range []string{"/favicon.ico", "/callback"} {
+				resp, err := http.Get(strings.TrimSuffix(redirect, "/callback") + target)
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+					return
+				}
+				resp.Body.Close()
+				if target == "/favicon.ico" && resp.StatusCode != http.StatusNotFound {
+					t.Errorf("Expected %s to be ignored, got %s", target, resp.Status)
+				}
+			}
+		}()
+		return nil
+	}
+	start := time.Now()
+	out, err := run("users", "link", "user_1", "-type", "vehicle", "-scope", "vehicle:read:data", "-redirect", redirect, "-discovery-timeout", "100ms", "-o", "json")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected discovery to end after its timeout, took %s", elapsed)
+	}
+	var result LinkResult
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("Unexpected JSON output %q: %v", out, err)
+	}
+	if result.Status != "success" || len(result.Devices) != 0 {
+		t.Errorf("Expected no new devices, got %+v", result)
+	}
+	if stderr := c.stderr.(*bytes.Buffer).String(); !strings.Contains(stderr, "no new devices") {
+		t.Errorf("Expected no new devices to be reported, got %q", stderr)
+	}
+}
+
+func TestCLI_LinkSlowDiscovery(t *testing.T) {
+	server, c, run := newCLI(t)
+	server.AddVehicle("user_1", models.VehicleWithLocation{})
+
+	// the devices are listed slower than the discovery timeout after the redirect
+	c.open = func(linkUrl string) error {
+		server.Inject(enodetest.Fault{Method: http.MethodGet, Path: "/users/user_1/vehicles", Latency: time.Second})
+		return completeLink("")(linkUrl)
+	}
+	out, err := run("users", "link", "user_1", "-type", "vehicle", "-scope", "vehicle:read:data", "-discovery-timeout", "100ms", "-o", "json")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var result LinkResult
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("Unexpected JSON output %q: %v", out, err)
+	}
+	if result.Status != "success" || len(result.Devices) != 0 {
+		t.Errorf("Expected a link without new devices, got %+v", result)
+	}
+}
+
+func TestCLI_LinkCancelled(t *testing.T) {
+	_, c, run := newCLI(t)
+	c.open = completeLink("user_cancelled")
+
+	out, err := run("users", "link", "user_1", "-type", "charger", "-scope", "charger:read:data", "-o", "yaml")
+	if err == nil {
+		t.Error("Expected an error for a cancelled link")
+	}
+	if !strings.Contains(out, "error: user_cancelled") || !strings.Contains(out, "devices: []") {
+		t.Errorf("Unexpected YAML output:\n%s", out)
+	}
+}
+
+func TestCLI_Devices(t *testing.T) {
+	server, _, run := newCLI(t)
+	vehicle := server.AddVehicle("user_1", models.VehicleWithLocation{})
+	server.AddCharger("user_1", models.Charger{})
+
+	out, err := run("chargers", "list", "-user", "user_1", "-o", "json")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var chargers []models.Charger
+	if err := json.Unmarshal([]byte(out), &chargers); err != nil || len(chargers) != 1 {
+		t.Errorf("Unexpected JSON output %q: %v", out, err)
+	}
+
+	out, err = run("vehicles", "charge", vehicle.Id, "-wait")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "START") || !strings.Contains(out, "CONFIRMED") {
+		t.Errorf("Unexpected table:\n%s", out)
+	}
+
+	var usageErr *usageError
+	if _, err := run("hvacs", "charge", "hvac_1"); !errors.As(err, &usageErr) {
+		t.Errorf("Expected a usage error, got %v", err)
+	}
+	if _, err := run("vehicles", "get"); !errors.As(err, &usageErr) {
+		t.Errorf("Expected a usage error, got %v", err)
+	}
+}
+
+func TestCLI_Webhooks(t *testing.T) {
+	_, _, run := newCLI(t)
+
+	out, err := run("webhooks", "create", "-url", "http://127.0.0.1:1/hook", "-secret", "test_secret", "-event", "user:vehicle:discovered", "-o", "json")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var webhook models.WebhookResponse
+	if err := json.Unmarshal([]byte(out), &webhook); err != nil {
+		t.Fatalf("Unexpected JSON output %q: %v", out, err)
+	}
+
+	out, err = run("webhooks", "list")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(out, webhook.Id) || !strings.Contains(out, "user:vehicle:discovered") {
+		t.Errorf("Unexpected table:\n%s", out)
+	}
+
+	out, err = run("webhooks", "test", webhook.Id)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(out, string(models.TEST_FIREHOSE_WEBHOOK_STATUS_FAILURE)) {
+		t.Errorf("Expected the test to fail for an unreachable URL:\n%s", out)
+	}
+}
+
+func TestCLI_WebhooksListen(t *testing.T) {
+	_, c, run := newCLI(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.ctx = ctx
+
+	var mu sync.Mutex
+	var forwarded []string
+	forward := httptest.NewServer(&webhooks.Receiver{Secret: "test_secret", Handle: func(ctx context.Context, event *webhooks.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		forwarded = append(forwarded, event.Event)
+		return nil
+	}})
+	defer forward.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+	file := filepath.Join(t.TempDir(), "events.jsonl")
+
+	type result struct {
+		out string
+		err error
+	}
+	done := make(chan result)
+	go func() {
+		out, err := run("webhooks", "listen", "-port", strconv.Itoa(port), "-secret", "test_secret", "-forward", forward.URL, "-out", file, "-o", "json")
+		done <- result{out, err}
+	}()
+
+	target := fmt.Sprintf("http://127.0.0.1:%d/", port)
+	events := []string{
+		`{"event":"user:vehicle:discovered","createdAt":"2024-01-01T10:00:00Z","user":{"id":"user_1"}}`,
+		`{"event":"user:vehicle:updated","createdAt":"2024-01-01T10:01:00Z","user":{"id":"user_1"}}`,
+	}
+	for _, event := range events {
+		var err error
+		for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+			if err = deliver(context.Background(), target, "test_secret", json.RawMessage(event)); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if err := deliver(context.Background(), target, "wrong_secret", json.RawMessage(events[0])); err == nil {
+		t.Error("Expected an error for an invalid signature")
+	}
+
+	cancel()
+	listened := <-done
+	if listened.err != nil {
+		t.Fatalf("Unexpected error: %v", listened.err)
+	}
+	if lines := strings.Split(strings.TrimSpace(listened.out), "\n"); len(lines) != 2 || lines[1] != events[1] {
+		t.Errorf("Unexpected JSON output:\n%s", listened.out)
+	}
+	// only the loopback interface is listened on without -addr
+	if stderr := c.stderr.(*bytes.Buffer).String(); !strings.Contains(stderr, target) {
+		t.Errorf("Expected to listen on %s, got %q", target, stderr)
+	}
+
+	c.ctx = context.Background()
+	if _, err := run("webhooks", "replay", file, "-url", forward.URL, "-secret", "test_secret", "-event", "user:vehicle:updated"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	expected := []string{"user:vehicle:discovered", "user:vehicle:updated", "user:vehicle:updated"}
+	if !slices.Equal(forwarded, expected) {
+		t.Errorf("Expected forwarded and replayed events %v, got %v", expected, forwarded)
+	}
+}
+
+func TestWriteYAML(t *testing.T) {
+	value, _ := decode([]byte(`{"id":"a","count":2,"ok":true,"empty":[],"nested":{"list":[{"x":"yes","y":1},"2024-01-01T00:00:00Z"]},"none":null}`))
+	var out bytes.Buffer
+	if err := writeYAML(&out, value); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `count: 2
+empty: []
+id: a
+nested:
+  list:
+    - x: "yes"
+      "y": 1
+    - "2024-01-01T00:00:00Z"
+none: null
+ok: true
+`
+	if out.String() != expected {
+		t.Errorf("Unexpected YAML:\n%s", out.String())
+	}
+}
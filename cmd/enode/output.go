@@ -0,0 +1,235 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	FORMAT_TABLE = "table"
+	FORMAT_JSON  = "json"
+	FORMAT_YAML  = "yaml"
+)
+
+// column is a column of table output, reading Path from the JSON form of a
+// value. Path segments are separated by dots and applied to each element of arrays.
+type column struct {
+	Header string
+	Path   string
+}
+
+// print writes value in the selected output format. Tables list one row per
+// element if value is a slice, or a single row otherwise.
+func (c *cli) print(value any, columns []column) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	switch c.output {
+	case FORMAT_JSON:
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, data, "", "  "); err != nil {
+			return err
+		}
+		indented.WriteByte('\n')
+		_, err := indented.WriteTo(c.stdout)
+		return err
+	case FORMAT_YAML:
+		generic, err := decode(data)
+		if err != nil {
+			return err
+		}
+		return writeYAML(c.stdout, generic)
+	case FORMAT_TABLE, "":
+		generic, err := decode(data)
+		if err != nil {
+			return err
+		}
+		return writeTable(c.stdout, generic, columns)
+	}
+	return usagef("unknown output format %q", c.output)
+}
+
+func decode(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var generic any
+	err := decoder.Decode(&generic)
+	return generic, err
+}
+
+func writeTable(w io.Writer, value any, columns []column) error {
+	rows, ok := value.([]any)
+	if !ok {
+		rows = []any{value}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = col.Header
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		cells := make([]string, len(columns))
+		for i, col := range columns {
+			cells[i] = cell(lookup(row, strings.Split(col.Path, ".")))
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+func lookup(value any, path []string) any {
+	if len(path) == 0 || path[0] == "" {
+		return value
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		return lookup(v[path[0]], path[1:])
+	case []any:
+		values := make([]any, len(v))
+		for i, element := range v {
+			values[i] = lookup(element, path)
+		}
+		return values
+	}
+	return nil
+}
+
+func cell(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "-"
+	case string:
+		if v == "" {
+			return "-"
+		}
+		return v
+	case []any:
+		parts := make([]string, len(v))
+		for i, element := range v {
+			parts[i] = cell(element)
+		}
+		return strings.Join(parts, ",")
+	case map[string]any:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+	return fmt.Sprint(value)
+}
+
+// plainScalar matches strings which need no quotes in YAML.
+var plainScalar = regexp.MustCompile(`^[A-Za-z_/.][A-Za-z0-9 _./:@+-]*$`)
+
+var yamlKeywords = map[string]bool{
+	"true": true, "false": true, "yes": true, "no": true, "y": true, "n": true,
+	"on": true, "off": true, "null": true, "~": true,
+}
+
+// writeYAML writes a value decoded from JSON as YAML block document.
+func writeYAML(w io.Writer, value any) error {
+	var buf bytes.Buffer
+	switch v := value.(type) {
+	case map[string]any, []any:
+		if isEmpty(v) {
+			buf.WriteString(scalar(v) + "\n")
+		} else {
+			yamlBlock(&buf, v, 0)
+		}
+	default:
+		buf.WriteString(scalar(v) + "\n")
+	}
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+func yamlBlock(buf *bytes.Buffer, value any, indent int) {
+	prefix := strings.Repeat("  ", indent)
+	switch v := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			buf.WriteString(prefix + scalar(key) + ":")
+			yamlNested(buf, v[key], indent+1)
+		}
+	case []any:
+		for _, element := range v {
+			if isEmpty(element) || !isCollection(element) {
+				buf.WriteString(prefix + "-")
+				yamlNested(buf, element, indent+1)
+				continue
+			}
+			// compact form: the first line of a nested collection follows the dash
+			var nested bytes.Buffer
+			yamlBlock(&nested, element, indent+1)
+			buf.WriteString(prefix + "- ")
+			buf.Write(nested.Bytes()[len(prefix)+2:])
+		}
+	}
+}
+
+func isCollection(value any) bool {
+	switch value.(type) {
+	case map[string]any, []any:
+		return true
+	}
+	return false
+}
+
+// yamlNested writes the value of a mapping key or sequence entry, after its marker.
+func yamlNested(buf *bytes.Buffer, value any, indent int) {
+	switch v := value.(type) {
+	case map[string]any, []any:
+		if !isEmpty(v) {
+			buf.WriteString("\n")
+			yamlBlock(buf, v, indent)
+			return
+		}
+	}
+	buf.WriteString(" " + scalar(value) + "\n")
+}
+
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	}
+	return false
+}
+
+func scalar(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	case string:
+		if plainScalar.MatchString(v) && !yamlKeywords[strings.ToLower(v)] &&
+			!strings.Contains(v, ": ") && !strings.HasSuffix(v, " ") && !strings.HasSuffix(v, ":") {
+			return v
+		}
+		return strconv.Quote(v)
+	case map[string]any:
+		return "{}"
+	case []any:
+		return "[]"
+	}
+	return strconv.Quote(fmt.Sprint(value))
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/enums/languages"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/users"
+	"github.com/addihorn/enode-gosdk/pkg/vendors"
+)
+
+var userColumns = []column{
+	{"ID", "id"},
+	{"CREATED", "createdAt"},
+	{"VENDORS", "linkedVendors.vendor"},
+}
+
+var linkColumns = []column{
+	{"LINK URL", "linkUrl"},
+	{"LINK TOKEN", "linkToken"},
+}
+
+func (c *cli) users(command string, args []string) error {
+	switch command {
+	case "list":
+		return c.listUsers(args)
+	case "get":
+		return c.getUser(args)
+	case "link":
+		return c.linkUser(args)
+	case "unlink", "deauthorize":
+		return c.removeUser(command, args)
+	case "disconnect":
+		return c.disconnectUser(args)
+	}
+	return usagef("unknown command users %s", command)
+}
+
+func (c *cli) listUsers(args []string) error {
+	fs := c.flags("users list")
+	if _, err := parse(fs, args); err != nil {
+		return err
+	}
+	sess, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	byId, err := users.ListUsersContext(c.ctx, sess)
+	if err != nil {
+		return err
+	}
+	list := make([]*users.User, 0, len(byId))
+	for _, user := range byId {
+		list = append(list, user)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Id < list[j].Id })
+	return c.print(list, userColumns)
+}
+
+func (c *cli) getUser(args []string) error {
+	fs := c.flags("users get")
+	positional, err := parse(fs, args, "userId")
+	if err != nil {
+		return err
+	}
+	sess, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	user, err := users.GetUserContext(c.ctx, sess, positional[0])
+	if err != nil {
+		return err
+	}
+	return c.print(user, userColumns)
+}
+
+func (c *cli) linkUser(args []string) error {
+	var scopes stringList
+	fs := c.flags("users link")
+	vendorType := fs.String("type", "", "vendor type to link, e.g. vehicle or charger")
+	vendor := fs.String("vendor", "", "vendor to preselect in Link UI")
+	language := fs.String("language", string(languages.ENGLISH_UK), "language of Link UI")
+	redirect := fs.String("redirect", "", "URI on localhost the user is redirected to after linking, a free port if empty")
+	noBrowser := fs.Bool("no-browser", false, "print the link URL instead of opening the browser")
+	urlOnly := fs.Bool("url-only", false, "only print the link URL and token, without waiting for the redirect")
+	timeout := fs.Duration("timeout", 10*time.Minute, "maximum time to complete Link UI")
+	discovery := fs.Duration("discovery-timeout", DISCOVERY_TIMEOUT, "maximum time to wait for new devices after linking")
+	fs.Var(&scopes, "scope", "scope to request, may be repeated")
+	positional, err := parse(fs, args, "userId")
+	if err != nil {
+		return err
+	}
+	if *vendorType == "" || len(scopes) == 0 {
+		return usagef("users link requires -type and at least one -scope")
+	}
+	if *urlOnly && *redirect == "" {
+		return usagef("users link -url-only requires -redirect")
+	}
+	sess, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	user := &users.User{Id: positional[0]}
+	data := &users.LinkData{LinkUserPayload: models.LinkUserPayload{
+		VendorType:  vendors.VendorType(*vendorType),
+		Language:    models.LinkUserPayloadLanguage(*language),
+		RedirectUri: *redirect,
+	}}
+	if *vendor != "" {
+		preselected := models.Vendor(*vendor)
+		data.Vendor = &preselected
+	}
+	for _, scope := range scopes {
+		data.Scopes = append(data.Scopes, models.Scopes(scope))
+	}
+	if *urlOnly {
+		if err := user.LinkContext(c.ctx, sess, data); err != nil {
+			return err
+		}
+		return c.print(data.LinkAccessData, linkColumns)
+	}
+
+	if *noBrowser {
+		c.open = func(string) error { return nil }
+	}
+	result, err := c.interactiveLink(sess, user, data, *timeout, *discovery)
+	if err != nil {
+		return err
+	}
+	if result.Status != "success" {
+		fmt.Fprintf(c.stderr, "linking user %s failed: %s %s\n", user.Id, result.Error, result.ErrorMessage)
+	} else if len(result.Devices) == 0 {
+		fmt.Fprintf(c.stderr, "linked user %s, no new devices\n", user.Id)
+	} else {
+		fmt.Fprintf(c.stderr, "linked user %s, %d new device(s)\n", user.Id, len(result.Devices))
+	}
+	if c.output == FORMAT_TABLE {
+		err = c.print(result.Devices, discoveredColumns)
+	} else {
+		err = c.print(result, nil)
+	}
+	if err == nil && result.Status != "success" {
+		err = fmt.Errorf("link failed: %s", result.Error)
+	}
+	return err
+}
+
+func (c *cli) removeUser(command string, args []string) error {
+	fs := c.flags("users " + command)
+	positional, err := parse(fs, args, "userId")
+	if err != nil {
+		return err
+	}
+	sess, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	user := &users.User{Id: positional[0]}
+	if command == "unlink" {
+		if err := user.UnlinkContext(c.ctx, sess); err != nil {
+			return err
+		}
+		fmt.Fprintf(c.stderr, "unlinked user %s\n", user.Id)
+		return nil
+	}
+	if err := user.DeauthorizeContext(c.ctx, sess); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stderr, "deauthorized user %s\n", user.Id)
+	return nil
+}
+
+func (c *cli) disconnectUser(args []string) error {
+	fs := c.flags("users disconnect")
+	vendor := fs.String("vendor", "", "vendor to disconnect")
+	vendorType := fs.String("type", "", "only disconnect devices of this vendor type")
+	positional, err := parse(fs, args, "userId")
+	if err != nil {
+		return err
+	}
+	if *vendor == "" {
+		return usagef("users disconnect requires -vendor")
+	}
+	sess, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	user := &users.User{Id: positional[0]}
+	if *vendorType == "" {
+		err = user.DisconnectVendorContext(c.ctx, sess, *vendor)
+	} else {
+		err = user.DisconnectVendortypeContext(c.ctx, sess, *vendor, vendors.VendorType(*vendorType))
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stderr, "disconnected %s from user %s\n", *vendor, user.Id)
+	return nil
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/webhooks"
+)
+
+var webhookColumns = []column{
+	{"ID", "id"},
+	{"URL", "url"},
+	{"EVENTS", "events"},
+	{"ACTIVE", "isActive"},
+	{"LAST SUCCESS", "lastSuccess"},
+}
+
+var webhookTestColumns = []column{
+	{"STATUS", "status"},
+	{"DESCRIPTION", "description"},
+	{"CODE", "response.code"},
+}
+
+func (c *cli) webhooks(command string, args []string) error {
+	switch command {
+	case "list":
+		return c.listWebhooks(args)
+	case "create":
+		return c.createWebhook(args)
+	case "test":
+		return c.testWebhook(args)
+	case "delete":
+		return c.deleteWebhook(args)
+	case "listen":
+		return c.listenWebhooks(args)
+	case "replay":
+		return c.replayWebhooks(args)
+	}
+	return usagef("unknown command webhooks %s", command)
+}
+
+func (c *cli) listWebhooks(args []string) error {
+	fs := c.flags("webhooks list")
+	if _, err := parse(fs, args); err != nil {
+		return err
+	}
+	sess, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	var list []models.WebhookResponse
+	params := &models.PaginationParams{}
+	for {
+		page, err := webhooks.ListWebhooks(c.ctx, sess, params)
+		if err != nil {
+			return err
+		}
+		list = append(list, page.Data...)
+		if page.Pagination.After == nil || *page.Pagination.After == "" {
+			break
+		}
+		params.After = page.Pagination.After
+	}
+	return c.print(list, webhookColumns)
+}
+
+func (c *cli) createWebhook(args []string) error {
+	var events stringList
+	fs := c.flags("webhooks create")
+	url := fs.String("url", "", "HTTPS URL the events are sent to")
+	secret := fs.String("secret", "", "secret used to sign the events")
+	apiVersion := fs.String("api-version", "", "API version of the event payloads, the client default if empty")
+	fs.Var(&events, "event", "event to subscribe to, may be repeated, all events if omitted")
+	if _, err := parse(fs, args); err != nil {
+		return err
+	}
+	if *url == "" || *secret == "" {
+		return usagef("webhooks create requires -url and -secret")
+	}
+	sess, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	payload := &models.WebhookCreatePayload{Url: *url, Secret: *secret}
+	for _, event := range events {
+		payload.Events = append(payload.Events, models.WebhookEvent(event))
+	}
+	if *apiVersion != "" {
+		payload.ApiVersion = apiVersion
+	}
+	webhook, err := webhooks.CreateWebhook(c.ctx, sess, payload)
+	if err != nil {
+		return err
+	}
+	return c.print(webhook, webhookColumns)
+}
+
+func (c *cli) testWebhook(args []string) error {
+	fs := c.flags("webhooks test")
+	positional, err := parse(fs, args, "webhookId")
+	if err != nil {
+		return err
+	}
+	sess, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	result, err := webhooks.TestWebhook(c.ctx, sess, positional[0])
+	if err != nil {
+		return err
+	}
+	return c.print(result, webhookTestColumns)
+}
+
+func (c *cli) deleteWebhook(args []string) error {
+	fs := c.flags("webhooks delete")
+	positional, err := parse(fs, args, "webhookId")
+	if err != nil {
+		return err
+	}
+	sess, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	if err := webhooks.DeleteWebhook(c.ctx, sess, positional[0]); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stderr, "deleted webhook %s\n", positional[0])
+	return nil
+}
@@ -5,7 +5,7 @@ import (
 
 	"github.com/addihorn/enode-gosdk/pkg/auth"
 	"github.com/addihorn/enode-gosdk/pkg/enums/environments"
-	"github.com/addihorn/enode-gosdk/pkg/enums/languages"
+	"github.com/addihorn/enode-gosdk/pkg/models"
 	"github.com/addihorn/enode-gosdk/pkg/session"
 	"github.com/addihorn/enode-gosdk/pkg/users"
 	"github.com/addihorn/enode-gosdk/pkg/vendors"
@@ -13,10 +13,13 @@ import (
 
 func main() {
 
-	client_id := "<YOUR-CLIENT_ID>"
-	client_secret := "<YOUR-CLIENT-SECRET>"
-
-	authentication, err := auth.NewAuthentication(client_id, client_secret, environments.SANDBOX, true)
+	// the credentials are read from ENODE_CLIENT_ID and ENODE_CLIENT_SECRET on every token request,
+	// auth.FromDirectory or auth.ExecCredentials read them from mounted secrets or a secret manager
+	authentication, err := auth.New(auth.Config{
+		Credentials:           auth.FromEnv(),
+		Environment:           environments.SANDBOX,
+		AutomaticTokenRefresh: true,
+	})
 	if err != nil {
 		fmt.Println("main: was not able to create a new session \n", err)
 	}
@@ -36,12 +39,12 @@ func main() {
 
 	//link user to new devices
 	user = &users.User{Id: "foobar"}
-	linkData := users.LinkData{
-		Type:        vendors.BATTERY,
-		Language:    languages.ENGLISH_UK,
-		Scopes:      []string{"battery:read:data"},
+	linkData := users.LinkData{LinkUserPayload: models.LinkUserPayload{
+		VendorType:  vendors.BATTERY,
+		Language:    models.LINK_USER_PAYLOAD_LANGUAGE_EN_GB,
+		Scopes:      []models.Scopes{models.SCOPES_BATTERY_READ_DATA},
 		RedirectUri: "http://localhost:3000",
-	}
+	}}
 	fmt.Printf("%+v\n", user.Link(sess, &linkData)) // print error
 	fmt.Printf("%+v\n", linkData.LinkAccessData)    // print link data
 
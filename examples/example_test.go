@@ -3,13 +3,18 @@ package main
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/cassette"
+	"github.com/addihorn/enode-gosdk/pkg/enodetest"
 	"github.com/addihorn/enode-gosdk/pkg/enums/environments"
-	"github.com/addihorn/enode-gosdk/pkg/enums/languages"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/profiles"
 	"github.com/addihorn/enode-gosdk/pkg/session"
 	"github.com/addihorn/enode-gosdk/pkg/users"
 	"github.com/addihorn/enode-gosdk/pkg/vendors"
@@ -33,35 +38,76 @@ func BenchmarkMain(b *testing.B) {
 	<-endTimer.C
 }
 
-func Test_UserCRUD(t *testing.T) {
-	//change directory to direct to your project directory
-	err := godotenv.Load("/workspaces/enode-gosdk/.env")
-
+// newCassette returns a client replaying the interactions in testdata/name.json
+// and the profile to authenticate with. With ENODE_CASSETTE_MODE=record the
+// client records from the sandbox with the ENODE_* variables of the environment
+// or ../.env. Without ENODE_CLIENT_ID, it records from the in-memory fake of
+// pkg/enodetest instead.
+func newCassette(t *testing.T, name string) (client *http.Client, profile *profiles.Profile) {
+	recorder, err := cassette.NewRecorder(filepath.Join("testdata", name+".json"), cassette.ModeFromEnv(cassette.MODE_REPLAY))
 	if err != nil {
-		t.Errorf("integration: was not able to read .env file")
+		t.Fatalf("integration: was not able to load cassette:\n%+v\n", err)
 	}
+	t.Cleanup(func() {
+		if err := recorder.Save(); err != nil {
+			t.Errorf("integration: was not able to save cassette:\n%+v\n", err)
+		}
+	})
+	client = &http.Client{Transport: recorder}
+
+	// replayed token requests are not checked, so any credentials do
+	fake := map[string]string{
+		"ENODE_ENVIRONMENT":   environments.SANDBOX,
+		"ENODE_CLIENT_ID":     enodetest.CLIENT_ID,
+		"ENODE_CLIENT_SECRET": enodetest.CLIENT_SECRET,
+	}
+	if recorder.Recording() {
+		// variables of the environment take precedence over the file
+		godotenv.Load("../.env")
+		if os.Getenv("ENODE_CLIENT_ID") != "" {
+			if profile, err = profiles.FromEnv("", nil); err != nil {
+				t.Fatalf("integration: invalid sandbox configuration:\n%+v\n", err)
+			}
+			return client, profile
+		}
+		server := enodetest.NewServer()
+		server.AddUser("other-user")
+		t.Cleanup(server.Close)
+		fake["ENODE_ENVIRONMENT"] = server.URL
+	}
+	if profile, err = profiles.FromEnv("", func(key string) string { return fake[key] }); err != nil {
+		t.Fatalf("integration: invalid cassette configuration:\n%+v\n", err)
+	}
+	return client, profile
+}
 
-	client_id := os.Getenv("ENODE_CLIENT_ID")
-	client_secret := os.Getenv("ENODE_CLIENT_SECRET")
-
-	authentication, err := auth.NewAuthentication(client_id, client_secret, environments.SANDBOX, true)
+func Test_UserCRUD(t *testing.T) {
+	client, profile := newCassette(t, "user_crud")
+
+	authentication, err := auth.New(auth.Config{
+		Credentials: profile.Credentials(),
+		Environment: profile.Env().ApiUrl,
+		TokenUrl:    profile.Env().Token(),
+		HttpClient:  client,
+	})
 	if err != nil {
-		t.Errorf("integration: was not able to create a new session:\n%+v\n", err)
+		t.Fatalf("integration: was not able to create a new session:\n%+v\n", err)
 	}
 
 	// get all users
 	sess := session.NewSession(authentication)
+	sess.HttpClient = client
 	userList, _ := users.ListUsers(sess)
 	fmt.Printf("%+v\n", userList)
 
 	//link user to new devices
 	user := &users.User{Id: "foobar"}
-	linkData := users.LinkData{
-		Type:        vendors.BATTERY,
-		Language:    languages.ENGLISH_UK,
-		Scopes:      []string{"battery:read:data"},
+	linkData := users.LinkData{LinkUserPayload: models.LinkUserPayload{
+		VendorType:  vendors.BATTERY,
+		Language:    models.LINK_USER_PAYLOAD_LANGUAGE_EN_GB,
+		Scopes:      []models.Scopes{models.SCOPES_BATTERY_READ_DATA},
 		RedirectUri: "http://localhost:3000",
-	}
+	}}
 	fmt.Printf("%+v\n", user.Link(sess, &linkData)) // print error
 	fmt.Printf("%+v\n", linkData.LinkAccessData)    // print link data
 
@@ -0,0 +1,92 @@
+// Package rest holds the request handling shared by the generated endpoint packages.
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+// NewRequest creates a request against the environment of the session.
+// The payload, if not nil, is sent as JSON body.
+func NewRequest(ctx context.Context, sess *session.Session, method, path string, query url.Values, payload any) (*http.Request, error) {
+	target := sess.Authentication.Environment + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	// a typed nil pointer, e.g. an omitted payload of a generated operation, has no body
+	if value := reflect.ValueOf(payload); value.Kind() == reflect.Pointer && value.IsNil() {
+		payload = nil
+	}
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+// Problem returns the problem details of an error response, or the status of
+// the response if the body does not contain any.
+func Problem(resp *http.Response, body []byte) error {
+	var problem models.Problem
+	if err := json.Unmarshal(body, &problem); err != nil || problem.Title == "" {
+		return errors.New(resp.Status)
+	}
+	return &problem
+}
+
+/*
+Reads the type of the problem details of an error response. The body of the
+response is restored, so it can be read again by the caller.
+
+Parameters:
+  - resp: The response to read.
+
+Returns:
+  - The type of the problem, or an empty string if the body holds no problem details.
+*/
+func ProblemType(resp *http.Response) string {
+	if resp.Body == nil {
+		return ""
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	var remaining io.Reader = bytes.NewReader(body)
+	if err != nil {
+		// the caller sees the error of the original body after the bytes read
+		remaining = io.MultiReader(remaining, &failingReader{err})
+	}
+	resp.Body = io.NopCloser(remaining)
+
+	var problem models.Problem
+	if json.Unmarshal(body, &problem) != nil {
+		return ""
+	}
+	return problem.Type
+}
+
+type failingReader struct{ err error }
+
+func (r *failingReader) Read([]byte) (int, error) { return 0, r.err }
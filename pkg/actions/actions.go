@@ -0,0 +1,280 @@
+// Package actions follows the lifecycle of the actions created by device
+// commands, e.g. starting to charge or setting a permanent hold, until they
+// are confirmed, failed or cancelled.
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/batteries"
+	"github.com/addihorn/enode-gosdk/pkg/chargers"
+	"github.com/addihorn/enode-gosdk/pkg/hvacs"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+)
+
+const (
+	ACTION_FAILED_ERROR    string = "actions: action failed"
+	ACTION_CANCELLED_ERROR string = "actions: action was cancelled"
+	ACTION_PARSE_ERROR     string = "actions: unable to parse action"
+
+	DEFAULT_MIN_INTERVAL = 2 * time.Second
+	DEFAULT_MAX_INTERVAL = 30 * time.Second
+)
+
+// Status holds the lifecycle fields shared by all actions.
+type Status struct {
+	Id            string                              `json:"id"`
+	UserId        string                              `json:"userId"`
+	TargetId      string                              `json:"targetId"`
+	State         models.ActionState                  `json:"state"`
+	CreatedAt     time.Time                           `json:"createdAt"`
+	UpdatedAt     time.Time                           `json:"updatedAt"`
+	CompletedAt   *time.Time                          `json:"completedAt"`
+	FailureReason *models.ChargingActionFailureReason `json:"failureReason"`
+}
+
+// IsSettled reports whether the action reached a final state.
+func (s Status) IsSettled() bool {
+	return s.State != models.ACTION_STATE_PENDING
+}
+
+// FailedError is returned by Wait for actions ending in state FAILED.
+type FailedError struct {
+	ActionId string
+	// Reason explains the failure, nil if the API did not report one.
+	Reason *models.ChargingActionFailureReason
+}
+
+func (e *FailedError) Error() string {
+	if e.Reason == nil {
+		return fmt.Sprintf("%s: %s", ACTION_FAILED_ERROR, e.ActionId)
+	}
+	return fmt.Sprintf("%s: %s: %s (%s)", ACTION_FAILED_ERROR, e.ActionId, e.Reason.Type, e.Reason.Detail)
+}
+
+// fetch reads or cancels an action through the endpoints of its target.
+type fetch func(ctx context.Context, sess *session.Session, actionId string) (any, error)
+
+// Action is an action created by a device command, of model type T.
+type Action[T any] struct {
+	// Current is the last known state of the action.
+	Current *T
+	// Events resolves Wait on user:vendor-action:updated webhook events if set.
+	// The API is still polled at MaxInterval in case an event is missed.
+	Events *Dispatcher
+	// MinInterval is the delay before polling the first time, growing up to MaxInterval.
+	MinInterval time.Duration
+	MaxInterval time.Duration
+
+	sess   *session.Session
+	get    fetch
+	cancel fetch
+}
+
+func newAction[T any](sess *session.Session, action *T, get, cancel fetch) *Action[T] {
+	return &Action[T]{
+		Current:     action,
+		MinInterval: DEFAULT_MIN_INTERVAL,
+		MaxInterval: DEFAULT_MAX_INTERVAL,
+		sess:        sess,
+		get:         get,
+		cancel:      cancel,
+	}
+}
+
+// VehicleCharging follows an action created by vehicles.ControlVehicleCharging.
+func VehicleCharging(sess *session.Session, action *models.ChargeAction) *Action[models.ChargeAction] {
+	return newAction(sess, action,
+		func(ctx context.Context, sess *session.Session, id string) (any, error) {
+			return vehicles.GetVehiclesAction(ctx, sess, id)
+		},
+		func(ctx context.Context, sess *session.Session, id string) (any, error) {
+			return vehicles.CancelVehicleAction(ctx, sess, id)
+		})
+}
+
+// VehicleMaxCurrent follows an action created by vehicles.SetVehicleMaxCurrent.
+func VehicleMaxCurrent(sess *session.Session, action *models.MaxCurrentAction) *Action[models.MaxCurrentAction] {
+	return newAction(sess, action,
+		func(ctx context.Context, sess *session.Session, id string) (any, error) {
+			return vehicles.GetVehiclesAction(ctx, sess, id)
+		},
+		func(ctx context.Context, sess *session.Session, id string) (any, error) {
+			return vehicles.CancelVehicleAction(ctx, sess, id)
+		})
+}
+
+// ChargerCharging follows an action created by chargers.ControlChargerCharging.
+func ChargerCharging(sess *session.Session, action *models.ChargeAction) *Action[models.ChargeAction] {
+	return newAction(sess, action, getChargerAction, cancelChargerAction)
+}
+
+// ChargerMaxCurrent follows an action created by chargers.SetChargerMaxCurrent.
+func ChargerMaxCurrent(sess *session.Session, action *models.MaxCurrentAction) *Action[models.MaxCurrentAction] {
+	return newAction(sess, action, getChargerAction, cancelChargerAction)
+}
+
+// BatteryOperationMode follows an action created by batteries.SetBatteryOperationMode.
+func BatteryOperationMode(sess *session.Session, action *models.OperationModeAction) *Action[models.OperationModeAction] {
+	return newAction(sess, action,
+		func(ctx context.Context, sess *session.Session, id string) (any, error) {
+			return batteries.GetBatteriesAction(ctx, sess, id)
+		},
+		func(ctx context.Context, sess *session.Session, id string) (any, error) {
+			return batteries.CancelBatteryAction(ctx, sess, id)
+		})
+}
+
+// HvacPermanentHold follows an action created by hvacs.SetHvacPermanentHold.
+func HvacPermanentHold(sess *session.Session, action *models.HvacActionPermanentHold) *Action[models.HvacActionPermanentHold] {
+	return newAction(sess, action, getHvacAction, cancelHvacAction)
+}
+
+// HvacFollowSchedule follows an action created by hvacs.SetHvacFollowSchedule.
+func HvacFollowSchedule(sess *session.Session, action *models.HvacActionFollowSchedule) *Action[models.HvacActionFollowSchedule] {
+	return newAction(sess, action, getHvacAction, cancelHvacAction)
+}
+
+func getChargerAction(ctx context.Context, sess *session.Session, id string) (any, error) {
+	return chargers.GetChargersAction(ctx, sess, id)
+}
+
+func cancelChargerAction(ctx context.Context, sess *session.Session, id string) (any, error) {
+	return chargers.CancelChargerAction(ctx, sess, id)
+}
+
+func getHvacAction(ctx context.Context, sess *session.Session, id string) (any, error) {
+	return hvacs.GetHvacsAction(ctx, sess, id)
+}
+
+func cancelHvacAction(ctx context.Context, sess *session.Session, id string) (any, error) {
+	return hvacs.CancelHvacAction(ctx, sess, id)
+}
+
+// Status returns the lifecycle fields of the current state.
+func (a *Action[T]) Status() Status {
+	status, _ := statusOf(a.Current)
+	return status
+}
+
+// Refresh reads the current state of the action from the API.
+func (a *Action[T]) Refresh(ctx context.Context) error {
+	return a.update(ctx, a.get)
+}
+
+// Cancel requests the cancellation of a pending action and updates the current state.
+func (a *Action[T]) Cancel(ctx context.Context) error {
+	return a.update(ctx, a.cancel)
+}
+
+func (a *Action[T]) update(ctx context.Context, call fetch) error {
+	result, err := call(ctx, a.sess, a.Status().Id)
+	if err != nil {
+		return err
+	}
+	current, err := convert[T](result)
+	if err != nil {
+		return err
+	}
+	a.Current = current
+	return nil
+}
+
+/*
+Waits for the action to leave the PENDING state, polling the API with growing intervals
+or, if Events is set, resolving on user:vendor-action:updated webhook events.
+
+Parameters:
+  - ctx: The context bounding the wait.
+
+Returns:
+  - The final state of the action.
+  - A *FailedError if the action failed, an error with ACTION_CANCELLED_ERROR if it was cancelled,
+    or the error of the context or of a request.
+*/
+func (a *Action[T]) Wait(ctx context.Context) (*T, error) {
+	var updates <-chan *models.UserActionUpdated
+	if a.Events != nil {
+		var unsubscribe func()
+		updates, unsubscribe = a.Events.Subscribe(a.Status().Id)
+		defer unsubscribe()
+	}
+
+	interval := a.MinInterval
+	if interval <= 0 {
+		interval = DEFAULT_MIN_INTERVAL
+	}
+	maxInterval := a.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DEFAULT_MAX_INTERVAL
+	}
+	if updates != nil {
+		interval = maxInterval
+	}
+
+	for {
+		status := a.Status()
+		if status.IsSettled() {
+			return a.Current, settledError(status)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return a.Current, ctx.Err()
+		case event := <-updates:
+			timer.Stop()
+			current, err := convert[T](event.VendorAction)
+			if err != nil {
+				return a.Current, err
+			}
+			a.Current = current
+		case <-timer.C:
+			if err := a.Refresh(ctx); err != nil {
+				return a.Current, err
+			}
+			interval = min(interval*3/2, maxInterval)
+		}
+	}
+}
+
+func settledError(status Status) error {
+	switch status.State {
+	case models.ACTION_STATE_FAILED:
+		return &FailedError{ActionId: status.Id, Reason: status.FailureReason}
+	case models.ACTION_STATE_CANCELLED:
+		return errors.Join(errors.New(ACTION_CANCELLED_ERROR), fmt.Errorf("%s", status.Id))
+	}
+	return nil
+}
+
+// convert turns one of the union types returned by the action endpoints into
+// the model type of the action.
+func convert[T any](value any) (*T, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.Join(errors.New(ACTION_PARSE_ERROR), err)
+	}
+	var result T
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, errors.Join(errors.New(ACTION_PARSE_ERROR), err)
+	}
+	return &result, nil
+}
+
+func statusOf(value any) (Status, error) {
+	var status Status
+	data, err := json.Marshal(value)
+	if err != nil {
+		return status, err
+	}
+	err = json.Unmarshal(data, &status)
+	return status, err
+}
@@ -0,0 +1,144 @@
+package actions_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/actions"
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+const chargeActionJSON = `{
+	"id": "test_action_id",
+	"userId": "test_user_id",
+	"createdAt": "2020-04-07T17:04:26Z",
+	"updatedAt": "2020-04-07T17:04:26Z",
+	"completedAt": %s,
+	"state": %q,
+	"targetId": "test_charger_id",
+	"targetType": "charger",
+	"kind": "START",
+	"failureReason": %s
+}`
+
+func pendingAction() *models.ChargeAction {
+	return &models.ChargeAction{
+		Id:         "test_action_id",
+		UserId:     "test_user_id",
+		State:      models.ACTION_STATE_PENDING,
+		TargetId:   "test_charger_id",
+		TargetType: models.CHARGEABLE_VENDOR_TYPE_CHARGER,
+		Kind:       models.CHARGING_ACTION_START,
+	}
+}
+
+func newSession(handler http.HandlerFunc) (*session.Session, func()) {
+	ts := httptest.NewServer(handler)
+	return &session.Session{
+		Authentication: &auth.Authentication{
+			Environment:  ts.URL,
+			Access_token: "test_token",
+		},
+	}, ts.Close
+}
+
+func TestWait_Polls(t *testing.T) {
+	// Create a test server confirming the action on the second poll
+	polls := 0
+	sess, done := newSession(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chargers/actions/test_action_id" {
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+		}
+		polls++
+		state := "PENDING"
+		if polls > 1 {
+			state = "CONFIRMED"
+		}
+		fmt.Fprintf(w, chargeActionJSON, "null", state, "null")
+	})
+	defer done()
+
+	action := actions.ChargerCharging(sess, pendingAction())
+	action.MinInterval = time.Millisecond
+
+	result, err := action.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.State != models.ACTION_STATE_CONFIRMED || polls != 2 {
+		t.Errorf("Expected confirmed action after 2 polls, but got %s after %d polls", result.State, polls)
+	}
+}
+
+func TestWait_Failed(t *testing.T) {
+	sess, done := newSession(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, chargeActionJSON, `"2020-04-07T17:05:26Z"`, "FAILED", `{"type":"NO_RESPONSE","detail":"The chargeable device did not respond."}`)
+	})
+	defer done()
+
+	action := actions.ChargerCharging(sess, pendingAction())
+	action.MinInterval = time.Millisecond
+
+	_, err := action.Wait(context.Background())
+	var failed *actions.FailedError
+	if !errors.As(err, &failed) {
+		t.Fatalf("Expected FailedError, but got %v", err)
+	}
+	if failed.Reason == nil || failed.Reason.Type != models.CHARGING_ACTION_FAILURE_REASON_TYPE_NO_RESPONSE {
+		t.Errorf("Expected failure reason NO_RESPONSE, but got %+v", failed.Reason)
+	}
+}
+
+func TestWait_Events(t *testing.T) {
+	sess, done := newSession(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request: %s", r.URL.Path)
+	})
+	defer done()
+
+	dispatcher := actions.NewDispatcher()
+	action := actions.ChargerCharging(sess, pendingAction())
+	action.Events = dispatcher
+	action.MaxInterval = time.Minute
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		confirmed := pendingAction()
+		confirmed.State = models.ACTION_STATE_CONFIRMED
+		dispatcher.Dispatch(&models.UserActionUpdated{VendorAction: models.UserActionUpdatedVendorAction{ChargeAction: confirmed}})
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	result, err := action.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.State != models.ACTION_STATE_CONFIRMED {
+		t.Errorf("Expected confirmed action, but got %s", result.State)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	sess, done := newSession(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/chargers/actions/test_action_id/cancel" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprintf(w, chargeActionJSON, `"2020-04-07T17:05:26Z"`, "CANCELLED", `{"type":"REQUESTED_CANCELLATION","detail":"The action was cancelled."}`)
+	})
+	defer done()
+
+	action := actions.ChargerCharging(sess, pendingAction())
+	if err := action.Cancel(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := action.Wait(context.Background()); err == nil || action.Status().State != models.ACTION_STATE_CANCELLED {
+		t.Errorf("Expected cancelled action, but got %s (%v)", action.Status().State, err)
+	}
+}
@@ -0,0 +1,62 @@
+package actions
+
+import (
+	"sync"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+)
+
+// Dispatcher routes user:vendor-action:updated webhook events to the actions
+// waiting for them. Feed it from the webhook receiver of the application.
+type Dispatcher struct {
+	mu          sync.Mutex
+	subscribers map[string][]chan *models.UserActionUpdated
+}
+
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{subscribers: make(map[string][]chan *models.UserActionUpdated)}
+}
+
+// Subscribe returns a channel receiving the events of an action, and a
+// function ending the subscription. Only the latest undelivered event is kept.
+func (d *Dispatcher) Subscribe(actionId string) (<-chan *models.UserActionUpdated, func()) {
+	updates := make(chan *models.UserActionUpdated, 1)
+
+	d.mu.Lock()
+	d.subscribers[actionId] = append(d.subscribers[actionId], updates)
+	d.mu.Unlock()
+
+	return updates, func() {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		subscribers := d.subscribers[actionId]
+		for i, subscriber := range subscribers {
+			if subscriber == updates {
+				d.subscribers[actionId] = append(subscribers[:i], subscribers[i+1:]...)
+				break
+			}
+		}
+		if len(d.subscribers[actionId]) == 0 {
+			delete(d.subscribers, actionId)
+		}
+	}
+}
+
+// Dispatch delivers an event to the subscribers of its action.
+func (d *Dispatcher) Dispatch(event *models.UserActionUpdated) {
+	status, err := statusOf(event.VendorAction)
+	if err != nil || status.Id == "" {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, subscriber := range d.subscribers[status.Id] {
+		// replace an event the subscriber did not receive yet
+		select {
+		case <-subscriber:
+		default:
+		}
+		subscriber <- event
+	}
+}
@@ -1,15 +1,18 @@
 package auth
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/enums/environments"
 )
 
 type Authentication struct {
@@ -17,101 +20,286 @@ type Authentication struct {
 	Scope        string
 	Token_type   string
 	Environment  string
+
+	config Config
+	// mu guards the token fields once the authentication is shared
+	mu sync.RWMutex
+	// refreshing serializes token requests
+	refreshing sync.Mutex
+	// timer is the pending automatic refresh, none is scheduled once stopped
+	timer   *time.Timer
+	stopped bool
+}
+
+// Config holds the credentials and endpoints of a client. Several clients,
+// e.g. one per country, are authenticated with a Config each.
+type Config struct {
+	ClientId     string
+	ClientSecret string
+	// Credentials provides the client credentials for every token request,
+	// ClientId and ClientSecret if nil.
+	Credentials CredentialsProvider
+	// Environment is the base URL of the API.
+	Environment string
+	// TokenUrl is the OAuth token endpoint, the one of the environment if empty.
+	TokenUrl string
+	// HttpClient sends the token requests, http.DefaultClient if nil.
+	HttpClient *http.Client
+	// Cache shares tokens with other processes of the client, e.g. a FileTokenCache.
+	// Tokens are requested for every authentication if nil.
+	Cache TokenCache
+	// AutomaticTokenRefresh requests a new token shortly before the current one expires.
+	AutomaticTokenRefresh bool
+	// OnTokenRefresh is called after the current token was replaced or failed
+	// to be replaced, with the error of the refresh, e.g. to count refreshes.
+	OnTokenRefresh func(err error)
 }
 
+// RETRY_INTERVAL is the delay before a failed automatic token refresh is attempted again.
+const RETRY_INTERVAL = 30 * time.Second
+
 func NewAuthentication(client_id, client_secret, environment string, automaticTokenRefresh bool) (*Authentication, error) {
+	return New(Config{
+		ClientId:              client_id,
+		ClientSecret:          client_secret,
+		Environment:           environment,
+		AutomaticTokenRefresh: automaticTokenRefresh,
+	})
+}
+
+/*
+Authenticates a client with the client credentials grant.
 
-	authData, err := authenticate(client_id, client_secret, environment)
+Parameters:
+  - config: The credentials and endpoints of the client.
 
+Returns:
+  - The authentication of the client, holding its access token.
+  - An error if no token could be obtained.
+*/
+func New(config Config) (*Authentication, error) {
+	if config.TokenUrl == "" {
+		config.TokenUrl = environments.TokenUrl(config.Environment)
+	}
+	if config.Credentials == nil {
+		config.Credentials = StaticCredentials{ClientId: config.ClientId, ClientSecret: config.ClientSecret}
+	}
+
+	auth := &Authentication{Environment: config.Environment, config: config}
+	lifetime, err := auth.obtain(context.Background(), "")
 	if err != nil {
 		return nil, errors.Join(errors.New("authentication: could not get a new authentication session"), err)
 	}
 
-	auth := &Authentication{Environment: environment}
-
-	if err = json.Unmarshal(authData, auth); err != nil {
-		return nil, errors.Join(errors.New("authentication: error, while trying to unmarshal response from auth service"), err)
+	if config.AutomaticTokenRefresh {
+		auth.schedule(lifetime - 30*time.Second)
 	}
 
-	if automaticTokenRefresh {
-		var f map[string]interface{}
+	return auth, nil
+}
+
+// TokenUrl returns the OAuth token endpoint the authentication was obtained from.
+func (sess *Authentication) TokenUrl() string {
+	return sess.config.TokenUrl
+}
 
-		if err = json.Unmarshal(authData, &f); err != nil {
-			fmt.Println(errors.Join(errors.New("authentication:unable to parse expiration-timer"), err))
-		}
-		expires_in, _ := strconv.Atoi(fmt.Sprint(f["expires_in"]))
+// Token returns the current access token.
+func (sess *Authentication) Token() string {
+	sess.mu.RLock()
+	defer sess.mu.RUnlock()
+	return sess.Access_token
+}
+
+// Refreshable reports whether the authentication can request new tokens, which
+// is the case for authentications created by New.
+func (sess *Authentication) Refreshable() bool {
+	return sess.config.Credentials != nil
+}
+
+/*
+Requests a new access token, e.g. after the API rejected the current one.
+Concurrent callers holding the same stale token cause a single token request.
 
-		time.AfterFunc(
-			time.Duration(expires_in-30)*time.Second,
-			func() { auth.refreshToken(client_id, client_secret, environment) },
-		)
+Parameters:
+  - ctx: The context of the token request.
+  - stale: The token that was rejected.
+
+Returns:
+  - An error if no new token could be obtained.
+*/
+func (sess *Authentication) Refresh(ctx context.Context, stale string) error {
+	if !sess.Refreshable() {
+		return errors.New("authentication: the authentication was not created with credentials")
+	}
+	sess.refreshing.Lock()
+	defer sess.refreshing.Unlock()
+	if sess.Token() != stale {
+		// another caller refreshed the token meanwhile
+		return nil
 	}
+	_, err := sess.obtain(ctx, stale)
+	sess.refreshed(err)
+	return err
+}
 
-	return auth, nil
+func (sess *Authentication) schedule(delay time.Duration) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if !sess.stopped {
+		sess.timer = time.AfterFunc(max(delay, time.Second), sess.refreshToken)
+	}
 }
 
-func (sess *Authentication) refreshToken(client_id, client_secret, environment string) {
+// Stop ends the automatic token refresh, e.g. when the client is no longer
+// used. Tokens rejected by the API are still refreshed through Refresh.
+func (sess *Authentication) Stop() {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	sess.stopped = true
+	if sess.timer != nil {
+		sess.timer.Stop()
+	}
+}
 
-	// fmt.Printf("Timer has been fired with token %s \n Refreshing Token...\n", sess.Access_token)
+func (sess *Authentication) refreshToken() {
+	sess.refreshing.Lock()
+	lifetime, err := sess.obtain(context.Background(), sess.Token())
+	sess.refreshing.Unlock()
+	sess.refreshed(err)
 
-	authData, err := authenticate(client_id, client_secret, environment)
 	if err != nil {
 		fmt.Println(errors.Join(errors.New("authentication: could not get a new authentication session"), err))
+		sess.schedule(RETRY_INTERVAL)
+		return
 	}
+	sess.schedule(lifetime - 30*time.Second)
+}
 
-	var f map[string]interface{}
-	if err = json.Unmarshal(authData, &f); err != nil {
-		fmt.Println(errors.Join(errors.New("authentication: error, while trying to unmarshal response from auth service"), err))
+func (sess *Authentication) refreshed(err error) {
+	if sess.config.OnTokenRefresh != nil {
+		sess.config.OnTokenRefresh(err)
 	}
+}
 
-	if err = json.Unmarshal(authData, sess); err != nil {
-		fmt.Println(errors.Join(errors.New("authentication:unable to parse expiration-timer"), err))
+// obtain stores a new token, from the cache if it holds one other than stale,
+// and returns its remaining lifetime.
+func (sess *Authentication) obtain(ctx context.Context, stale string) (time.Duration, error) {
+	cache := sess.config.Cache
+	if cache == nil {
+		token, err := sess.request(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return sess.store(token), nil
 	}
 
-	expires_in, _ := strconv.Atoi(fmt.Sprint(f["expires_in"]))
+	credentials, err := sess.config.Credentials.Credentials(ctx)
+	if err != nil {
+		return 0, err
+	}
+	key := CacheKey(credentials.ClientId, sess.config.TokenUrl)
+	// failures of the cache fall back to requesting a token
+	if token, err := cache.Get(ctx, key); err == nil && token.valid(time.Now()) && token.AccessToken != stale {
+		return sess.store(token), nil
+	}
+	if locker, ok := cache.(TokenLocker); ok {
+		if unlock, err := locker.Lock(ctx, key); err == nil {
+			defer unlock()
+			// another process may have requested a token while this one waited
+			if token, err := cache.Get(ctx, key); err == nil && token.valid(time.Now()) && token.AccessToken != stale {
+				return sess.store(token), nil
+			}
+		}
+	}
 
-	time.AfterFunc(
-		time.Duration(expires_in-30)*time.Second,
-		func() { sess.refreshToken(client_id, client_secret, environment) },
-	)
+	token, err := sess.request(ctx)
+	if err != nil {
+		return 0, err
+	}
+	cache.Put(ctx, key, token)
+	return sess.store(token), nil
 }
 
-func authenticate(client_id, client_secret, environment string) ([]byte, error) {
+// store makes token the current token and returns its remaining lifetime.
+func (sess *Authentication) store(token *CachedToken) time.Duration {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	sess.Access_token, sess.Scope, sess.Token_type = token.AccessToken, token.Scope, token.TokenType
+	return time.Until(token.ExpiresAt)
+}
+
+// request requests a new token from the token endpoint. Credentials rejected by
+// the endpoint are read again once, to pick up a rotation.
+func (sess *Authentication) request(ctx context.Context) (*CachedToken, error) {
+	requested := time.Now()
+	authData, status, err := authenticate(ctx, sess.config)
+	if invalidator, ok := sess.config.Credentials.(Invalidator); ok && status == http.StatusUnauthorized {
+		invalidator.Invalidate()
+		authData, _, err = authenticate(ctx, sess.config)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var token struct {
+		Access_token string
+		Scope        string
+		Token_type   string
+		Expires_in   int
+	}
+	if err = json.Unmarshal(authData, &token); err != nil {
+		return nil, errors.Join(errors.New("authentication: error, while trying to unmarshal response from auth service"), err)
+	}
+	return &CachedToken{
+		AccessToken: token.Access_token,
+		Scope:       token.Scope,
+		TokenType:   token.Token_type,
+		ExpiresAt:   requested.Add(time.Duration(token.Expires_in) * time.Second),
+	}, nil
+}
+
+func authenticate(ctx context.Context, config Config) ([]byte, int, error) {
+
+	credentials, err := config.Credentials.Credentials(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
 
-	authUrl := fmt.Sprintf("%s/oauth2/token", strings.Replace(environment, "enode-api", "oauth", 1))
-	fmt.Println(authUrl)
 	form := url.Values{}
 	form.Add("grant_type", "client_credentials")
 
-	req, err := http.NewRequest("POST", authUrl, strings.NewReader(form.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", config.TokenUrl, strings.NewReader(form.Encode()))
 
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	req.PostForm = form
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-	req.SetBasicAuth(client_id, client_secret)
+	req.SetBasicAuth(credentials.ClientId, credentials.ClientSecret)
 
-	resp, err := http.DefaultClient.Do(req)
+	client := config.HttpClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
 
 	if err != nil {
-		fmt.Printf("client: could not execute authentication request: \n")
-		return nil, errors.Join(errors.New("client: could not execute authentication request"), err)
+		return nil, 0, errors.Join(errors.New("client: could not execute authentication request"), err)
 	}
+	defer resp.Body.Close()
 
 	resBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		fmt.Printf("client: could not read response body: %s\n", err)
-		return nil, errors.Join(errors.New("client: could not read response body: \n"), err)
+		return nil, resp.StatusCode, errors.Join(errors.New("client: could not read response body: \n"), err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusUnauthorized:
-		return resBody, errors.Join(fmt.Errorf("client: unauthorized access \n %+v", resp.Status))
+		return resBody, resp.StatusCode, errors.Join(fmt.Errorf("client: unauthorized access \n %+v", resp.Status))
 	case http.StatusOK:
-		return resBody, nil
+		return resBody, resp.StatusCode, nil
 	default:
-		return resBody, errors.Join(fmt.Errorf("client: error during authentication  \n %+v", resp))
+		return resBody, resp.StatusCode, errors.Join(fmt.Errorf("client: error during authentication  \n %+v", resp.Status))
 	}
 
 }
@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	TOKEN_CACHE_ERROR string = "authentication: unable to access the token cache"
+	TOKEN_LOCK_ERROR  string = "authentication: unable to lock the token cache"
+)
+
+// CACHE_MARGIN is the remaining lifetime below which a cached token is no longer used.
+const CACHE_MARGIN = time.Minute
+
+// STALE_LOCK is the age after which the lock of a crashed process is broken.
+const STALE_LOCK = 30 * time.Second
+
+// CachedToken is an access token stored in a TokenCache.
+type CachedToken struct {
+	AccessToken string    `json:"accessToken"`
+	Scope       string    `json:"scope"`
+	TokenType   string    `json:"tokenType"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// valid reports whether the token may still be used at now.
+func (t *CachedToken) valid(now time.Time) bool {
+	return t != nil && t.AccessToken != "" && now.Add(CACHE_MARGIN).Before(t.ExpiresAt)
+}
+
+// TokenCache stores access tokens, so processes of the same client share them
+// instead of requesting a token each. Implementations may be backed by files,
+// or by stores like Redis for workers on several hosts.
+type TokenCache interface {
+	// Get returns the token stored for key, or nil if there is none.
+	Get(ctx context.Context, key string) (*CachedToken, error)
+	Put(ctx context.Context, key string, token *CachedToken) error
+}
+
+// TokenLocker is implemented by caches which can serialize token requests
+// across processes, so many workers starting at once request a single token.
+type TokenLocker interface {
+	// Lock blocks until the lock of key is held and returns the function releasing it.
+	Lock(ctx context.Context, key string) (unlock func(), err error)
+}
+
+// CacheKey returns the key of the tokens of a client in an environment, not revealing the client ID.
+func CacheKey(clientId, tokenUrl string) string {
+	sum := sha256.Sum256([]byte(clientId + "\x00" + tokenUrl))
+	return hex.EncodeToString(sum[:])
+}
+
+// FileTokenCache stores tokens as files readable by the owner only, locking
+// them with lock files next to them.
+type FileTokenCache struct {
+	Dir string
+}
+
+// DefaultTokenCache returns a file cache in the cache directory of the user.
+func DefaultTokenCache() (*FileTokenCache, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, errors.Join(errors.New(TOKEN_CACHE_ERROR), err)
+	}
+	return &FileTokenCache{Dir: filepath.Join(dir, "enode-gosdk", "tokens")}, nil
+}
+
+func (c *FileTokenCache) path(key, extension string) string {
+	return filepath.Join(c.Dir, key+extension)
+}
+
+func (c *FileTokenCache) Get(ctx context.Context, key string) (*CachedToken, error) {
+	data, err := os.ReadFile(c.path(key, ".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Join(errors.New(TOKEN_CACHE_ERROR), err)
+	}
+	var token CachedToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		// a corrupt entry is replaced by the next token
+		return nil, nil
+	}
+	return &token, nil
+}
+
+func (c *FileTokenCache) Put(ctx context.Context, key string, token *CachedToken) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return errors.Join(errors.New(TOKEN_CACHE_ERROR), err)
+	}
+	if err := os.MkdirAll(c.Dir, 0o700); err != nil {
+		return errors.Join(errors.New(TOKEN_CACHE_ERROR), err)
+	}
+
+	// readers see either the previous or the new token, never a partial file
+	file, err := os.CreateTemp(c.Dir, key+".*.tmp")
+	if err != nil {
+		return errors.Join(errors.New(TOKEN_CACHE_ERROR), err)
+	}
+	defer os.Remove(file.Name())
+	if err := file.Chmod(0o600); err != nil {
+		file.Close()
+		return errors.Join(errors.New(TOKEN_CACHE_ERROR), err)
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return errors.Join(errors.New(TOKEN_CACHE_ERROR), err)
+	}
+	if err := file.Close(); err != nil {
+		return errors.Join(errors.New(TOKEN_CACHE_ERROR), err)
+	}
+	if err := os.Rename(file.Name(), c.path(key, ".json")); err != nil {
+		return errors.Join(errors.New(TOKEN_CACHE_ERROR), err)
+	}
+	return nil
+}
+
+// Lock creates the lock file of key exclusively, waiting while another process
+// holds it. Lock files older than STALE_LOCK are removed.
+func (c *FileTokenCache) Lock(ctx context.Context, key string) (func(), error) {
+	if err := os.MkdirAll(c.Dir, 0o700); err != nil {
+		return nil, errors.Join(errors.New(TOKEN_LOCK_ERROR), err)
+	}
+	path := c.path(key, ".lock")
+	for {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			file.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, errors.Join(errors.New(TOKEN_LOCK_ERROR), err)
+		}
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > STALE_LOCK {
+			os.Remove(path)
+			continue
+		}
+
+		timer := time.NewTimer(50 * time.Millisecond)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, errors.Join(errors.New(TOKEN_LOCK_ERROR), ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
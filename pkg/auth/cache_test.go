@@ -0,0 +1,111 @@
+package auth_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/enodetest"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+)
+
+func tokenRequests(server *enodetest.Server) int {
+	count := 0
+	for _, request := range server.Requests() {
+		if request.Path == "/oauth2/token" {
+			count++
+		}
+	}
+	return count
+}
+
+func TestFileTokenCache_SharedAcrossWorkers(t *testing.T) {
+	server := enodetest.NewServer()
+	defer server.Close()
+	cache := &auth.FileTokenCache{Dir: t.TempDir()}
+	config := auth.Config{ClientId: enodetest.CLIENT_ID, ClientSecret: enodetest.CLIENT_SECRET, Environment: server.URL, Cache: cache}
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 10)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			authentication, err := auth.New(config)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+			tokens[i] = authentication.Token()
+		}(i)
+	}
+	wg.Wait()
+
+	if count := tokenRequests(server); count != 1 {
+		t.Errorf("Expected a single token request, got %d", count)
+	}
+	for _, token := range tokens[1:] {
+		if token != tokens[0] {
+			t.Errorf("Expected all workers to share token %s, got %s", tokens[0], token)
+		}
+	}
+
+	key := auth.CacheKey(enodetest.CLIENT_ID, server.URL+"/oauth2/token")
+	info, err := os.Stat(filepath.Join(cache.Dir, key+".json"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("Expected the token file to be readable by the owner only, got %v", info.Mode().Perm())
+	}
+}
+
+func TestFileTokenCache_Expiry(t *testing.T) {
+	server := enodetest.NewServer()
+	defer server.Close()
+	cache := &auth.FileTokenCache{Dir: t.TempDir()}
+	key := auth.CacheKey(enodetest.CLIENT_ID, server.URL+"/oauth2/token")
+	config := auth.Config{ClientId: enodetest.CLIENT_ID, ClientSecret: enodetest.CLIENT_SECRET, Environment: server.URL, Cache: cache}
+
+	// a token close to its expiry is not reused
+	cache.Put(context.Background(), key, &auth.CachedToken{AccessToken: "expiring", ExpiresAt: time.Now().Add(30 * time.Second)})
+	authentication, err := auth.New(config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if authentication.Token() == "expiring" || tokenRequests(server) != 1 {
+		t.Errorf("Expected a new token instead of the expiring one")
+	}
+
+	// a token rejected by the API is replaced in the cache
+	server.RotateSecret(enodetest.CLIENT_SECRET)
+	sess := session.NewSession(authentication)
+	if _, err := vehicles.ListVehicles(context.Background(), sess, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	cached, _ := cache.Get(context.Background(), key)
+	if tokenRequests(server) != 2 || cached.AccessToken != authentication.Token() {
+		t.Errorf("Expected the revoked token to be replaced, got %d token requests", tokenRequests(server))
+	}
+}
+
+func TestFileTokenCache_StaleLock(t *testing.T) {
+	cache := &auth.FileTokenCache{Dir: t.TempDir()}
+	lock := filepath.Join(cache.Dir, "key.lock")
+	os.WriteFile(lock, nil, 0o600)
+	old := time.Now().Add(-2 * auth.STALE_LOCK)
+	os.Chtimes(lock, old, old)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	unlock, err := cache.Lock(ctx, "key")
+	if err != nil {
+		t.Fatalf("Expected the stale lock to be broken, got error: %v", err)
+	}
+	unlock()
+}
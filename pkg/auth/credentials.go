@@ -0,0 +1,210 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	CREDENTIALS_ERROR string = "authentication: unable to read client credentials"
+)
+
+// Credentials are the client credentials of an Enode client.
+type Credentials struct {
+	ClientId     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"`
+}
+
+// CredentialsProvider supplies the client credentials for token requests, so
+// secrets need not be held as plain strings by the application.
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// Invalidator is implemented by providers caching credentials. Invalidate is
+// called when the token endpoint rejects the credentials, e.g. after a rotation,
+// so they are read again for the next attempt.
+type Invalidator interface {
+	Invalidate()
+}
+
+// StaticCredentials provides fixed credentials.
+type StaticCredentials Credentials
+
+func (c StaticCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	return Credentials(c), nil
+}
+
+// EnvCredentials reads the credentials from environment variables on every token request.
+type EnvCredentials struct {
+	ClientIdVar     string
+	ClientSecretVar string
+	// Getenv looks up the variables, os.Getenv if nil.
+	Getenv func(string) string
+}
+
+// FromEnv reads the credentials from ENODE_CLIENT_ID and ENODE_CLIENT_SECRET.
+func FromEnv() *EnvCredentials {
+	return &EnvCredentials{ClientIdVar: "ENODE_CLIENT_ID", ClientSecretVar: "ENODE_CLIENT_SECRET"}
+}
+
+func (c *EnvCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	getenv := c.Getenv
+	if getenv == nil {
+		getenv = os.Getenv
+	}
+	credentials := Credentials{ClientId: getenv(c.ClientIdVar), ClientSecret: getenv(c.ClientSecretVar)}
+	if credentials.ClientId == "" || credentials.ClientSecret == "" {
+		return Credentials{}, errors.Join(errors.New(CREDENTIALS_ERROR), errors.New(c.ClientIdVar+" and "+c.ClientSecretVar+" must be set"))
+	}
+	return credentials, nil
+}
+
+// FileCredentials reads the credentials from files, like secrets mounted into a
+// Kubernetes pod. The files are read again when they change, so rotated secrets
+// are picked up without restarting the process.
+type FileCredentials struct {
+	// ClientId is used if ClientIdFile is empty.
+	ClientId     string
+	ClientIdFile string
+	// ClientSecretFile holds the client secret. Surrounding whitespace is ignored.
+	ClientSecretFile string
+
+	mu    sync.Mutex
+	files map[string]cachedFile
+}
+
+type cachedFile struct {
+	modified time.Time
+	size     int64
+	content  string
+}
+
+// FromDirectory reads the credentials from the files client_id and client_secret of a directory.
+func FromDirectory(dir string) *FileCredentials {
+	return &FileCredentials{
+		ClientIdFile:     strings.TrimSuffix(dir, "/") + "/client_id",
+		ClientSecretFile: strings.TrimSuffix(dir, "/") + "/client_secret",
+	}
+}
+
+func (c *FileCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	credentials := Credentials{ClientId: c.ClientId}
+	if c.ClientIdFile != "" {
+		id, err := c.read(c.ClientIdFile)
+		if err != nil {
+			return Credentials{}, err
+		}
+		credentials.ClientId = id
+	}
+	secret, err := c.read(c.ClientSecretFile)
+	if err != nil {
+		return Credentials{}, err
+	}
+	credentials.ClientSecret = secret
+	return credentials, nil
+}
+
+// Invalidate forgets the cached files, in case a rotation kept their size and time.
+func (c *FileCredentials) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.files = nil
+}
+
+// read returns the content of a file, reading it again if it changed. Requires c.mu.
+func (c *FileCredentials) read(path string) (string, error) {
+	// Stat follows the symlinks Kubernetes swaps on updates of mounted secrets
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", errors.Join(errors.New(CREDENTIALS_ERROR), err)
+	}
+	if cached, ok := c.files[path]; ok && cached.modified.Equal(info.ModTime()) && cached.size == info.Size() {
+		return cached.content, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Join(errors.New(CREDENTIALS_ERROR), err)
+	}
+	content := strings.TrimSpace(string(data))
+	if content == "" {
+		return "", errors.Join(errors.New(CREDENTIALS_ERROR), errors.New(path+" is empty"))
+	}
+	if c.files == nil {
+		c.files = make(map[string]cachedFile)
+	}
+	c.files[path] = cachedFile{modified: info.ModTime(), size: info.Size(), content: content}
+	return content, nil
+}
+
+// ExecCredentials runs a command to obtain the credentials, e.g. the CLI of a
+// secret manager. The command prints either a JSON object with clientId and
+// clientSecret, or the client secret alone. The result is cached until the
+// token endpoint rejects it.
+type ExecCredentials struct {
+	// Command is the program and its arguments.
+	Command []string
+	// ClientId is used if the command prints the client secret only.
+	ClientId string
+
+	mu     sync.Mutex
+	cached *Credentials
+}
+
+func (c *ExecCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cached != nil {
+		return *c.cached, nil
+	}
+	if len(c.Command) == 0 {
+		return Credentials{}, errors.Join(errors.New(CREDENTIALS_ERROR), errors.New("no command configured"))
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.Command[0], c.Command[1:]...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			err = errors.Join(err, errors.New(message))
+		}
+		return Credentials{}, errors.Join(errors.New(CREDENTIALS_ERROR), err)
+	}
+
+	credentials := Credentials{ClientId: c.ClientId}
+	out = bytes.TrimSpace(out)
+	if bytes.HasPrefix(out, []byte("{")) {
+		if err := json.Unmarshal(out, &credentials); err != nil {
+			return Credentials{}, errors.Join(errors.New(CREDENTIALS_ERROR), err)
+		}
+		if credentials.ClientId == "" {
+			credentials.ClientId = c.ClientId
+		}
+	} else {
+		credentials.ClientSecret = string(out)
+	}
+	if credentials.ClientId == "" || credentials.ClientSecret == "" {
+		return Credentials{}, errors.Join(errors.New(CREDENTIALS_ERROR), errors.New("the command printed no complete credentials"))
+	}
+	c.cached = &credentials
+	return credentials, nil
+}
+
+// Invalidate makes the next token request run the command again.
+func (c *ExecCredentials) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cached = nil
+}
@@ -0,0 +1,79 @@
+package auth_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/enodetest"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+)
+
+// listAfterRotation checks that requests keep working after the secret of the
+// client was rotated on the server and in the source of the provider.
+func listAfterRotation(t *testing.T, credentials auth.CredentialsProvider, rotate func(secret string)) {
+	server := enodetest.NewServer()
+	defer server.Close()
+	server.AddVehicle("user_1", models.VehicleWithLocation{})
+
+	authentication, err := auth.New(auth.Config{Credentials: credentials, Environment: server.URL})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sess := session.NewSession(authentication)
+	if _, err := vehicles.ListVehicles(context.Background(), sess, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	rotate("rotated_secret")
+	server.RotateSecret("rotated_secret")
+
+	list, err := vehicles.ListVehicles(context.Background(), sess, nil)
+	if err != nil {
+		t.Fatalf("Expected the rotated secret to be used, got error: %v", err)
+	}
+	if len(list.Data) != 1 {
+		t.Errorf("Expected 1 vehicle, got %d", len(list.Data))
+	}
+}
+
+func TestFileCredentials_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content+"\n"), 0o600); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	write("client_id", enodetest.CLIENT_ID)
+	write("client_secret", enodetest.CLIENT_SECRET)
+
+	listAfterRotation(t, auth.FromDirectory(dir), func(secret string) { write("client_secret", secret) })
+}
+
+func TestExecCredentials_Rotation(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "secret.json")
+	write := func(secret string) {
+		if err := os.WriteFile(file, []byte(`{"clientId":"`+enodetest.CLIENT_ID+`","clientSecret":"`+secret+`"}`), 0o600); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	write(enodetest.CLIENT_SECRET)
+
+	listAfterRotation(t, &auth.ExecCredentials{Command: []string{"cat", file}}, write)
+}
+
+func TestEnvCredentials(t *testing.T) {
+	env := map[string]string{"ID": enodetest.CLIENT_ID, "SECRET": enodetest.CLIENT_SECRET}
+	credentials := &auth.EnvCredentials{ClientIdVar: "ID", ClientSecretVar: "SECRET", Getenv: func(key string) string { return env[key] }}
+
+	listAfterRotation(t, credentials, func(secret string) { env["SECRET"] = secret })
+
+	delete(env, "SECRET")
+	if _, err := credentials.Credentials(context.Background()); err == nil {
+		t.Error("Expected an error for a missing secret")
+	}
+}
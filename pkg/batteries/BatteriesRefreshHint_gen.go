@@ -0,0 +1,32 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package batteries
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Use this endpoint to initiate an expedited data refresh for the specified battery.
+
+Note: The Enode platform keeps data automatically up-to-date and detects changes in the OEM APIs within seconds to a few minutes. We change the refresh interval dynamically based on a number of heuristics. This ensures we find the best trade-off between the stability of the connection to the OEM and freshness of the data.
+This method overrides most of our heuristics and should therefore be used with caution. You may use it when you have a strong reason to believe the data might be stale.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - batteryId: The batteryId of the request.
+
+Returns:
+  - An error if any occurred during the request. If the request is successful, it returns nil.
+*/
+func BatteriesRefreshHint(ctx context.Context, sess *session.Session, batteryId string) error {
+	path := fmt.Sprintf("/batteries/%s/refresh-hint", url.PathEscape(batteryId))
+
+	return do(ctx, sess, http.MethodPost, path, nil, nil, nil)
+}
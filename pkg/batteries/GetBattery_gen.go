@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package batteries
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Get Battery
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - batteryId: The batteryId of the request.
+
+Returns:
+  - A pointer to the models.Battery object returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetBattery(ctx context.Context, sess *session.Session, batteryId string) (*models.Battery, error) {
+	path := fmt.Sprintf("/batteries/%s", url.PathEscape(batteryId))
+
+	var result models.Battery
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
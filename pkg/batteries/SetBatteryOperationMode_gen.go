@@ -0,0 +1,36 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package batteries
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Request an `operationMode` change for a battery. This request creates an Action that will retry until the battery's `operationMode` matches the expected value. The Action must complete before any further commands can be sent to the battery. Only one Action can be active for a specific battery at a time. If a new Action is created, the previous Action will be automatically cancelled and transitioned to the `CANCELLED` state. Regardless of operation mode, the battery's charge limit will not fall below `dischargeLimit` except in emergency power situations. Transitions can be tracked via the `user:vendor-action:updated` webhook event or [Get Operation Mode Action](/api/reference#getBatteriesAction).
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - batteryId: The batteryId of the request.
+  - payload: The request body sent to the API.
+
+Returns:
+  - A pointer to the models.OperationModeAction object returned by the API.
+  - An error if any occurred during the request.
+*/
+func SetBatteryOperationMode(ctx context.Context, sess *session.Session, batteryId string, payload *models.SetBatteryOperationModePayload) (*models.OperationModeAction, error) {
+	path := fmt.Sprintf("/batteries/%s/operation-mode", url.PathEscape(batteryId))
+
+	var result models.OperationModeAction
+	if err := do(ctx, sess, http.MethodPost, path, nil, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
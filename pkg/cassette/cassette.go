@@ -0,0 +1,386 @@
+// Package cassette records the HTTP interactions of the SDK into cassette
+// files and replays them offline, so tests written against the real API run
+// without network or credentials.
+//
+// Recorded cassettes contain no secrets: Authorization headers are dropped,
+// tokens and webhook secrets are redacted and user IDs are replaced by a
+// stable pseudonym. Requests are matched on method, path, query and body.
+package cassette
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+type Mode int
+
+const (
+	// MODE_REPLAY answers requests from the cassette and fails for unknown requests.
+	MODE_REPLAY Mode = iota
+	// MODE_RECORD sends requests to the API and records them, replacing the cassette.
+	MODE_RECORD
+	// MODE_AUTO replays an existing cassette and records a missing one.
+	MODE_AUTO
+)
+
+const (
+	// MODE_ENV selects the mode of ModeFromEnv: "record", "replay" or "auto".
+	MODE_ENV = "ENODE_CASSETTE_MODE"
+
+	// REDACTED replaces tokens and secrets in recorded bodies.
+	REDACTED = "REDACTED"
+
+	CASSETTE_READ_ERROR  string = "cassette: could not read cassette"
+	CASSETTE_WRITE_ERROR string = "cassette: could not write cassette"
+	CASSETTE_MATCH_ERROR string = "cassette: no recorded interaction matches request"
+)
+
+// redactedKeys are JSON keys whose values are never written to a cassette.
+var redactedKeys = map[string]bool{
+	"access_token":  true,
+	"client_secret": true,
+	"secret":        true,
+	"linkToken":     true,
+	"linkUrl":       true,
+	"headerValue":   true,
+}
+
+// dropped are response headers which are not recorded. Request headers are never recorded.
+var dropped = map[string]bool{
+	"Set-Cookie":     true,
+	"Content-Length": true,
+	"Date":           true,
+}
+
+// ModeFromEnv returns the mode set in MODE_ENV, or fallback if it is unset or unknown.
+func ModeFromEnv(fallback Mode) Mode {
+	switch strings.ToLower(os.Getenv(MODE_ENV)) {
+	case "record":
+		return MODE_RECORD
+	case "replay":
+		return MODE_REPLAY
+	case "auto":
+		return MODE_AUTO
+	}
+	return fallback
+}
+
+type Request struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+type Response struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Cassette is the content of a cassette file.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper recording to or replaying from a cassette
+// file, to be used as transport of a session's HttpClient.
+type Recorder struct {
+	// Base sends the requests while recording, http.DefaultTransport if nil.
+	Base http.RoundTripper
+
+	path string
+	mode Mode
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+	// aliases maps user ID pseudonyms to the IDs seen in replayed requests.
+	aliases map[string]string
+}
+
+/*
+Creates a recorder for a cassette file.
+
+Parameters:
+  - path: The cassette file. Its directory is created when the cassette is saved.
+  - mode: Whether to record or replay. MODE_AUTO records if the file does not exist.
+
+Returns:
+  - A pointer to the Recorder. Call Save after recording to write the cassette.
+  - An error with CASSETTE_READ_ERROR if an existing cassette cannot be read.
+*/
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode, aliases: make(map[string]string)}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case mode == MODE_RECORD:
+		return r, nil
+	case errors.Is(err, os.ErrNotExist) && mode == MODE_AUTO:
+		r.mode = MODE_RECORD
+		return r, nil
+	case err != nil:
+		return nil, errors.Join(errors.New(CASSETTE_READ_ERROR), err)
+	}
+
+	if err := json.Unmarshal(data, &r.cassette); err != nil {
+		return nil, errors.Join(errors.New(CASSETTE_READ_ERROR), err)
+	}
+	r.mode = MODE_REPLAY
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// Recording reports whether requests are sent to the API.
+func (r *Recorder) Recording() bool {
+	return r.mode == MODE_RECORD
+}
+
+// Save writes the recorded interactions to the cassette file. It does nothing when replaying.
+func (r *Recorder) Save() error {
+	if !r.Recording() {
+		return nil
+	}
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return errors.Join(errors.New(CASSETTE_WRITE_ERROR), err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return errors.Join(errors.New(CASSETTE_WRITE_ERROR), err)
+	}
+	if err := os.WriteFile(r.path, append(data, '\n'), 0o644); err != nil {
+		return errors.Join(errors.New(CASSETTE_WRITE_ERROR), err)
+	}
+	return nil
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	request := scrubRequest(req, body)
+
+	if r.Recording() {
+		return r.record(req, request)
+	}
+	return r.replay(req, request)
+}
+
+func (r *Recorder) record(req *http.Request, request Request) (*http.Response, error) {
+	base := r.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := http.Header{}
+	for name, values := range resp.Header {
+		if !dropped[name] {
+			header[name] = values
+		}
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request:  request,
+		Response: Response{Status: resp.StatusCode, Header: header, Body: scrubBody(request.Path, body)},
+	})
+	r.mu.Unlock()
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, request Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.learnAliases(req.URL.Path)
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !matches(interaction.Request, request) {
+			continue
+		}
+		r.used[i] = true
+
+		body := interaction.Response.Body
+		for pseudonym, id := range r.aliases {
+			body = strings.ReplaceAll(body, pseudonym, id)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+			StatusCode:    interaction.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	path := request.Path
+	if request.Query != "" {
+		path += "?" + request.Query
+	}
+	return nil, errors.Join(errors.New(CASSETTE_MATCH_ERROR), fmt.Errorf("%s %s", request.Method, path))
+}
+
+// learnAliases remembers the user IDs of a request path, so their pseudonyms
+// in replayed responses can be replaced with the IDs the caller knows.
+func (r *Recorder) learnAliases(path string) {
+	if id, ok := userIdOfPath(path); ok {
+		r.aliases[Pseudonym(id)] = id
+	}
+}
+
+func matches(recorded, request Request) bool {
+	return recorded.Method == request.Method && recorded.Path == request.Path &&
+		equalQueries(recorded.Query, request.Query) && equalBodies(recorded.Body, request.Body)
+}
+
+// equalQueries compares query strings independent of the order of their
+// parameters, so the pages of a list are told apart.
+func equalQueries(a, b string) bool {
+	if a == b {
+		return true
+	}
+	va, errA := url.ParseQuery(a)
+	vb, errB := url.ParseQuery(b)
+	if errA != nil || errB != nil || len(va) != len(vb) {
+		return false
+	}
+	for key, values := range va {
+		if !slices.Equal(values, vb[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalBodies compares JSON bodies independent of formatting and key order.
+func equalBodies(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var va, vb any
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	ca, _ := json.Marshal(va)
+	cb, _ := json.Marshal(vb)
+	return bytes.Equal(ca, cb)
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// Pseudonym returns the stable replacement of a user ID in cassettes. It is
+// derived from the ID, so replayed requests for the same user match.
+func Pseudonym(userId string) string {
+	if strings.HasPrefix(userId, "user-") && len(userId) == 17 {
+		return userId
+	}
+	sum := sha256.Sum256([]byte(userId))
+	return "user-" + hex.EncodeToString(sum[:6])
+}
+
+func userIdOfPath(path string) (string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) >= 2 && segments[0] == "users" && segments[1] != "" {
+		return segments[1], true
+	}
+	return "", false
+}
+
+func scrubPath(path string) string {
+	if id, ok := userIdOfPath(path); ok {
+		return strings.Replace(path, "/users/"+id, "/users/"+Pseudonym(id), 1)
+	}
+	return path
+}
+
+func scrubRequest(req *http.Request, body []byte) Request {
+	return Request{
+		Method: req.Method,
+		Path:   scrubPath(req.URL.Path),
+		Query:  req.URL.RawQuery,
+		Body:   scrubBody(req.URL.Path, body),
+	}
+}
+
+// scrubBody redacts secrets and replaces user IDs in JSON bodies. Other bodies are kept unchanged.
+func scrubBody(path string, body []byte) string {
+	var value any
+	if len(body) == 0 || json.Unmarshal(body, &value) != nil {
+		return string(body)
+	}
+	// the "id" of user resources is a user ID as well
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	users := segments[0] == "users" && len(segments) <= 2
+	data, err := json.Marshal(scrubValue(value, users))
+	if err != nil {
+		return string(body)
+	}
+	return string(data)
+}
+
+func scrubValue(value any, users bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			text, isString := field.(string)
+			switch {
+			case redactedKeys[key] && isString:
+				v[key] = REDACTED
+			case (key == "userId" || (users && key == "id")) && isString:
+				v[key] = Pseudonym(text)
+			default:
+				v[key] = scrubValue(field, users)
+			}
+		}
+	case []any:
+		for i := range v {
+			v[i] = scrubValue(v[i], users)
+		}
+	}
+	return value
+}
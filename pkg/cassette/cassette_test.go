@@ -0,0 +1,182 @@
+package cassette_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/cassette"
+	"github.com/addihorn/enode-gosdk/pkg/enodetest"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/users"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+	"github.com/addihorn/enode-gosdk/pkg/webhooks"
+)
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	server := enodetest.NewServer()
+	vehicle := server.AddVehicle("secret-user-id", models.VehicleWithLocation{})
+
+	// Record against the fake server
+	recorder, err := cassette.NewRecorder(path, cassette.MODE_AUTO)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !recorder.Recording() {
+		t.Fatal("Expected a missing cassette to be recorded")
+	}
+	authentication, err := auth.NewAuthentication(enodetest.CLIENT_ID, enodetest.CLIENT_SECRET, server.URL, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sess := session.NewSession(authentication)
+	sess.HttpClient = &http.Client{Transport: recorder}
+
+	if _, err := users.GetUser(sess, "secret-user-id"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := webhooks.CreateWebhook(context.Background(), sess, &models.WebhookCreatePayload{Url: "http://localhost:1", Secret: "webhook-secret"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	recorded, err := vehicles.GetVehicle(context.Background(), sess, vehicle.Id)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	server.Close()
+
+	data, _ := os.ReadFile(path)
+	for _, secret := range []string{"secret-user-id", "webhook-secret", authentication.Access_token} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("Expected %q to be scrubbed from the cassette", secret)
+		}
+	}
+
+	// Replay without the server
+	replayer, err := cassette.NewRecorder(path, cassette.MODE_AUTO)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sess.HttpClient = &http.Client{Transport: replayer}
+
+	user, err := users.GetUser(sess, "secret-user-id")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if user.Id != "secret-user-id" {
+		t.Errorf("Expected the user ID of the request, got %s", user.Id)
+	}
+	if _, err := webhooks.CreateWebhook(context.Background(), sess, &models.WebhookCreatePayload{Url: "http://localhost:1", Secret: "other-secret"}); err != nil {
+		t.Errorf("Expected secrets to be ignored when matching, got %v", err)
+	}
+	replayed, err := vehicles.GetVehicle(context.Background(), sess, vehicle.Id)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// the user ID is restored, as it was used in a previous request
+	if replayed.Id != recorded.Id || replayed.UserId != "secret-user-id" {
+		t.Errorf("Unexpected replayed vehicle: %+v", replayed)
+	}
+
+	_, err = vehicles.GetVehicle(context.Background(), sess, vehicle.Id)
+	if err == nil || !strings.Contains(err.Error(), cassette.CASSETTE_MATCH_ERROR) {
+		t.Errorf("Expected interactions to be replayed once, got %v", err)
+	}
+}
+
+func TestRecorder_MatchesBody(t *testing.T) {
+	path := filepath.Join("testdata", "charging.json")
+	recorder, err := cassette.NewRecorder(path, cassette.MODE_REPLAY)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sess := &session.Session{
+		Authentication: &auth.Authentication{Environment: "https://enode-api.sandbox.enode.io", Access_token: "test_token"},
+		HttpClient:     &http.Client{Transport: recorder},
+	}
+
+	action, err := vehicles.ControlVehicleCharging(context.Background(), sess, "test_vehicle_id",
+		&models.ControlChargingPayload{Action: models.CHARGING_ACTION_STOP})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if action.Kind != models.CHARGING_ACTION_STOP {
+		t.Errorf("Expected the STOP interaction, got %s", action.Kind)
+	}
+
+	_, err = vehicles.ControlVehicleCharging(context.Background(), sess, "other_vehicle_id",
+		&models.ControlChargingPayload{Action: models.CHARGING_ACTION_START})
+	if err == nil || !strings.Contains(err.Error(), cassette.CASSETTE_MATCH_ERROR) {
+		t.Errorf("Expected no match for another path, got %v", err)
+	}
+}
+
+func TestRecorder_MatchesQuery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pages.json")
+	server := enodetest.NewServer()
+	server.AddVehicle("user-1", models.VehicleWithLocation{})
+	server.AddVehicle("user-1", models.VehicleWithLocation{})
+
+	recorder, err := cassette.NewRecorder(path, cassette.MODE_RECORD)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	authentication, err := auth.NewAuthentication(enodetest.CLIENT_ID, enodetest.CLIENT_SECRET, server.URL, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sess := session.NewSession(authentication)
+	sess.HttpClient = &http.Client{Transport: recorder}
+
+	pageSize := 1
+	first, err := vehicles.ListVehicles(context.Background(), sess, &models.PaginationParams{PageSize: &pageSize})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	second, err := vehicles.ListVehicles(context.Background(), sess, &models.PaginationParams{PageSize: &pageSize, After: first.Pagination.After})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	server.Close()
+
+	replayer, err := cassette.NewRecorder(path, cassette.MODE_REPLAY)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sess.HttpClient = &http.Client{Transport: replayer}
+
+	// the pages are requested in reverse order and still get their own response
+	replayed, err := vehicles.ListVehicles(context.Background(), sess, &models.PaginationParams{PageSize: &pageSize, After: first.Pagination.After})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(replayed.Data) != 1 || replayed.Data[0].Id != second.Data[0].Id {
+		t.Errorf("Expected the second page, got %+v", replayed.Data)
+	}
+	replayed, err = vehicles.ListVehicles(context.Background(), sess, &models.PaginationParams{PageSize: &pageSize})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(replayed.Data) != 1 || replayed.Data[0].Id != first.Data[0].Id {
+		t.Errorf("Expected the first page, got %+v", replayed.Data)
+	}
+}
+
+func TestNewRecorder_MissingCassette(t *testing.T) {
+	_, err := cassette.NewRecorder(filepath.Join(t.TempDir(), "missing.json"), cassette.MODE_REPLAY)
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected a missing cassette error, got %v", err)
+	}
+}
@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package chargers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Overrides an active smart feature by forcing the charger to start charging. This feature is meant to be used in situations where the user wants to charge immediately without disabling other smart features. The override remains active until the charger stops charging, or until the [End Smart Override](/api/reference#chargerEndSmartOverride) endpoint is called. When the override ends, the overridden smart feature will regain control of the charger. This endpoint should not be used for standard charge control, use the [Control Charging](/api/reference#postVehiclesVehicleidCharging) endpoint instead.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - chargerId: The chargerId of the request.
+
+Returns:
+  - A pointer to the models.ChargerOnlySmartOverrideResponse object returned by the API.
+  - An error if any occurred during the request.
+*/
+func ChargerCreateSmartOverride(ctx context.Context, sess *session.Session, chargerId string) (*models.ChargerOnlySmartOverrideResponse, error) {
+	path := fmt.Sprintf("/chargers/%s/smart-override", url.PathEscape(chargerId))
+
+	var result models.ChargerOnlySmartOverrideResponse
+	if err := do(ctx, sess, http.MethodPost, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
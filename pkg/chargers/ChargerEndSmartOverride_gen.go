@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package chargers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Ends any active Smart Override for the charger specified by `chargerId`. If previously configured, Schedules or Smart Charging will resume control over the target charger. Note that this does not mean the charger will stop charging, only that it will return to the state expected by the active Schedule or Smart Charging Plan.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - chargerId: The chargerId of the request.
+
+Returns:
+  - A pointer to the models.ChargerOnlySmartOverrideResponse object returned by the API.
+  - An error if any occurred during the request.
+*/
+func ChargerEndSmartOverride(ctx context.Context, sess *session.Session, chargerId string) (*models.ChargerOnlySmartOverrideResponse, error) {
+	path := fmt.Sprintf("/chargers/%s/smart-override", url.PathEscape(chargerId))
+
+	var result models.ChargerOnlySmartOverrideResponse
+	if err := do(ctx, sess, http.MethodDelete, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
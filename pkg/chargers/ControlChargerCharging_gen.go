@@ -0,0 +1,38 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package chargers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Request for a charger to start or stop charging. This request creates an Action that will retry until the charger's `powerDeliveryState` matches the expected value. The Action must complete before any further commands are sent to the charger. Only one Action can be active for a specific charger at a time. If a new Action is created, the previous Action will be automatically cancelled and transitioned to the `CANCELLED` state. Transitions can be tracked via the `user:vendor-action:updated` webhook event or [Get Charger Action](/api/reference#getChargersAction).
+
+	This endpoint returns an error with status code 422 if the charger is controlled by a schedule. To restore user control, either disable the schedule or use [Create Smart Override](/api/reference#chargerCreateSmartOverride) to temporarily enable charging.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - chargerId: The chargerId of the request.
+  - payload: The request body sent to the API.
+
+Returns:
+  - A pointer to the models.ChargeAction object returned by the API.
+  - An error if any occurred during the request.
+*/
+func ControlChargerCharging(ctx context.Context, sess *session.Session, chargerId string, payload *models.ControlChargingPayload) (*models.ChargeAction, error) {
+	path := fmt.Sprintf("/chargers/%s/charging", url.PathEscape(chargerId))
+
+	var result models.ChargeAction
+	if err := do(ctx, sess, http.MethodPost, path, nil, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
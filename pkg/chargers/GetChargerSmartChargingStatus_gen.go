@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package chargers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Get the current smart charging status for this charger
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - chargerId: The chargerId of the request.
+
+Returns:
+  - A pointer to the models.ChargerSmartChargingStatus object returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetChargerSmartChargingStatus(ctx context.Context, sess *session.Session, chargerId string) (*models.ChargerSmartChargingStatus, error) {
+	path := fmt.Sprintf("/chargers/%s/smart-charging-status", url.PathEscape(chargerId))
+
+	var result models.ChargerSmartChargingStatus
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,36 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package chargers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Register for a change of the `maxCurrent` field on a charger. This request creates an Action that will retry until the charger's `maxCurrent` matches the expected value. The Action must complete before any further commands are sent to the charger. Only one Action can be active for a specific charger at a time. If a new Action is created, the previous Action will be automatically cancelled and transitioned to the `CANCELLED` state. Transitions can be tracked via the `user:vendor-action:updated` webhook event or [Get Charger Action](/api/reference#getChargersAction).
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - chargerId: The chargerId of the request.
+  - payload: The request body sent to the API.
+
+Returns:
+  - A pointer to the models.MaxCurrentAction object returned by the API.
+  - An error if any occurred during the request.
+*/
+func SetChargerMaxCurrent(ctx context.Context, sess *session.Session, chargerId string, payload *models.TargetMaxCurrent) (*models.MaxCurrentAction, error) {
+	path := fmt.Sprintf("/chargers/%s/max-current", url.PathEscape(chargerId))
+
+	var result models.MaxCurrentAction
+	if err := do(ctx, sess, http.MethodPost, path, nil, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
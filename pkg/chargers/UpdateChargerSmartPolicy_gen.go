@@ -0,0 +1,36 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package chargers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Update the configured smart charging policy for this charger.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - chargerId: The chargerId of the request.
+  - payload: The request body sent to the API.
+
+Returns:
+  - A pointer to the models.ChargerSmartChargingPolicy object returned by the API.
+  - An error if any occurred during the request.
+*/
+func UpdateChargerSmartPolicy(ctx context.Context, sess *session.Session, chargerId string, payload *models.PartialChargerSmartChargingPolicy) (*models.ChargerSmartChargingPolicy, error) {
+	path := fmt.Sprintf("/chargers/%s/smart-charging-policy", url.PathEscape(chargerId))
+
+	var result models.ChargerSmartChargingPolicy
+	if err := do(ctx, sess, http.MethodPut, path, nil, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
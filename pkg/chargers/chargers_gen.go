@@ -0,0 +1,82 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+// Package chargers wraps the endpoints of the Enode API for the EV chargers of a user.
+package chargers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/internal/rest"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+const (
+	REST_CHARGER_TRANSFER_ERROR      string = "chargers: could not transfer request"
+	REST_CHARGER_READ_ERROR          string = "chargers: could not read response body"
+	REST_CHARGER_PARSE_ERROR         string = "chargers: unable to parse response data"
+	REST_CHARGER_PAYLOAD_ERROR       string = "chargers: unable to create request payload"
+	REST_CHARGER_UNAUTHORIZED_ERROR  string = "chargers: unauthorized access"
+	REST_CHARGER_FORBIDDEN_ERROR     string = "chargers: access to the resource is forbidden"
+	REST_CHARGER_NOT_FOUND_ERROR     string = "chargers: requested resource not found"
+	REST_CHARGER_VALIDATION_ERROR    string = "chargers: invalid request payload input"
+	REST_CHARGER_CONFLICT_ERROR      string = "chargers: request conflicts with the current state of the resource"
+	REST_CHARGER_UNPROCESSABLE_ERROR string = "chargers: request could not be processed for the target"
+	REST_CHARGER_RATE_LIMIT_ERROR    string = "chargers: too many requests"
+	REST_CHARGER_GENERAL_ERROR       string = "chargers: some kind of error occurred"
+)
+
+// do executes a request and decodes a successful response into result, if set.
+func do(ctx context.Context, sess *session.Session, method, path string, query url.Values, payload, result any) error {
+	req, err := rest.NewRequest(ctx, sess, method, path, query, payload)
+	if err != nil {
+		return errors.Join(errors.New(REST_CHARGER_PAYLOAD_ERROR), err)
+	}
+
+	resp, err := sess.Do(req)
+	if err != nil {
+		return errors.Join(errors.New(REST_CHARGER_TRANSFER_ERROR), err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Join(errors.New(REST_CHARGER_READ_ERROR), err)
+	}
+
+	switch resp.StatusCode {
+	default:
+		return errors.Join(errors.New(REST_CHARGER_GENERAL_ERROR), rest.Problem(resp, body))
+	case http.StatusBadRequest:
+		return errors.Join(errors.New(REST_CHARGER_VALIDATION_ERROR), rest.Problem(resp, body))
+	case http.StatusUnauthorized:
+		return errors.Join(errors.New(REST_CHARGER_UNAUTHORIZED_ERROR), rest.Problem(resp, body))
+	case http.StatusForbidden:
+		return errors.Join(errors.New(REST_CHARGER_FORBIDDEN_ERROR), rest.Problem(resp, body))
+	case http.StatusNotFound:
+		return errors.Join(errors.New(REST_CHARGER_NOT_FOUND_ERROR), rest.Problem(resp, body))
+	case http.StatusConflict:
+		return errors.Join(errors.New(REST_CHARGER_CONFLICT_ERROR), rest.Problem(resp, body))
+	case http.StatusUnprocessableEntity:
+		return errors.Join(errors.New(REST_CHARGER_UNPROCESSABLE_ERROR), rest.Problem(resp, body))
+	case http.StatusTooManyRequests:
+		return errors.Join(errors.New(REST_CHARGER_RATE_LIMIT_ERROR), rest.Problem(resp, body))
+	case http.StatusBadGateway:
+		return errors.Join(errors.New(REST_CHARGER_TRANSFER_ERROR), rest.Problem(resp, body))
+	case http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent:
+		if result == nil {
+			return nil
+		}
+		if len(body) == 0 {
+			return errors.Join(errors.New(REST_CHARGER_READ_ERROR), io.EOF)
+		}
+		if err := json.Unmarshal(body, result); err != nil {
+			return errors.Join(errors.New(REST_CHARGER_PARSE_ERROR), err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,177 @@
+// Package costs attributes the cost of charging sessions to the rates of the
+// tariff scheduled for their location, e.g. to reconcile billing:
+//
+//	sessions, err := statistics.GetChargingSessionsStatistics(ctx, sess, userId, params)
+//	schedule, err := tariffs.GetUserLocationTariff(ctx, sess, locationId)
+//	report, err := costs.Calculate(sessions, planner.ScheduledPrices{Schedule: schedule, Tariffs: rates}, "NOK")
+//
+// The statistics of a session report its energy only, so the energy is
+// spread evenly over the duration of the session.
+package costs
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/planner"
+)
+
+const (
+	COST_SESSION_ERROR string = "costs: unable to attribute the cost of the session"
+)
+
+// Interval is the part of a session charged at one rate.
+type Interval struct {
+	From     time.Time
+	To       time.Time
+	TariffId string
+	RateName string
+	// Energy in kWh.
+	Energy float64
+	// Price per kWh.
+	Price models.MonetaryAmount
+	Cost  models.MonetaryAmount
+}
+
+// RateTotal sums the intervals charged at one rate.
+type RateTotal struct {
+	TariffId string
+	RateName string
+	Energy   float64
+	Cost     models.MonetaryAmount
+}
+
+// SessionCost is the cost of a session, split into the intervals of the schedule.
+type SessionCost struct {
+	Session   models.SessionsStatisticsTimeseriesItem
+	Intervals []Interval
+	Energy    float64
+	Cost      models.MonetaryAmount
+	Currency  models.CurrencyCode
+	// Rates sums the intervals by rate, ordered by tariff and rate name.
+	Rates []RateTotal
+}
+
+// Report is the cost of a list of sessions.
+type Report struct {
+	Sessions []SessionCost
+	Energy   float64
+	Cost     models.MonetaryAmount
+	Currency models.CurrencyCode
+	// Rates sums all sessions by rate, ordered by tariff and rate name.
+	Rates []RateTotal
+}
+
+/*
+Attributes the cost of a charging session to the scheduled rates.
+
+Parameters:
+  - session: The statistics of the session.
+  - prices: The tariffs and schedule of the location of the session.
+  - currency: The currency of the tariffs.
+
+Returns:
+  - The cost of the session, split where the scheduled rate changes.
+  - An error if no rate is scheduled during a part of the session.
+*/
+func CalculateSession(session models.SessionsStatisticsTimeseriesItem, prices planner.ScheduledPrices, currency models.CurrencyCode) (SessionCost, error) {
+	result := SessionCost{Session: session, Currency: currency, Intervals: []Interval{}}
+	duration := session.To.Sub(session.From)
+	if duration <= 0 {
+		// an instant session is charged at the rate of its start
+		rate, err := prices.RateAt(session.From)
+		if err != nil {
+			return result, errors.Join(errors.New(COST_SESSION_ERROR), fmt.Errorf("session %s", session.Id), err)
+		}
+		result.Intervals = append(result.Intervals, interval(session.From, session.To, rate, session.KwhSum))
+	}
+	for from := session.From; from.Before(session.To); {
+		rate, err := prices.RateAt(from)
+		if err != nil {
+			return result, errors.Join(errors.New(COST_SESSION_ERROR), fmt.Errorf("session %s", session.Id), err)
+		}
+		to := rate.Until
+		if to.After(session.To) {
+			to = session.To
+		}
+		energy := session.KwhSum * float64(to.Sub(from)) / float64(duration)
+		result.Intervals = append(result.Intervals, interval(from, to, rate, energy))
+		from = to
+	}
+
+	for _, interval := range result.Intervals {
+		result.Energy += interval.Energy
+		result.Cost += interval.Cost
+	}
+	result.Rates = totals(result.Intervals)
+	return result, nil
+}
+
+/*
+Attributes the cost of charging sessions to the scheduled rates.
+
+Parameters:
+  - sessions: The statistics of the sessions, e.g. from GetChargingSessionsStatistics.
+  - prices: The tariffs and schedule of the location of the sessions.
+  - currency: The currency of the tariffs.
+
+Returns:
+  - The report of the costs per session and in total.
+  - An error joining the sessions whose cost could not be attributed. These are left out of the report.
+*/
+func Calculate(sessions models.SessionsStatisticsTimeseries, prices planner.ScheduledPrices, currency models.CurrencyCode) (Report, error) {
+	report := Report{Currency: currency, Sessions: []SessionCost{}}
+	var intervals []Interval
+	var errs []error
+	for _, session := range sessions {
+		cost, err := CalculateSession(session, prices, currency)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		report.Sessions = append(report.Sessions, cost)
+		report.Energy += cost.Energy
+		report.Cost += cost.Cost
+		intervals = append(intervals, cost.Intervals...)
+	}
+	report.Rates = totals(intervals)
+	return report, errors.Join(errs...)
+}
+
+func interval(from, to time.Time, rate *planner.ScheduledRate, energy float64) Interval {
+	return Interval{
+		From:     from,
+		To:       to,
+		TariffId: rate.TariffId,
+		RateName: rate.Name,
+		Energy:   energy,
+		Price:    models.MonetaryAmount(rate.Price),
+		Cost:     models.MonetaryAmount(rate.Price * energy),
+	}
+}
+
+func totals(intervals []Interval) []RateTotal {
+	byRate := map[[2]string]*RateTotal{}
+	for _, interval := range intervals {
+		key := [2]string{interval.TariffId, interval.RateName}
+		if byRate[key] == nil {
+			byRate[key] = &RateTotal{TariffId: interval.TariffId, RateName: interval.RateName}
+		}
+		byRate[key].Energy += interval.Energy
+		byRate[key].Cost += interval.Cost
+	}
+	rates := make([]RateTotal, 0, len(byRate))
+	for _, total := range byRate {
+		rates = append(rates, *total)
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		if rates[i].TariffId != rates[j].TariffId {
+			return rates[i].TariffId < rates[j].TariffId
+		}
+		return rates[i].RateName < rates[j].RateName
+	})
+	return rates
+}
@@ -0,0 +1,58 @@
+package costs_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/costs"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/planner"
+)
+
+func TestCalculate(t *testing.T) {
+	prices := planner.ScheduledPrices{
+		// Mondays only, 2024-01-01 is a Monday
+		Schedule: models.LocationTariffSchedule{
+			{Weekday: 0, FromHourMinute: "00:00", ToHourMinute: "06:00", TariffId: "grid", TariffName: "NIGHT"},
+			{Weekday: 0, FromHourMinute: "06:00", ToHourMinute: "00:00", TariffId: "grid", TariffName: "DAY"},
+		},
+		Tariffs: map[string]models.Tariff{
+			"grid": {{Name: "NIGHT", Cost: "0.5"}, {Name: "DAY", Cost: "1.5"}},
+		},
+	}
+	monday := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sessions := models.SessionsStatisticsTimeseries{
+		// 4 hours crossing the change to the day rate with 2 kWh per hour
+		{Id: "vehicle_1", KwhSum: 8, From: monday.Add(4 * time.Hour), To: monday.Add(8 * time.Hour)},
+		{Id: "vehicle_1", KwhSum: 3, From: monday.Add(20 * time.Hour), To: monday.Add(21 * time.Hour)},
+		// Tuesday has no schedule
+		{Id: "vehicle_1", KwhSum: 1, From: monday.Add(25 * time.Hour), To: monday.Add(26 * time.Hour)},
+	}
+
+	report, err := costs.Calculate(sessions, prices, "NOK")
+	if err == nil || !strings.HasPrefix(err.Error(), costs.COST_SESSION_ERROR) {
+		t.Errorf("Expected an error for the session on Tuesday, got %v", err)
+	}
+	if len(report.Sessions) != 2 {
+		t.Fatalf("Expected 2 sessions, got %d", len(report.Sessions))
+	}
+
+	first := report.Sessions[0]
+	if len(first.Intervals) != 2 || first.Intervals[0].RateName != "NIGHT" || !first.Intervals[0].To.Equal(monday.Add(6*time.Hour)) {
+		t.Errorf("Expected the session to be split at 06:00, got %+v", first.Intervals)
+	}
+	// 4 kWh at 0.5 and 4 kWh at 1.5
+	if math.Abs(float64(first.Cost)-8) > 1e-9 || math.Abs(first.Energy-8) > 1e-9 || first.Currency != "NOK" {
+		t.Errorf("Unexpected cost %v for %v kWh", first.Cost, first.Energy)
+	}
+
+	if math.Abs(float64(report.Cost)-12.5) > 1e-9 || len(report.Rates) != 2 {
+		t.Fatalf("Unexpected report %+v", report)
+	}
+	day := report.Rates[0]
+	if day.RateName != "DAY" || math.Abs(day.Energy-7) > 1e-9 || math.Abs(float64(day.Cost)-10.5) > 1e-9 {
+		t.Errorf("Unexpected total of the day rate %+v", day)
+	}
+}
@@ -0,0 +1,186 @@
+// Package devices lists the devices of all types of a user in one call.
+package devices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/addihorn/enode-gosdk/pkg/batteries"
+	"github.com/addihorn/enode-gosdk/pkg/chargers"
+	"github.com/addihorn/enode-gosdk/pkg/hvacs"
+	"github.com/addihorn/enode-gosdk/pkg/inverters"
+	"github.com/addihorn/enode-gosdk/pkg/meters"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+)
+
+const (
+	DEVICES_PARTIAL_ERROR string = "devices: unable to list all device types"
+)
+
+// TYPES lists the device types in the order devices are returned.
+var TYPES = []models.VendorType{
+	models.VENDOR_TYPE_VEHICLE,
+	models.VENDOR_TYPE_CHARGER,
+	models.VENDOR_TYPE_HVAC,
+	models.VENDOR_TYPE_BATTERY,
+	models.VENDOR_TYPE_INVERTER,
+	models.VENDOR_TYPE_METER,
+}
+
+// PartialError reports the device types which could not be listed.
+type PartialError struct {
+	Failed map[models.VendorType]error
+}
+
+func (e *PartialError) Error() string {
+	types := make([]string, 0, len(e.Failed))
+	for vendorType := range e.Failed {
+		types = append(types, string(vendorType))
+	}
+	sort.Strings(types)
+	messages := make([]string, len(types))
+	for i, vendorType := range types {
+		messages[i] = fmt.Sprintf("%s: %s", vendorType, e.Failed[models.VendorType(vendorType)])
+	}
+	return DEVICES_PARTIAL_ERROR + "\n" + strings.Join(messages, "\n")
+}
+
+func (e *PartialError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failed))
+	for _, err := range e.Failed {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// page lists a page of the devices of one type.
+type page func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) ([]models.Device, *string, error)
+
+func pageOf[T models.Device](data []T, after *string) ([]models.Device, *string) {
+	devices := make([]models.Device, len(data))
+	for i, device := range data {
+		devices[i] = device
+	}
+	return devices, after
+}
+
+var pages = map[models.VendorType]page{
+	models.VENDOR_TYPE_VEHICLE: func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) ([]models.Device, *string, error) {
+		list, err := vehicles.ListUserVehicles(ctx, sess, userId, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		devices, after := pageOf(list.Data, list.Pagination.After)
+		return devices, after, nil
+	},
+	models.VENDOR_TYPE_CHARGER: func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) ([]models.Device, *string, error) {
+		list, err := chargers.ListUserChargers(ctx, sess, userId, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		devices, after := pageOf(list.Data, list.Pagination.After)
+		return devices, after, nil
+	},
+	models.VENDOR_TYPE_HVAC: func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) ([]models.Device, *string, error) {
+		list, err := hvacs.ListUserHVACs(ctx, sess, userId, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		devices, after := pageOf(list.Data, list.Pagination.After)
+		return devices, after, nil
+	},
+	models.VENDOR_TYPE_BATTERY: func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) ([]models.Device, *string, error) {
+		list, err := batteries.ListUserBatteries(ctx, sess, userId, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		devices, after := pageOf(list.Data, list.Pagination.After)
+		return devices, after, nil
+	},
+	models.VENDOR_TYPE_INVERTER: func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) ([]models.Device, *string, error) {
+		list, err := inverters.ListUserInverters(ctx, sess, userId, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		devices, after := pageOf(list.Data, list.Pagination.After)
+		return devices, after, nil
+	},
+	models.VENDOR_TYPE_METER: func(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) ([]models.Device, *string, error) {
+		list, err := meters.ListUserMeters(ctx, sess, userId, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		devices, after := pageOf(list.Data, list.Pagination.After)
+		return devices, after, nil
+	},
+}
+
+/*
+Lists the devices of all types of a user, requesting the device types concurrently.
+
+Parameters:
+  - sess: The session of the client.
+  - userId: The ID of the user.
+
+Returns:
+  - The devices of the user, ordered by TYPES.
+  - A *PartialError if some device types could not be listed. The devices of
+    the other types are returned nevertheless.
+*/
+func ListUserDevices(ctx context.Context, sess *session.Session, userId string) ([]models.Device, error) {
+	results := make([][]models.Device, len(TYPES))
+	errs := make([]error, len(TYPES))
+
+	var wg sync.WaitGroup
+	for i, vendorType := range TYPES {
+		wg.Add(1)
+		go func(i int, list page) {
+			defer wg.Done()
+			results[i], errs[i] = listAll(ctx, sess, userId, list)
+		}(i, pages[vendorType])
+	}
+	wg.Wait()
+
+	devices := []models.Device{}
+	partial := &PartialError{Failed: map[models.VendorType]error{}}
+	for i, vendorType := range TYPES {
+		if errs[i] != nil {
+			partial.Failed[vendorType] = errs[i]
+			continue
+		}
+		devices = append(devices, results[i]...)
+	}
+	if len(partial.Failed) > 0 {
+		return devices, partial
+	}
+	return devices, nil
+}
+
+func listAll(ctx context.Context, sess *session.Session, userId string, list page) ([]models.Device, error) {
+	var devices []models.Device
+	params := &models.PaginationParams{}
+	for {
+		data, after, err := list(ctx, sess, userId, params)
+		if err != nil {
+			return nil, err
+		}
+		devices = append(devices, data...)
+		// the last page may have an empty cursor instead of null
+		if after == nil || *after == "" {
+			return devices, nil
+		}
+		params.After = after
+	}
+}
+
+// IsPartial reports whether err is a *PartialError, returned with the devices of the other types.
+func IsPartial(err error) bool {
+	var partial *PartialError
+	return errors.As(err, &partial)
+}
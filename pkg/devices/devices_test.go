@@ -0,0 +1,96 @@
+package devices_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/devices"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+func TestListUserDevices(t *testing.T) {
+	// Create a test server with a device of each type, failing for meters
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/users/user_1/vehicles":
+			// the second page is requested with the cursor of the first
+			if r.URL.Query().Get("after") == "" {
+				fmt.Fprint(w, `{"data":[{"id":"vehicle_1","userId":"user_1","vendor":"TESLA","isReachable":true,"information":{"brand":"Tesla"},"location":{"latitude":59.9,"longitude":10.7},"capabilities":{"startCharging":{"isCapable":true,"interventionIds":[]}}}],"pagination":{"after":"cursor","before":null}}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":[{"id":"vehicle_2","userId":"user_1","vendor":"AUDI"}],"pagination":{"after":null,"before":null}}`)
+		case "/users/user_1/chargers":
+			fmt.Fprint(w, `{"data":[{"id":"charger_1","userId":"user_1","vendor":"ZAPTEC","isReachable":true,"locationId":"location_1","information":{"brand":"Zaptec"}}],"pagination":{"after":null,"before":null}}`)
+		case "/users/user_1/hvacs":
+			fmt.Fprint(w, `{"data":[{"id":"hvac_1","userId":"user_1","vendor":"NIBE","information":{"brand":"NIBE"}}],"pagination":{"after":null,"before":null}}`)
+		case "/users/user_1/batteries":
+			fmt.Fprint(w, `{"data":[{"id":"battery_1","userId":"user_1","vendor":"TESLA"}],"pagination":{"after":null,"before":null}}`)
+		case "/users/user_1/inverters":
+			fmt.Fprint(w, `{"data":[{"id":"inverter_1","userId":"user_1","vendor":"SMA"}],"pagination":{"after":null,"before":null}}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"type":"https://developers.enode.com/api/problems/server-error","title":"Server Error"}`)
+		}
+	}))
+	defer ts.Close()
+
+	sess := session.NewSession(&auth.Authentication{Environment: ts.URL, Access_token: "test_token"})
+	list, err := devices.ListUserDevices(context.Background(), sess, "user_1")
+
+	var partial *devices.PartialError
+	if !errors.As(err, &partial) || len(partial.Failed) != 1 || partial.Failed[models.VENDOR_TYPE_METER] == nil {
+		t.Fatalf("Expected meters to fail only, got %v", err)
+	}
+	expected := []string{"vehicle_1", "vehicle_2", "charger_1", "hvac_1", "battery_1", "inverter_1"}
+	if len(list) != len(expected) {
+		t.Fatalf("Expected %d devices, got %d", len(expected), len(list))
+	}
+	for i, device := range list {
+		if device.DeviceId() != expected[i] {
+			t.Errorf("Expected device %s at %d, got %s", expected[i], i, device.DeviceId())
+		}
+	}
+
+	vehicle := list[0]
+	if vehicle.DeviceVendorType() != models.VENDOR_TYPE_VEHICLE || vehicle.DeviceBrand() != "Tesla" || !vehicle.DeviceIsReachable() ||
+		*vehicle.DeviceLocation().Latitude != 59.9 || !vehicle.DeviceCapabilities()["startCharging"].IsCapable {
+		t.Errorf("Unexpected vehicle %+v", vehicle)
+	}
+	charger := list[2]
+	if charger.DeviceVendor() != "ZAPTEC" || *charger.DeviceLocation().LocationId != "location_1" {
+		t.Errorf("Unexpected charger %+v", charger)
+	}
+}
+
+func TestListUserDevices_EmptyCursor(t *testing.T) {
+	vehiclePages := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/users/user_1/vehicles" {
+			vehiclePages++
+			fmt.Fprint(w, `{"data":[{"id":"vehicle_1","userId":"user_1","vendor":"TESLA"}],"pagination":{"after":"","before":""}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":[],"pagination":{"after":null,"before":null}}`)
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	sess := session.NewSession(&auth.Authentication{Environment: ts.URL, Access_token: "test_token"})
+	list, err := devices.ListUserDevices(ctx, sess, "user_1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(list) != 1 || vehiclePages != 1 {
+		t.Errorf("Expected an empty cursor to end the listing, got %d devices from %d pages", len(list), vehiclePages)
+	}
+}
@@ -0,0 +1,80 @@
+// Package endpoints resolves the requests of the SDK to the operations of the
+// Enode API, e.g. to label metrics by the path template instead of raw IDs.
+// The list of operations is generated from the specification.
+package endpoints
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Endpoint is an operation of the API.
+type Endpoint struct {
+	// OperationId is the ID of the operation in the specification, e.g. getUser.
+	OperationId string
+	Method      string
+	// Template is the path of the operation with its parameters, e.g. /users/{userId}.
+	Template string
+}
+
+var (
+	once     sync.Once
+	segments [][]string
+)
+
+func split(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+/*
+Finds the operation of a request path.
+
+Parameters:
+  - method: The HTTP method of the request.
+  - path: The path of the request, without the query.
+
+Returns:
+  - The operation whose template matches the path. Literal segments are
+    preferred over parameters, so /users/{userId} does not match /users/link.
+  - false if no operation matches.
+*/
+func Match(method, path string) (Endpoint, bool) {
+	once.Do(func() {
+		segments = make([][]string, len(ENDPOINTS))
+		for i, endpoint := range ENDPOINTS {
+			segments[i] = split(endpoint.Template)
+		}
+	})
+
+	parts := split(path)
+	best, bestLiterals := -1, -1
+	for i, endpoint := range ENDPOINTS {
+		if endpoint.Method != method || len(segments[i]) != len(parts) {
+			continue
+		}
+		literals := 0
+		for j, segment := range segments[i] {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+				continue
+			}
+			if segment != parts[j] {
+				literals = -1
+				break
+			}
+			literals++
+		}
+		if literals > bestLiterals {
+			best, bestLiterals = i, literals
+		}
+	}
+	if best < 0 {
+		return Endpoint{}, false
+	}
+	return ENDPOINTS[best], true
+}
+
+// ForRequest finds the operation of a request, see Match.
+func ForRequest(req *http.Request) (Endpoint, bool) {
+	return Match(req.Method, req.URL.EscapedPath())
+}
@@ -0,0 +1,111 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package endpoints
+
+// ENDPOINTS lists the operations of the API in the order of the specification.
+var ENDPOINTS = []Endpoint{
+	{OperationId: "listBatteries", Method: "GET", Template: "/batteries"},
+	{OperationId: "listUserBatteries", Method: "GET", Template: "/users/{userId}/batteries"},
+	{OperationId: "getBattery", Method: "GET", Template: "/batteries/{batteryId}"},
+	{OperationId: "postUserSetOperationMode", Method: "POST", Template: "/batteries/{batteryId}/operation-mode"},
+	{OperationId: "getBatteriesAction", Method: "GET", Template: "/batteries/actions/{actionId}"},
+	{OperationId: "cancelBatteryAction", Method: "POST", Template: "/batteries/actions/{actionId}/cancel"},
+	{OperationId: "batteriesRefreshHint", Method: "POST", Template: "/batteries/{batteryId}/refresh-hint"},
+	{OperationId: "listChargers", Method: "GET", Template: "/chargers"},
+	{OperationId: "listUserChargers", Method: "GET", Template: "/users/{userId}/chargers"},
+	{OperationId: "getCharger", Method: "GET", Template: "/chargers/{chargerId}"},
+	{OperationId: "updateCharger", Method: "PUT", Template: "/chargers/{chargerId}"},
+	{OperationId: "controlChargerCharging", Method: "POST", Template: "/chargers/{chargerId}/charging"},
+	{OperationId: "postSetChargerMaxCurrent", Method: "POST", Template: "/chargers/{chargerId}/max-current"},
+	{OperationId: "getChargersAction", Method: "GET", Template: "/chargers/actions/{actionId}"},
+	{OperationId: "cancelChargerAction", Method: "POST", Template: "/chargers/actions/{actionId}/cancel"},
+	{OperationId: "chargersRefreshHint", Method: "POST", Template: "/chargers/{chargerId}/refresh-hint"},
+	{OperationId: "chargerCreateSmartOverride", Method: "POST", Template: "/chargers/{chargerId}/smart-override"},
+	{OperationId: "chargerEndSmartOverride", Method: "DELETE", Template: "/chargers/{chargerId}/smart-override"},
+	{OperationId: "chargerSmartPolicy", Method: "GET", Template: "/chargers/{chargerId}/smart-charging-policy"},
+	{OperationId: "updateChargerSmartPolicy", Method: "PUT", Template: "/chargers/{chargerId}/smart-charging-policy"},
+	{OperationId: "chargerSmartChargingStatus", Method: "GET", Template: "/chargers/{chargerId}/smart-charging-status"},
+	{OperationId: "listHVACs", Method: "GET", Template: "/hvacs"},
+	{OperationId: "listUserHVACs", Method: "GET", Template: "/users/{userId}/hvacs"},
+	{OperationId: "getHvacsHvacIdSmartPolicy", Method: "GET", Template: "/hvacs/{hvacId}/smart-policy"},
+	{OperationId: "updateHvacSmartPolicy", Method: "PUT", Template: "/hvacs/{hvacId}/smart-policy"},
+	{OperationId: "getHvacSmartStatus", Method: "GET", Template: "/hvacs/{hvacId}/smart-status"},
+	{OperationId: "updateHVAC", Method: "PUT", Template: "/hvacs/{hvacId}"},
+	{OperationId: "getHVAC", Method: "GET", Template: "/hvacs/{hvacId}"},
+	{OperationId: "getHvacsAction", Method: "GET", Template: "/hvacs/actions/{actionId}"},
+	{OperationId: "cancelHvacAction", Method: "POST", Template: "/hvacs/actions/{actionId}/cancel"},
+	{OperationId: "postSetHvacFollowSchedule", Method: "POST", Template: "/hvacs/{hvacId}/follow-schedule"},
+	{OperationId: "hvacsRefreshHint", Method: "POST", Template: "/hvacs/{hvacId}/refresh-hint"},
+	{OperationId: "postSetHvacPermanentHold", Method: "POST", Template: "/hvacs/{hvacId}/permanent-hold"},
+	{OperationId: "listInterventions", Method: "GET", Template: "/interventions"},
+	{OperationId: "getIntervention", Method: "GET", Template: "/interventions/{interventionId}"},
+	{OperationId: "listInverters", Method: "GET", Template: "/inverters"},
+	{OperationId: "listUserInverters", Method: "GET", Template: "/users/{userId}/inverters"},
+	{OperationId: "getInverter", Method: "GET", Template: "/inverters/{inverterId}"},
+	{OperationId: "invertersRefreshHint", Method: "POST", Template: "/inverters/{inverterId}/refresh-hint"},
+	{OperationId: "getLocations", Method: "GET", Template: "/locations"},
+	{OperationId: "getUserlocations", Method: "GET", Template: "/users/{userId}/locations"},
+	{OperationId: "createLocation", Method: "POST", Template: "/users/{userId}/locations"},
+	{OperationId: "getLocation", Method: "GET", Template: "/locations/{locationId}"},
+	{OperationId: "deleteLocation", Method: "DELETE", Template: "/locations/{locationId}"},
+	{OperationId: "updateLocation", Method: "PUT", Template: "/locations/{locationId}"},
+	{OperationId: "getMeter", Method: "GET", Template: "/meters/{meterId}"},
+	{OperationId: "metersRefreshHint", Method: "POST", Template: "/meters/{meterId}/refresh-hint"},
+	{OperationId: "listUserMeters", Method: "GET", Template: "/users/{userId}/meters"},
+	{OperationId: "listMeters", Method: "GET", Template: "/meters"},
+	{OperationId: "getSchedules", Method: "GET", Template: "/users/{userId}/schedules"},
+	{OperationId: "createSchedule", Method: "POST", Template: "/users/{userId}/schedules"},
+	{OperationId: "getSchedule", Method: "GET", Template: "/schedules/{scheduleId}"},
+	{OperationId: "updateSchedule", Method: "PUT", Template: "/schedules/{scheduleId}"},
+	{OperationId: "deleteSchedule", Method: "DELETE", Template: "/schedules/{scheduleId}"},
+	{OperationId: "getScheduleStatus", Method: "GET", Template: "/schedules/{scheduleId}/status"},
+	{OperationId: "getChargingStatistics", Method: "GET", Template: "/users/{userId}/statistics/charging"},
+	{OperationId: "getChargingSessionsStatistics", Method: "GET", Template: "/users/{userId}/statistics/charging/sessions"},
+	{OperationId: "getProductionStatistics", Method: "GET", Template: "/users/{userId}/statistics/production"},
+	{OperationId: "getInverterVendorStatistics", Method: "GET", Template: "/users/{userId}/vendor-statistics"},
+	{OperationId: "getTariffInformation", Method: "GET", Template: "/tariffs/{tariffId}"},
+	{OperationId: "sendTariffInformation", Method: "PUT", Template: "/tariffs/{tariffId}"},
+	{OperationId: "associateUserLocationWithTariff", Method: "PUT", Template: "/locations/{locationId}/tariff"},
+	{OperationId: "getUserLocationTariff", Method: "GET", Template: "/locations/{locationId}/tariff"},
+	{OperationId: "listUsers", Method: "GET", Template: "/users"},
+	{OperationId: "getUser", Method: "GET", Template: "/users/{userId}"},
+	{OperationId: "deleteUsersUserid", Method: "DELETE", Template: "/users/{userId}"},
+	{OperationId: "disconnectUserVendor", Method: "DELETE", Template: "/users/{userId}/vendors/{vendor}"},
+	{OperationId: "disconnectUserVendorVendorType", Method: "DELETE", Template: "/users/{userId}/vendors/{vendor}/{vendorType}"},
+	{OperationId: "postUsersUseridLink", Method: "POST", Template: "/users/{userId}/link"},
+	{OperationId: "deleteUsersUseridAuthorization", Method: "DELETE", Template: "/users/{userId}/authorization"},
+	{OperationId: "getVehicles", Method: "GET", Template: "/vehicles"},
+	{OperationId: "listUserVehicles", Method: "GET", Template: "/users/{userId}/vehicles"},
+	{OperationId: "getVehicle", Method: "GET", Template: "/vehicles/{vehicleId}"},
+	{OperationId: "postVehiclesVehicleidCharging", Method: "POST", Template: "/vehicles/{vehicleId}/charging"},
+	{OperationId: "postVehiclesVehicleidMaxCurrent", Method: "POST", Template: "/vehicles/{vehicleId}/max-current"},
+	{OperationId: "getVehiclesAction", Method: "GET", Template: "/vehicles/actions/{actionId}"},
+	{OperationId: "cancelVehicleAction", Method: "POST", Template: "/vehicles/actions/{actionId}/cancel"},
+	{OperationId: "vehiclesRefreshHint", Method: "POST", Template: "/vehicles/{vehicleId}/refresh-hint"},
+	{OperationId: "getVehiclesVehicleidSmartchargingplans", Method: "GET", Template: "/vehicles/{vehicleId}/smart-charging-plans/{smartChargingPlanId}"},
+	{OperationId: "getVehiclesVehicleidSmartchargingpolicy", Method: "GET", Template: "/vehicles/{vehicleId}/smart-charging-policy"},
+	{OperationId: "updateVehicleSmartChargingPolicy", Method: "PUT", Template: "/vehicles/{vehicleId}/smart-charging-policy"},
+	{OperationId: "vehicleCreateSmartOverride", Method: "POST", Template: "/vehicles/{vehicleId}/smart-override"},
+	{OperationId: "vehicleEndSmartOverride", Method: "DELETE", Template: "/vehicles/{vehicleId}/smart-override"},
+	{OperationId: "getVehiclesVehicleidSmartchargingstatus", Method: "GET", Template: "/vehicles/{vehicleId}/smart-charging-status"},
+	{OperationId: "putWebhooksFirehose", Method: "PUT", Template: "/webhooks/firehose"},
+	{OperationId: "deleteWebhooksFirehose", Method: "DELETE", Template: "/webhooks/firehose"},
+	{OperationId: "testFirehose", Method: "POST", Template: "/webhooks/firehose/test"},
+	{OperationId: "createWebhook", Method: "POST", Template: "/webhooks"},
+	{OperationId: "listWebhooks", Method: "GET", Template: "/webhooks"},
+	{OperationId: "updateWebhook", Method: "PATCH", Template: "/webhooks/{webhookId}"},
+	{OperationId: "getWebhook", Method: "GET", Template: "/webhooks/{webhookId}"},
+	{OperationId: "deleteWebhook", Method: "DELETE", Template: "/webhooks/{webhookId}"},
+	{OperationId: "testWebhook", Method: "POST", Template: "/webhooks/{webhookId}/test"},
+	{OperationId: "getHealthChargerVendors", Method: "GET", Template: "/health/chargers"},
+	{OperationId: "getHealthVehicleVendors", Method: "GET", Template: "/health/vehicles"},
+	{OperationId: "getHealthInverterVendors", Method: "GET", Template: "/health/inverter"},
+	{OperationId: "getHealthHvacVendors", Method: "GET", Template: "/health/hvacs"},
+	{OperationId: "getHealthMeterVendors", Method: "GET", Template: "/health/meters"},
+	{OperationId: "getHealthReady", Method: "GET", Template: "/health/ready"},
+	{OperationId: "createSimulatedVehicle", Method: "POST", Template: "/simulated/vehicles"},
+	{OperationId: "listSimulatedVehicle", Method: "GET", Template: "/simulated/vehicles"},
+	{OperationId: "getSimulatedVehicle", Method: "GET", Template: "/simulated/vehicles/{simulatedVehicleId}"},
+	{OperationId: "updateSimulatedVehicle", Method: "PATCH", Template: "/simulated/vehicles/{simulatedVehicleId}"},
+	{OperationId: "deleteSimulatedVehicle", Method: "DELETE", Template: "/simulated/vehicles/{simulatedVehicleId}"},
+}
@@ -0,0 +1,36 @@
+package endpoints_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/addihorn/enode-gosdk/pkg/endpoints"
+)
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		method, path string
+		operationId  string
+		template     string
+	}{
+		{http.MethodGet, "/users", "listUsers", "/users"},
+		{http.MethodGet, "/users/user_1", "getUser", "/users/{userId}"},
+		{http.MethodPost, "/users/user_1/link", "postUsersUseridLink", "/users/{userId}/link"},
+		{http.MethodGet, "/vehicles/actions/action_1", "getVehiclesAction", "/vehicles/actions/{actionId}"},
+		{http.MethodGet, "/vehicles/vehicle_1", "getVehicle", "/vehicles/{vehicleId}"},
+		{http.MethodGet, "/users/user_1/statistics/charging/sessions/", "getChargingSessionsStatistics", "/users/{userId}/statistics/charging/sessions"},
+	}
+	for _, c := range cases {
+		endpoint, ok := endpoints.Match(c.method, c.path)
+		if !ok || endpoint.OperationId != c.operationId || endpoint.Template != c.template {
+			t.Errorf("Match(%s %s): expected %s %s, got %+v", c.method, c.path, c.operationId, c.template, endpoint)
+		}
+	}
+
+	if endpoint, ok := endpoints.Match(http.MethodGet, "/unknown/path"); ok {
+		t.Errorf("Expected no match, got %+v", endpoint)
+	}
+	if endpoint, ok := endpoints.Match(http.MethodPatch, "/users"); ok {
+		t.Errorf("Expected no match for another method, got %+v", endpoint)
+	}
+}
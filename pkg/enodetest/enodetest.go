@@ -0,0 +1,289 @@
+// Package enodetest provides an in-memory fake of the Enode API for tests.
+//
+// The fake implements the OAuth token endpoint and a stateful subset of the
+// API: users and linking, vehicles, chargers, their actions and webhooks,
+// which are delivered to local URLs. Faults like latency, throttling, server
+// errors and malformed responses can be injected per endpoint.
+//
+//	server := enodetest.NewServer()
+//	defer server.Close()
+//	authentication, _ := auth.NewAuthentication(enodetest.CLIENT_ID, enodetest.CLIENT_SECRET, server.URL, false)
+//	sess := session.NewSession(authentication)
+package enodetest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+)
+
+// Credentials accepted by the token endpoint of a new Server.
+const (
+	CLIENT_ID     = "enodetest-client"
+	CLIENT_SECRET = "enodetest-secret"
+)
+
+// Fault describes a failure injected into matching requests.
+type Fault struct {
+	// Method and Path restrict the fault to requests with this method and
+	// path prefix. Empty values match every request.
+	Method string
+	Path   string
+	// Latency delays the response.
+	Latency time.Duration
+	// Status replaces the response with a problem of this status, e.g. 429 or 502.
+	Status int
+	// RetryAfter is sent as Retry-After header with a Status response, in seconds.
+	RetryAfter int
+	// Malformed replaces the response body with invalid JSON.
+	Malformed bool
+	// Times limits the fault to the given number of requests, 0 means unlimited.
+	Times int
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	return (f.Method == "" || f.Method == r.Method) && strings.HasPrefix(r.URL.Path, f.Path)
+}
+
+// Request is a request received by the server.
+type Request struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// Server is a fake Enode API, served on a local address.
+type Server struct {
+	// URL is the environment to use with auth.NewAuthentication.
+	URL string
+	// ClientId and ClientSecret are the credentials accepted by the token endpoint.
+	ClientId     string
+	ClientSecret string
+	// ConfirmAfter is the number of reads after which pending actions are
+	// confirmed. With 0 actions stay pending until SettleAction is called.
+	ConfirmAfter int
+
+	server *httptest.Server
+
+	mu        sync.Mutex
+	tokens    map[string]bool
+	faults    []*Fault
+	requests  []Request
+	users     map[string]*user
+	vehicles  map[string]*models.VehicleWithLocation
+	chargers  map[string]*models.Charger
+	actions   map[string]*action
+	links     map[string]*link
+	webhooks  map[string]*webhook
+	userOrder []string
+}
+
+// NewServer starts a fake server. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		ClientId:     CLIENT_ID,
+		ClientSecret: CLIENT_SECRET,
+		ConfirmAfter: 1,
+		tokens:       make(map[string]bool),
+		users:        make(map[string]*user),
+		vehicles:     make(map[string]*models.VehicleWithLocation),
+		chargers:     make(map[string]*models.Charger),
+		actions:      make(map[string]*action),
+		links:        make(map[string]*link),
+		webhooks:     make(map[string]*webhook),
+	}
+	s.server = httptest.NewServer(s.handler())
+	s.URL = s.server.URL
+	return s
+}
+
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Inject adds a fault to the server. Faults are applied in the order they were added.
+func (s *Server) Inject(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests returns the requests received by the server so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /oauth2/token", s.token)
+	mux.HandleFunc("GET /link/{linkToken}", s.completeLink)
+	s.routeUsers(mux)
+	s.routeDevices(mux)
+	s.routeWebhooks(mux)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := readBody(r)
+		s.mu.Lock()
+		s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Body: body})
+		fault := s.fault(r)
+		s.mu.Unlock()
+
+		if fault != nil {
+			time.Sleep(fault.Latency)
+			if fault.Status != 0 {
+				if fault.RetryAfter > 0 {
+					w.Header().Set("Retry-After", fmt.Sprint(fault.RetryAfter))
+				}
+				problem(w, fault.Status, "Injected fault")
+				return
+			}
+			if fault.Malformed {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, `{"data": [`)
+				return
+			}
+		}
+
+		if r.URL.Path != "/oauth2/token" && !strings.HasPrefix(r.URL.Path, "/link/") && !s.authorized(r) {
+			problem(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// fault returns the first matching fault, counting its use. Requires s.mu.
+func (s *Server) fault(r *http.Request) *Fault {
+	for i, fault := range s.faults {
+		if !fault.matches(r) {
+			continue
+		}
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+// RotateSecret replaces the client secret accepted by the token endpoint and
+// revokes all issued tokens, like a rotation of the credentials of a client.
+func (s *Server) RotateSecret(secret string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ClientSecret = secret
+	s.tokens = make(map[string]bool)
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	id, secret, ok := r.BasicAuth()
+	s.mu.Lock()
+	valid := ok && id == s.ClientId && secret == s.ClientSecret
+	s.mu.Unlock()
+	if !valid || r.FormValue("grant_type") != "client_credentials" {
+		problem(w, http.StatusUnauthorized, "Invalid client credentials")
+		return
+	}
+
+	token := randomId()
+	s.mu.Lock()
+	s.tokens[token] = true
+	s.mu.Unlock()
+
+	respond(w, http.StatusOK, map[string]any{
+		"access_token": token,
+		"expires_in":   3599,
+		"scope":        "",
+		"token_type":   "bearer",
+	})
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return ok && s.tokens[token]
+}
+
+// problem writes an error response in the problem format of the API.
+func problem(w http.ResponseWriter, status int, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(models.Problem{
+		Type:   fmt.Sprintf("https://developers.enode.com/api/problems/%d", status),
+		Title:  http.StatusText(status),
+		Detail: detail,
+	})
+}
+
+func respond(w http.ResponseWriter, status int, value any) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		problem(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+func decode(w http.ResponseWriter, r *http.Request, target any) bool {
+	if err := json.NewDecoder(r.Body).Decode(target); err != nil {
+		problem(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+func randomId() string {
+	var b [16]byte
+	rand.Read(b[:])
+	id := hex.EncodeToString(b[:])
+	return fmt.Sprintf("%s-%s-%s-%s-%s", id[0:8], id[8:12], id[12:16], id[16:20], id[20:32])
+}
+
+// paginate returns a page of the sorted ids, according to the pagination parameters.
+func paginate(r *http.Request, ids []string) ([]string, models.PaginationCursors) {
+	pageSize := 50
+	fmt.Sscan(r.URL.Query().Get("pageSize"), &pageSize)
+	start := 0
+	if after := r.URL.Query().Get("after"); after != "" {
+		fmt.Sscan(after, &start)
+	}
+
+	var cursors models.PaginationCursors
+	end := min(start+pageSize, len(ids))
+	if start >= len(ids) {
+		return nil, cursors
+	}
+	if end < len(ids) {
+		after := fmt.Sprint(end)
+		cursors.After = &after
+	}
+	if start > 0 {
+		before := fmt.Sprint(max(start-pageSize, 0))
+		cursors.Before = &before
+	}
+	return ids[start:end], cursors
+}
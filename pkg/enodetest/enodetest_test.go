@@ -0,0 +1,219 @@
+package enodetest_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/actions"
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/enodetest"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/users"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+	"github.com/addihorn/enode-gosdk/pkg/webhooks"
+)
+
+func newSession(t *testing.T) (*enodetest.Server, *session.Session) {
+	server := enodetest.NewServer()
+	t.Cleanup(server.Close)
+
+	authentication, err := auth.NewAuthentication(enodetest.CLIENT_ID, enodetest.CLIENT_SECRET, server.URL, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return server, session.NewSession(authentication)
+}
+
+func TestServer_Authentication(t *testing.T) {
+	server := enodetest.NewServer()
+	defer server.Close()
+
+	if _, err := auth.NewAuthentication(enodetest.CLIENT_ID, "wrong", server.URL, false); err == nil {
+		t.Error("Expected an error for wrong credentials")
+	}
+
+	sess := &session.Session{Authentication: &auth.Authentication{Environment: server.URL, Access_token: "unknown"}}
+	if _, err := vehicles.GetVehicle(context.Background(), sess, "any"); err == nil {
+		t.Error("Expected an error for an unknown token")
+	}
+}
+
+func TestServer_Users(t *testing.T) {
+	server, sess := newSession(t)
+	server.AddUser("user_1")
+	server.AddVehicle("user_2", models.VehicleWithLocation{})
+
+	list, err := users.ListUsers(sess)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(list) != 2 {
+		t.Errorf("Expected 2 users, got %d", len(list))
+	}
+
+	user, err := users.GetUser(sess, "user_2")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(user.LinkedVendors) != 1 || user.LinkedVendors[0].Vendor != "TESLA" {
+		t.Errorf("Expected linked vendor TESLA, got %+v", user.LinkedVendors)
+	}
+}
+
+func TestServer_VehicleCharging(t *testing.T) {
+	server, sess := newSession(t)
+	server.ConfirmAfter = 2
+	vehicle := server.AddVehicle("user_1", models.VehicleWithLocation{})
+
+	action, err := vehicles.ControlVehicleCharging(context.Background(), sess, vehicle.Id,
+		&models.ControlChargingPayload{Action: models.CHARGING_ACTION_START})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if action.State != models.ACTION_STATE_PENDING {
+		t.Errorf("Expected a pending action, got %s", action.State)
+	}
+
+	follower := actions.VehicleCharging(sess, action)
+	follower.MinInterval, follower.MaxInterval = time.Millisecond, time.Millisecond
+	result, err := follower.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.State != models.ACTION_STATE_CONFIRMED {
+		t.Errorf("Expected a confirmed action, got %s", result.State)
+	}
+
+	updated, err := vehicles.GetVehicle(context.Background(), sess, vehicle.Id)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if updated.ChargeState.IsCharging == nil || !*updated.ChargeState.IsCharging {
+		t.Error("Expected the vehicle to be charging")
+	}
+}
+
+func TestServer_SettleAction(t *testing.T) {
+	server, sess := newSession(t)
+	server.ConfirmAfter = 0
+	vehicle := server.AddVehicle("user_1", models.VehicleWithLocation{})
+
+	action, err := vehicles.ControlVehicleCharging(context.Background(), sess, vehicle.Id,
+		&models.ControlChargingPayload{Action: models.CHARGING_ACTION_START})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	server.SettleAction(action.Id, models.ACTION_STATE_FAILED, &models.ChargingActionFailureReason{Type: "NO_RESPONSE"})
+
+	follower := actions.VehicleCharging(sess, action)
+	follower.MinInterval = time.Millisecond
+	_, err = follower.Wait(context.Background())
+	var failed *actions.FailedError
+	if !errors.As(err, &failed) || failed.Reason.Type != "NO_RESPONSE" {
+		t.Errorf("Expected a failed action, got %v", err)
+	}
+}
+
+func TestServer_Faults(t *testing.T) {
+	server, sess := newSession(t)
+	vehicle := server.AddVehicle("user_1", models.VehicleWithLocation{})
+
+	server.Inject(enodetest.Fault{Path: "/vehicles", Status: http.StatusTooManyRequests, RetryAfter: 1, Times: 1})
+	_, err := vehicles.GetVehicle(context.Background(), sess, vehicle.Id)
+	var problem *models.Problem
+	if !errors.As(err, &problem) || !strings.HasSuffix(problem.Type, "/429") {
+		t.Errorf("Expected a 429 problem, got %v", err)
+	}
+
+	server.Inject(enodetest.Fault{Method: http.MethodGet, Path: "/vehicles/", Malformed: true, Times: 1})
+	if _, err := vehicles.GetVehicle(context.Background(), sess, vehicle.Id); err == nil {
+		t.Error("Expected a parse error for a malformed response")
+	}
+
+	if _, err := vehicles.GetVehicle(context.Background(), sess, vehicle.Id); err != nil {
+		t.Errorf("Expected exhausted faults to be removed, got %v", err)
+	}
+	if requests := server.Requests(); len(requests) != 4 {
+		t.Errorf("Expected 4 recorded requests, got %d", len(requests))
+	}
+}
+
+func TestServer_Webhooks(t *testing.T) {
+	server, sess := newSession(t)
+
+	events := make(chan string, 10)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !webhooks.Verify("test_secret", body, r.Header.Get(webhooks.SIGNATURE_HEADER)) {
+			t.Error("Invalid webhook signature")
+		}
+		events <- string(body)
+	}))
+	defer receiver.Close()
+
+	hook, err := webhooks.CreateWebhook(context.Background(), sess, &models.WebhookCreatePayload{
+		Url:    receiver.URL,
+		Secret: "test_secret",
+		Events: []models.WebhookEvent{"user:vehicle:discovered", "enode:webhook:test"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	result, err := webhooks.TestWebhook(context.Background(), sess, hook.Id)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Status != models.TEST_FIREHOSE_WEBHOOK_STATUS_SUCCESS {
+		t.Errorf("Expected a successful test, got %+v", result)
+	}
+	if event := <-events; !strings.Contains(event, `"enode:webhook:test"`) {
+		t.Errorf("Expected a test event, got %s", event)
+	}
+
+	server.AddCharger("user_1", models.Charger{})
+	server.AddVehicle("user_1", models.VehicleWithLocation{})
+	if event := <-events; !strings.Contains(event, `"user:vehicle:discovered"`) {
+		t.Errorf("Expected only the subscribed vehicle event, got %s", event)
+	}
+}
+
+func TestServer_Link(t *testing.T) {
+	_, sess := newSession(t)
+
+	redirected := make(chan string, 1)
+	app := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected <- r.URL.RawQuery
+	}))
+	defer app.Close()
+
+	user := &users.User{Id: "user_1"}
+	link := &users.LinkData{LinkUserPayload: models.LinkUserPayload{VendorType: "vehicle", RedirectUri: app.URL, Scopes: []models.Scopes{"vehicle:read:data"}, Language: "en-US"}}
+	if err := user.Link(sess, link); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	resp, err := http.Get(link.LinkAccessData.LinkUrl)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if query := <-redirected; strings.Contains(query, "error") {
+		t.Errorf("Expected a successful redirect, got %s", query)
+	}
+
+	list, err := vehicles.ListUserVehicles(context.Background(), sess, "user_1", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(list.Data) != 1 {
+		t.Errorf("Expected a discovered vehicle, got %d", len(list.Data))
+	}
+}
@@ -0,0 +1,515 @@
+package enodetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+)
+
+type user struct {
+	id        string
+	createdAt time.Time
+	vendors   []models.UserResponseLinkedVendor
+}
+
+// link is a pending link session created by POST /users/{userId}/link.
+type link struct {
+	userId  string
+	payload models.LinkUserPayload
+}
+
+type action struct {
+	targetType string // "vehicle" or "charger"
+	reads      int
+	charge     *models.ChargeAction
+	maxCurrent *models.MaxCurrentAction
+}
+
+func (a *action) value() any {
+	if a.charge != nil {
+		return a.charge
+	}
+	return a.maxCurrent
+}
+
+func (a *action) status() (id, userId string, state models.ActionState) {
+	if a.charge != nil {
+		return a.charge.Id, a.charge.UserId, a.charge.State
+	}
+	return a.maxCurrent.Id, a.maxCurrent.UserId, a.maxCurrent.State
+}
+
+func readBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, err
+}
+
+// AddUser creates a user without linked vendors.
+func (s *Server) AddUser(userId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addUser(userId)
+}
+
+// addUser returns the user, creating it if needed. Requires s.mu.
+func (s *Server) addUser(userId string) *user {
+	if u, ok := s.users[userId]; ok {
+		return u
+	}
+	u := &user{id: userId, createdAt: time.Now().UTC().Truncate(time.Second)}
+	s.users[userId] = u
+	s.userOrder = append(s.userOrder, userId)
+	return u
+}
+
+// AddVehicle stores a vehicle of the user, creating the user if needed.
+// A missing vehicle ID is generated. The stored vehicle is returned.
+func (s *Server) AddVehicle(userId string, vehicle models.VehicleWithLocation) *models.VehicleWithLocation {
+	s.mu.Lock()
+	u := s.addUser(userId)
+	if vehicle.Id == "" {
+		vehicle.Id = randomId()
+	}
+	vehicle.UserId = userId
+	if vehicle.Vendor == "" {
+		vehicle.Vendor = models.VEHICLE_VENDOR_TESLA
+	}
+	s.vehicles[vehicle.Id] = &vehicle
+	addVendor(u, models.Vendor(vehicle.Vendor), models.VENDOR_TYPE_VEHICLE)
+	s.mu.Unlock()
+
+	s.deliver(userId, map[string]any{"event": "user:vehicle:discovered", "vehicle": vehicle})
+	return &vehicle
+}
+
+// AddCharger stores a charger of the user, creating the user if needed.
+// A missing charger ID is generated. The stored charger is returned.
+func (s *Server) AddCharger(userId string, charger models.Charger) *models.Charger {
+	s.mu.Lock()
+	u := s.addUser(userId)
+	if charger.Id == "" {
+		charger.Id = randomId()
+	}
+	charger.UserId = userId
+	if charger.Vendor == "" {
+		charger.Vendor = models.CHARGER_VENDOR_ZAPTEC
+	}
+	s.chargers[charger.Id] = &charger
+	addVendor(u, models.Vendor(charger.Vendor), models.VENDOR_TYPE_CHARGER)
+	s.mu.Unlock()
+
+	s.deliver(userId, map[string]any{"event": "user:charger:discovered", "charger": charger})
+	return &charger
+}
+
+func addVendor(u *user, vendor models.Vendor, vendorType models.VendorType) {
+	for _, linked := range u.vendors {
+		if linked.Vendor == vendor && linked.VendorType == vendorType {
+			return
+		}
+	}
+	u.vendors = append(u.vendors, models.UserResponseLinkedVendor{Vendor: vendor, VendorType: vendorType, IsValid: true})
+}
+
+// SettleAction moves an action into a final state, notifying webhooks.
+func (s *Server) SettleAction(actionId string, state models.ActionState, reason *models.ChargingActionFailureReason) bool {
+	s.mu.Lock()
+	a, ok := s.actions[actionId]
+	if ok {
+		s.settle(a, state, reason)
+	}
+	s.mu.Unlock()
+
+	if ok {
+		s.deliverAction(a)
+	}
+	return ok
+}
+
+// settle updates the state of an action and its target. Requires s.mu.
+func (s *Server) settle(a *action, state models.ActionState, reason *models.ChargingActionFailureReason) {
+	now := time.Now().UTC().Truncate(time.Second)
+	if a.charge != nil {
+		a.charge.State, a.charge.FailureReason, a.charge.UpdatedAt, a.charge.CompletedAt = state, reason, now, &now
+		if state == models.ACTION_STATE_CONFIRMED {
+			s.applyCharging(a.charge.TargetId, a.charge.Kind == models.CHARGING_ACTION_START)
+		}
+		return
+	}
+	a.maxCurrent.State, a.maxCurrent.FailureReason, a.maxCurrent.UpdatedAt, a.maxCurrent.CompletedAt = state, reason, now, &now
+	if state == models.ACTION_STATE_CONFIRMED {
+		maxCurrent := a.maxCurrent.TargetState.MaxCurrent
+		if vehicle, ok := s.vehicles[a.maxCurrent.TargetId]; ok {
+			vehicle.ChargeState.MaxCurrent = &maxCurrent
+		}
+		if charger, ok := s.chargers[a.maxCurrent.TargetId]; ok {
+			charger.ChargeState.MaxCurrent = &maxCurrent
+		}
+	}
+}
+
+// applyCharging reflects a confirmed charge action in the target. Requires s.mu.
+func (s *Server) applyCharging(targetId string, charging bool) {
+	if vehicle, ok := s.vehicles[targetId]; ok {
+		vehicle.ChargeState.IsCharging = &charging
+		vehicle.ChargeState.PowerDeliveryState = models.VEHICLE_POWER_DELIVERY_STATE_PLUGGED_IN_STOPPED
+		if charging {
+			vehicle.ChargeState.PowerDeliveryState = models.VEHICLE_POWER_DELIVERY_STATE_PLUGGED_IN_CHARGING
+		}
+	}
+	if charger, ok := s.chargers[targetId]; ok {
+		charger.ChargeState.IsCharging = &charging
+		charger.ChargeState.PowerDeliveryState = models.CHARGER_POWER_DELIVERY_STATE_PLUGGED_IN_STOPPED
+		if charging {
+			charger.ChargeState.PowerDeliveryState = models.CHARGER_POWER_DELIVERY_STATE_PLUGGED_IN_CHARGING
+		}
+	}
+}
+
+func (s *Server) routeUsers(mux *http.ServeMux) {
+	mux.HandleFunc("GET /users", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		ids, cursors := paginate(r, s.userOrder)
+		page := models.PaginatedUsersListResponse{Data: []models.UsersListEntry{}, Pagination: cursors}
+		for _, id := range ids {
+			createdAt := s.users[id].createdAt
+			page.Data = append(page.Data, models.UsersListEntry{Id: id, CreatedAt: &createdAt, Scopes: []string{}})
+		}
+		respond(w, http.StatusOK, page)
+	})
+
+	mux.HandleFunc("GET /users/{userId}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		u, ok := s.users[r.PathValue("userId")]
+		if !ok {
+			problem(w, http.StatusNotFound, "User not found")
+			return
+		}
+		respond(w, http.StatusOK, map[string]any{"id": u.id, "createdAt": u.createdAt, "linkedVendors": append([]models.UserResponseLinkedVendor{}, u.vendors...)})
+	})
+
+	mux.HandleFunc("DELETE /users/{userId}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		userId := r.PathValue("userId")
+		if _, ok := s.users[userId]; !ok {
+			problem(w, http.StatusNotFound, "User not found")
+			return
+		}
+		delete(s.users, userId)
+		for i, id := range s.userOrder {
+			if id == userId {
+				s.userOrder = append(s.userOrder[:i], s.userOrder[i+1:]...)
+				break
+			}
+		}
+		s.removeDevices(userId, func(models.Vendor, models.VendorType) bool { return true })
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("DELETE /users/{userId}/authorization", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		u, ok := s.users[r.PathValue("userId")]
+		if !ok {
+			problem(w, http.StatusNotFound, "User not found")
+			return
+		}
+		u.vendors = nil
+		s.removeDevices(u.id, func(models.Vendor, models.VendorType) bool { return true })
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	disconnect := func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		u, ok := s.users[r.PathValue("userId")]
+		if !ok {
+			problem(w, http.StatusNotFound, "User not found")
+			return
+		}
+		vendor, vendorType := models.Vendor(r.PathValue("vendor")), models.VendorType(r.PathValue("vendorType"))
+		matches := func(v models.Vendor, t models.VendorType) bool {
+			return v == vendor && (vendorType == "" || t == vendorType)
+		}
+		var remaining []models.UserResponseLinkedVendor
+		for _, linked := range u.vendors {
+			if !matches(linked.Vendor, linked.VendorType) {
+				remaining = append(remaining, linked)
+			}
+		}
+		u.vendors = remaining
+		s.removeDevices(u.id, matches)
+		w.WriteHeader(http.StatusNoContent)
+	}
+	mux.HandleFunc("DELETE /users/{userId}/vendors/{vendor}", disconnect)
+	mux.HandleFunc("DELETE /users/{userId}/vendors/{vendor}/{vendorType}", disconnect)
+
+	mux.HandleFunc("POST /users/{userId}/link", func(w http.ResponseWriter, r *http.Request) {
+		var payload models.LinkUserPayload
+		if !decode(w, r, &payload) {
+			return
+		}
+		if payload.RedirectUri == "" || payload.VendorType == "" {
+			problem(w, http.StatusBadRequest, "vendorType and redirectUri are required")
+			return
+		}
+
+		token := randomId()
+		s.mu.Lock()
+		s.addUser(r.PathValue("userId"))
+		s.links[token] = &link{userId: r.PathValue("userId"), payload: payload}
+		s.mu.Unlock()
+
+		respond(w, http.StatusOK, models.LinkUserResponse{LinkUrl: s.URL + "/link/" + token, LinkToken: token})
+	})
+}
+
+// completeLink simulates a user finishing Link UI: a device of the requested
+// type is discovered and the browser is redirected to the redirect URI.
+func (s *Server) completeLink(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	l, ok := s.links[r.PathValue("linkToken")]
+	delete(s.links, r.PathValue("linkToken"))
+	s.mu.Unlock()
+	if !ok {
+		problem(w, http.StatusNotFound, "Link session not found or already used")
+		return
+	}
+
+	redirect, err := url.Parse(l.payload.RedirectUri)
+	if err != nil {
+		problem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	query := redirect.Query()
+	if r.URL.Query().Get("error") != "" {
+		query.Set("error", r.URL.Query().Get("error"))
+	} else {
+		switch l.payload.VendorType {
+		case models.VENDOR_TYPE_VEHICLE:
+			vehicle := models.VehicleWithLocation{}
+			if l.payload.Vendor != nil {
+				vehicle.Vendor = models.VehicleVendor(*l.payload.Vendor)
+			}
+			s.AddVehicle(l.userId, vehicle)
+		case models.VENDOR_TYPE_CHARGER:
+			charger := models.Charger{}
+			if l.payload.Vendor != nil {
+				charger.Vendor = models.ChargerVendor(*l.payload.Vendor)
+			}
+			s.AddCharger(l.userId, charger)
+		}
+	}
+	redirect.RawQuery = query.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+// removeDevices deletes the devices of a user matching the vendor filter. Requires s.mu.
+func (s *Server) removeDevices(userId string, matches func(models.Vendor, models.VendorType) bool) {
+	for id, vehicle := range s.vehicles {
+		if vehicle.UserId == userId && matches(models.Vendor(vehicle.Vendor), models.VENDOR_TYPE_VEHICLE) {
+			delete(s.vehicles, id)
+		}
+	}
+	for id, charger := range s.chargers {
+		if charger.UserId == userId && matches(models.Vendor(charger.Vendor), models.VENDOR_TYPE_CHARGER) {
+			delete(s.chargers, id)
+		}
+	}
+}
+
+func (s *Server) routeDevices(mux *http.ServeMux) {
+	listVehicles := func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		var ids []string
+		for id, vehicle := range s.vehicles {
+			if userId := r.PathValue("userId"); userId == "" || vehicle.UserId == userId {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+		ids, cursors := paginate(r, ids)
+		page := models.PaginatedVehicleList{Data: []models.VehicleWithLocation{}, Pagination: cursors}
+		for _, id := range ids {
+			page.Data = append(page.Data, *s.vehicles[id])
+		}
+		respond(w, http.StatusOK, page)
+	}
+	mux.HandleFunc("GET /vehicles", listVehicles)
+	mux.HandleFunc("GET /users/{userId}/vehicles", listVehicles)
+
+	mux.HandleFunc("GET /vehicles/{vehicleId}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		vehicle, ok := s.vehicles[r.PathValue("vehicleId")]
+		if !ok {
+			problem(w, http.StatusNotFound, "Vehicle not found")
+			return
+		}
+		respond(w, http.StatusOK, vehicle)
+	})
+
+	listChargers := func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		var ids []string
+		for id, charger := range s.chargers {
+			if userId := r.PathValue("userId"); userId == "" || charger.UserId == userId {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+		ids, cursors := paginate(r, ids)
+		page := models.PaginatedChargerList{Data: []models.Charger{}, Pagination: cursors}
+		for _, id := range ids {
+			page.Data = append(page.Data, *s.chargers[id])
+		}
+		respond(w, http.StatusOK, page)
+	}
+	mux.HandleFunc("GET /chargers", listChargers)
+	mux.HandleFunc("GET /users/{userId}/chargers", listChargers)
+
+	mux.HandleFunc("GET /chargers/{chargerId}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		charger, ok := s.chargers[r.PathValue("chargerId")]
+		if !ok {
+			problem(w, http.StatusNotFound, "Charger not found")
+			return
+		}
+		respond(w, http.StatusOK, charger)
+	})
+
+	for _, targetType := range []string{"vehicle", "charger"} {
+		prefix := "/" + targetType + "s"
+		mux.HandleFunc("POST "+prefix+"/{targetId}/charging", s.createAction(targetType, true))
+		mux.HandleFunc("POST "+prefix+"/{targetId}/max-current", s.createAction(targetType, false))
+		mux.HandleFunc("GET "+prefix+"/actions/{actionId}", s.readAction(targetType, false))
+		mux.HandleFunc("POST "+prefix+"/actions/{actionId}/cancel", s.readAction(targetType, true))
+	}
+}
+
+// owner returns the user owning a device of the target type. Requires s.mu.
+func (s *Server) owner(targetType, targetId string) (string, bool) {
+	if targetType == "vehicle" {
+		vehicle, ok := s.vehicles[targetId]
+		if !ok {
+			return "", false
+		}
+		return vehicle.UserId, true
+	}
+	charger, ok := s.chargers[targetId]
+	if !ok {
+		return "", false
+	}
+	return charger.UserId, true
+}
+
+func (s *Server) createAction(targetType string, charging bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		targetId := r.PathValue("targetId")
+		now := time.Now().UTC().Truncate(time.Second)
+
+		a := &action{targetType: targetType}
+		if charging {
+			var payload models.ControlChargingPayload
+			if !decode(w, r, &payload) {
+				return
+			}
+			a.charge = &models.ChargeAction{Id: randomId(), CreatedAt: now, UpdatedAt: now, State: models.ACTION_STATE_PENDING,
+				TargetId: targetId, TargetType: models.ChargeableVendorType(targetType), Kind: payload.Action}
+		} else {
+			var payload models.TargetMaxCurrent
+			if !decode(w, r, &payload) {
+				return
+			}
+			a.maxCurrent = &models.MaxCurrentAction{Id: randomId(), CreatedAt: now, UpdatedAt: now, State: models.ACTION_STATE_PENDING,
+				TargetId: targetId, TargetType: models.ChargeableVendorType(targetType), TargetState: payload}
+		}
+
+		s.mu.Lock()
+		userId, ok := s.owner(targetType, targetId)
+		if !ok {
+			s.mu.Unlock()
+			problem(w, http.StatusNotFound, "Target not found")
+			return
+		}
+		if a.charge != nil {
+			a.charge.UserId = userId
+		} else {
+			a.maxCurrent.UserId = userId
+		}
+		// a pending action of the same kind is reused, as done by the API
+		for _, existing := range s.actions {
+			id, _, state := existing.status()
+			if state == models.ACTION_STATE_PENDING && existing.targetType == targetType && (existing.charge != nil) == charging &&
+				((charging && existing.charge.TargetId == targetId && existing.charge.Kind == a.charge.Kind) ||
+					(!charging && existing.maxCurrent.TargetId == targetId && existing.maxCurrent.TargetState == a.maxCurrent.TargetState)) {
+				s.mu.Unlock()
+				respond(w, http.StatusOK, s.actions[id].value())
+				return
+			}
+		}
+		id, _, _ := a.status()
+		s.actions[id] = a
+		s.mu.Unlock()
+
+		respond(w, http.StatusOK, a.value())
+	}
+}
+
+func (s *Server) readAction(targetType string, cancel bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		a, ok := s.actions[r.PathValue("actionId")]
+		if !ok || a.targetType != targetType {
+			s.mu.Unlock()
+			problem(w, http.StatusNotFound, "Action not found")
+			return
+		}
+
+		_, _, state := a.status()
+		settled := false
+		if state == models.ACTION_STATE_PENDING {
+			a.reads++
+			switch {
+			case cancel:
+				s.settle(a, models.ACTION_STATE_CANCELLED, &models.ChargingActionFailureReason{
+					Type:   models.CHARGING_ACTION_FAILURE_REASON_TYPE_REQUESTED_CANCELLATION,
+					Detail: "The action was cancelled.",
+				})
+				settled = true
+			case s.ConfirmAfter > 0 && a.reads >= s.ConfirmAfter:
+				s.settle(a, models.ACTION_STATE_CONFIRMED, nil)
+				settled = true
+			}
+		} else if cancel {
+			s.mu.Unlock()
+			problem(w, http.StatusConflict, "Action is not pending")
+			return
+		}
+		value := a.value()
+		data, _ := json.Marshal(value)
+		s.mu.Unlock()
+
+		if settled {
+			s.deliverAction(a)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}
+}
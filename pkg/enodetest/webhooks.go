@@ -0,0 +1,180 @@
+package enodetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/webhooks"
+)
+
+type webhook struct {
+	response models.WebhookResponse
+	secret   string
+}
+
+func (w *webhook) subscribed(event string) bool {
+	return len(w.response.Events) == 0 || slices.Contains(w.response.Events, "*") || slices.Contains(w.response.Events, event)
+}
+
+func (s *Server) routeWebhooks(mux *http.ServeMux) {
+	mux.HandleFunc("POST /webhooks", func(w http.ResponseWriter, r *http.Request) {
+		var payload models.WebhookCreatePayload
+		if !decode(w, r, &payload) {
+			return
+		}
+		if payload.Url == "" || payload.Secret == "" {
+			problem(w, http.StatusBadRequest, "url and secret are required")
+			return
+		}
+		hook := &webhook{secret: payload.Secret, response: models.WebhookResponse{
+			Id:         randomId(),
+			Url:        payload.Url,
+			IsActive:   true,
+			CreatedAt:  time.Now().UTC().Format(time.RFC3339),
+			ApiVersion: payload.ApiVersion,
+		}}
+		for _, event := range payload.Events {
+			hook.response.Events = append(hook.response.Events, string(event))
+		}
+
+		s.mu.Lock()
+		s.webhooks[hook.response.Id] = hook
+		s.mu.Unlock()
+		respond(w, http.StatusOK, hook.response)
+	})
+
+	mux.HandleFunc("GET /webhooks", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		var ids []string
+		for id := range s.webhooks {
+			ids = append(ids, id)
+		}
+		slices.Sort(ids)
+		ids, cursors := paginate(r, ids)
+		page := struct {
+			Data       []models.WebhookResponse `json:"data"`
+			Pagination models.PaginationCursors `json:"pagination"`
+		}{Data: []models.WebhookResponse{}, Pagination: cursors}
+		for _, id := range ids {
+			page.Data = append(page.Data, s.webhooks[id].response)
+		}
+		respond(w, http.StatusOK, page)
+	})
+
+	mux.HandleFunc("GET /webhooks/{webhookId}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		hook, ok := s.webhooks[r.PathValue("webhookId")]
+		if !ok {
+			problem(w, http.StatusNotFound, "Webhook not found")
+			return
+		}
+		respond(w, http.StatusOK, hook.response)
+	})
+
+	mux.HandleFunc("DELETE /webhooks/{webhookId}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.webhooks[r.PathValue("webhookId")]; !ok {
+			problem(w, http.StatusNotFound, "Webhook not found")
+			return
+		}
+		delete(s.webhooks, r.PathValue("webhookId"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("POST /webhooks/{webhookId}/test", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		hook, ok := s.webhooks[r.PathValue("webhookId")]
+		s.mu.Unlock()
+		if !ok {
+			problem(w, http.StatusNotFound, "Webhook not found")
+			return
+		}
+
+		event := map[string]any{"event": "enode:webhook:test", "createdAt": time.Now().UTC(), "version": models.API_VERSION}
+		resp, err := s.send(hook, []map[string]any{event})
+		if err != nil {
+			respond(w, http.StatusOK, models.TestFirehoseWebhook{Status: models.TEST_FIREHOSE_WEBHOOK_STATUS_FAILURE, Description: err.Error()})
+			return
+		}
+		result := models.TestFirehoseWebhook{Status: models.TEST_FIREHOSE_WEBHOOK_STATUS_SUCCESS, Description: "Test webhook event delivered", Response: resp}
+		if resp.Code >= 300 {
+			result.Status, result.Description = models.TEST_FIREHOSE_WEBHOOK_STATUS_FAILURE, "Webhook endpoint responded with an error"
+		}
+		respond(w, http.StatusOK, result)
+	})
+}
+
+// deliverAction sends a user:vendor-action:updated event for the action.
+func (s *Server) deliverAction(a *action) {
+	s.mu.Lock()
+	value := a.value()
+	_, userId, _ := a.status()
+	data, _ := json.Marshal(value)
+	s.mu.Unlock()
+
+	s.deliver(userId, map[string]any{
+		"event":         "user:vendor-action:updated",
+		"vendorAction":  json.RawMessage(data),
+		"updatedFields": []string{"state"},
+	})
+}
+
+// deliver sends an event of the user to all subscribed webhooks. It must not be called with s.mu held.
+func (s *Server) deliver(userId string, event map[string]any) {
+	event["version"] = models.API_VERSION
+	event["createdAt"] = time.Now().UTC()
+	event["user"] = map[string]string{"id": userId}
+
+	s.mu.Lock()
+	var hooks []*webhook
+	for _, hook := range s.webhooks {
+		if hook.subscribed(event["event"].(string)) {
+			hooks = append(hooks, hook)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, hook := range hooks {
+		s.send(hook, []map[string]any{event})
+	}
+}
+
+// send posts events to a webhook, recording the time of successful deliveries.
+func (s *Server) send(hook *webhook, events []map[string]any) (*models.TestFirehoseWebhookResponse, error) {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, hook.response.Url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhooks.SIGNATURE_HEADER, webhooks.Sign(hook.secret, body))
+
+	resp, err := s.server.Client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode < 300 {
+		s.mu.Lock()
+		hook.response.LastSuccess = time.Now().UTC().Format(time.RFC3339)
+		s.mu.Unlock()
+	}
+	result := &models.TestFirehoseWebhookResponse{Code: float64(resp.StatusCode), Body: string(respBody)}
+	for name := range resp.Header {
+		result.Headers = append(result.Headers, name)
+	}
+	return result, nil
+}
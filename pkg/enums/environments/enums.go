@@ -1,6 +1,61 @@
 package environments
 
+import "strings"
+
 const (
 	SANDBOX    = "https://enode-api.sandbox.enode.io"
 	PRODUCTION = "https://enode-api.production.enode.io"
 )
+
+// TOKEN_PATH is appended to the API URL of environments without an explicit token URL.
+const TOKEN_PATH = "/oauth2/token"
+
+// Environment describes where the API and the OAuth server of a client live.
+// Custom environments, e.g. staging proxies, are defined with their own URLs.
+type Environment struct {
+	Name string `json:"name"`
+	// ApiUrl is the base URL of the API, without trailing slash.
+	ApiUrl string `json:"apiUrl"`
+	// TokenUrl is the OAuth client credentials endpoint, ApiUrl + TOKEN_PATH if empty.
+	TokenUrl string `json:"tokenUrl,omitempty"`
+}
+
+var (
+	Sandbox    = Environment{Name: "sandbox", ApiUrl: SANDBOX, TokenUrl: "https://oauth.sandbox.enode.io/oauth2/token"}
+	Production = Environment{Name: "production", ApiUrl: PRODUCTION, TokenUrl: "https://oauth.production.enode.io/oauth2/token"}
+)
+
+// KNOWN holds the environments operated by Enode.
+var KNOWN = []Environment{Sandbox, Production}
+
+// Token returns the token URL of the environment.
+func (env Environment) Token() string {
+	if env.TokenUrl != "" {
+		return env.TokenUrl
+	}
+	return strings.TrimSuffix(env.ApiUrl, "/") + TOKEN_PATH
+}
+
+/*
+Resolves an environment given by name or API URL.
+
+Parameters:
+  - value: The name of a known environment, like SANDBOX or production, or the URL of an API.
+
+Returns:
+  - The known environment of that name or URL, or a custom environment for the URL.
+*/
+func Lookup(value string) Environment {
+	value = strings.TrimSuffix(value, "/")
+	for _, env := range KNOWN {
+		if strings.EqualFold(env.Name, value) || env.ApiUrl == value {
+			return env
+		}
+	}
+	return Environment{ApiUrl: value}
+}
+
+// TokenUrl returns the token URL of the environment with the given API URL.
+func TokenUrl(apiUrl string) string {
+	return Lookup(apiUrl).Token()
+}
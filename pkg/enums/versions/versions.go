@@ -0,0 +1,15 @@
+package versions
+
+// API versions accepted by the Enode-Version header.
+const (
+	V2024_01_01 = "2024-01-01"
+	V2023_08_01 = "2023-08-01"
+	V2023_05_01 = "2023-05-01"
+	V2023_04_15 = "2023-04-15"
+	V2023_04_01 = "2023-04-01"
+	V2023_03_01 = "2023-03-01"
+	V2023_02_01 = "2023-02-01"
+
+	// DEFAULT is the version of the bundled specification the SDK is generated from.
+	DEFAULT = V2024_01_01
+)
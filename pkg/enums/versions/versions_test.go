@@ -0,0 +1,14 @@
+package versions_test
+
+import (
+	"testing"
+
+	"github.com/addihorn/enode-gosdk/pkg/enums/versions"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+)
+
+func TestDefault_MatchesSpecification(t *testing.T) {
+	if versions.DEFAULT != models.API_VERSION {
+		t.Errorf("Expected default version %s of the generated models, but got %s", models.API_VERSION, versions.DEFAULT)
+	}
+}
@@ -0,0 +1,220 @@
+// Package export writes the statistics timeseries of users to files for a
+// data warehouse, in one schema for all series and formats:
+//
+//	w, err := export.NewWriter(export.FORMAT_CSV, file)
+//	err = export.Export(ctx, sess, export.Options{Start: start, End: end}, w)
+//	err = w.Close()
+//
+// Charging statistics and sessions are exported for vehicles, chargers and
+// HVACs, production statistics for inverters.
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/devices"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/statistics"
+	"github.com/addihorn/enode-gosdk/pkg/users"
+)
+
+const (
+	EXPORT_FORMAT_ERROR     string = "export: unknown format"
+	EXPORT_WRITE_ERROR      string = "export: unable to write records"
+	EXPORT_STATISTICS_ERROR string = "export: unable to get the statistics of a device"
+)
+
+// WriteError is returned when the Writer fails to write a record, which stops the export.
+type WriteError struct {
+	Err error
+}
+
+func (e *WriteError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *WriteError) Unwrap() error {
+	return e.Err
+}
+
+// Series is a statistics timeseries which can be exported.
+type Series string
+
+const (
+	// SERIES_CHARGING is the consumption per bucket of the resolution.
+	SERIES_CHARGING Series = "charging"
+	// SERIES_SESSIONS is the consumption per charging session.
+	SERIES_SESSIONS Series = "sessions"
+	// SERIES_PRODUCTION is the production of inverters per bucket of the resolution.
+	SERIES_PRODUCTION Series = "production"
+)
+
+// SERIES lists all series, exported if Options.Series is empty.
+var SERIES = []Series{SERIES_CHARGING, SERIES_SESSIONS, SERIES_PRODUCTION}
+
+// Options select the statistics to export.
+type Options struct {
+	Start time.Time
+	End   time.Time
+	// Resolution of the charging and production series, hourly if empty.
+	Resolution statistics.Resolution
+	// Series to export, all if empty.
+	Series []Series
+	// PageSize of the list of users, the API default if 0.
+	PageSize int
+}
+
+func (o Options) exports(series Series) bool {
+	if len(o.Series) == 0 {
+		return true
+	}
+	for _, s := range o.Series {
+		if s == series {
+			return true
+		}
+	}
+	return false
+}
+
+func (o Options) resolution() *statistics.Resolution {
+	resolution := o.Resolution
+	if resolution == "" {
+		resolution = statistics.RESOLUTION_HOUR
+	}
+	return &resolution
+}
+
+// chargingTypes maps the device types with charging statistics to their statistics type.
+var chargingTypes = map[models.VendorType]statistics.Type{
+	models.VENDOR_TYPE_VEHICLE: statistics.TYPE_VEHICLE,
+	models.VENDOR_TYPE_CHARGER: statistics.TYPE_CHARGER,
+	models.VENDOR_TYPE_HVAC:    statistics.TYPE_HVAC,
+}
+
+/*
+Exports the statistics of all users.
+
+Parameters:
+  - ctx: The context of the requests, used for cancellation and deadlines.
+  - sess: A pointer to a session.Session object containing the authentication details and environment URL.
+  - options: The date range and series to export.
+  - w: The writer the records are written to. It is not closed.
+
+Returns:
+  - An error if the users could not be listed, or a *WriteError if the records
+    could not be written, which stops the export. Otherwise an error joining the
+    devices whose statistics could not be exported, after the records of all
+    other devices are written.
+*/
+func Export(ctx context.Context, sess *session.Session, options Options, w Writer) error {
+	var errs []error
+	err := users.ListUserPages(ctx, sess, options.PageSize, func(page []models.UsersListEntry) error {
+		for _, user := range page {
+			err := ExportUser(ctx, sess, user.Id, options, w)
+			var written *WriteError
+			if errors.As(err, &written) {
+				return err
+			}
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return errors.Join(errs...)
+}
+
+/*
+Exports the statistics of the devices of a user.
+
+Parameters:
+  - ctx: The context of the requests, used for cancellation and deadlines.
+  - sess: A pointer to a session.Session object containing the authentication details and environment URL.
+  - userId: The ID of the user.
+  - options: The date range and series to export.
+  - w: The writer the records are written to. It is not closed.
+
+Returns:
+  - A *WriteError if the records could not be written, which stops the export.
+    Otherwise an error joining the device types which could not be listed and
+    the devices whose statistics could not be exported.
+*/
+func ExportUser(ctx context.Context, sess *session.Session, userId string, options Options, w Writer) error {
+	list, err := devices.ListUserDevices(ctx, sess, userId)
+	if err != nil && !devices.IsPartial(err) {
+		return err
+	}
+	errs := []error{err}
+	for _, device := range list {
+		records, err := deviceRecords(ctx, sess, userId, device, options)
+		if err != nil {
+			errs = append(errs, errors.Join(errors.New(EXPORT_STATISTICS_ERROR), fmt.Errorf("user %s, %s %s", userId, device.DeviceVendorType(), device.DeviceId()), err))
+		}
+		for _, record := range records {
+			if err := w.Write(record); err != nil {
+				return &WriteError{Err: err}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// deviceRecords gets the series of a device, returning the records of the
+// series got before an error.
+func deviceRecords(ctx context.Context, sess *session.Session, userId string, device models.Device, options Options) ([]Record, error) {
+	deviceId := device.DeviceId()
+	deviceType := string(device.DeviceVendorType())
+	record := func(series Series, start time.Time, kwh, cost float64) Record {
+		return Record{UserId: userId, DeviceId: deviceId, DeviceType: deviceType, Series: series, BucketStart: start, Kwh: kwh, Cost: cost}
+	}
+
+	var records []Record
+	if statisticsType, ok := chargingTypes[device.DeviceVendorType()]; ok {
+		if options.exports(SERIES_CHARGING) {
+			series, err := statistics.GetChargingStatistics(ctx, sess, userId, &statistics.GetChargingStatisticsParams{
+				StartDate: options.Start, EndDate: &options.End, Id: &deviceId, Type: statisticsType, Resolution: options.resolution(),
+			})
+			if err != nil {
+				return records, err
+			}
+			for _, item := range series {
+				r := record(SERIES_CHARGING, item.Date, item.KwhSum, item.CostSum)
+				r.SavedCost = item.EstimatedSavings
+				records = append(records, r)
+			}
+		}
+		if options.exports(SERIES_SESSIONS) {
+			series, err := statistics.GetChargingSessionsStatistics(ctx, sess, userId, &statistics.GetChargingSessionsStatisticsParams{
+				StartDate: options.Start, EndDate: &options.End, Id: &deviceId, Type: statisticsType,
+			})
+			if err != nil {
+				return records, err
+			}
+			for _, item := range series {
+				r := record(SERIES_SESSIONS, item.From, item.KwhSum, item.CostSum)
+				r.BucketEnd = &item.To
+				r.SavedCost = item.EstimatedSavings
+				records = append(records, r)
+			}
+		}
+	}
+	if device.DeviceVendorType() == models.VENDOR_TYPE_INVERTER && options.exports(SERIES_PRODUCTION) {
+		series, err := statistics.GetProductionStatistics(ctx, sess, userId, &statistics.GetProductionStatisticsParams{
+			StartDate: options.Start, EndDate: &options.End, Id: &deviceId, Type: statistics.GET_PRODUCTION_STATISTICS_TYPE_INVERTER, Resolution: options.resolution(),
+		})
+		if err != nil {
+			return records, err
+		}
+		for _, item := range series {
+			records = append(records, record(SERIES_PRODUCTION, item.Date, item.KwhSum, item.EarningsSum))
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,243 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/export"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/parquet-go/parquet-go"
+)
+
+const empty = `{"data":[],"pagination":{"after":null,"before":null}}`
+
+func TestExport(t *testing.T) {
+	// Create a test server with a vehicle and an inverter of user_1, and a
+	// charger of user_2 whose sessions fail
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+		switch r.URL.Path {
+		case "/users":
+			fmt.Fprint(w, `{"data":[{"id":"user_1"},{"id":"user_2"}],"pagination":{"after":null,"before":null}}`)
+		case "/users/user_1/vehicles":
+			fmt.Fprint(w, `{"data":[{"id":"vehicle_1","userId":"user_1"}],"pagination":{"after":null,"before":null}}`)
+		case "/users/user_1/inverters":
+			fmt.Fprint(w, `{"data":[{"id":"inverter_1","userId":"user_1"}],"pagination":{"after":null,"before":null}}`)
+		case "/users/user_2/chargers":
+			fmt.Fprint(w, `{"data":[{"id":"charger_1","userId":"user_2"}],"pagination":{"after":null,"before":null}}`)
+		case "/users/user_1/statistics/charging", "/users/user_2/statistics/charging":
+			if query.Get("resolution") != "DAY" || query.Get("startDate") == "" || query.Get("endDate") == "" {
+				t.Errorf("Unexpected query %s", r.URL.RawQuery)
+			}
+			fmt.Fprintf(w, `[{"kwhSum":1.5,"costSum":2.25,"estimatedSavings":0.5,"date":"2024-01-01T00:00:00Z","id":%q}]`, query.Get("id"))
+		case "/users/user_1/statistics/charging/sessions", "/users/user_2/statistics/charging/sessions":
+			if query.Get("type") == "charger" {
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprint(w, `{"type":"https://developers.enode.com/api/problems/server-error","title":"Server Error"}`)
+				return
+			}
+			fmt.Fprint(w, `[{"id":"vehicle_1","kwhSum":3,"costSum":4,"from":"2024-01-01T18:00:00Z","to":"2024-01-01T20:00:00Z","estimatedSavings":null}]`)
+		case "/users/user_1/statistics/production":
+			if query.Get("type") != "inverter" || query.Get("id") != "inverter_1" {
+				t.Errorf("Unexpected query %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `[{"kwhSum":10,"earningsSum":7,"date":"2024-01-01T00:00:00Z"}]`)
+		default:
+			fmt.Fprint(w, empty)
+		}
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	w := export.NewCSVWriter(&buf)
+	sess := session.NewSession(&auth.Authentication{Environment: ts.URL, Access_token: "test_token"})
+	options := export.Options{
+		Start:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:        time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Resolution: "DAY",
+	}
+	err := export.Export(context.Background(), sess, options, w)
+	if err == nil || !strings.Contains(err.Error(), export.EXPORT_STATISTICS_ERROR) || !strings.Contains(err.Error(), "charger_1") {
+		t.Errorf("Expected the sessions of charger_1 to fail, got %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := strings.Join([]string{
+		"user_id,device_id,device_type,series,bucket_start,bucket_end,kwh,cost,saved_cost,saved_co2_kg",
+		"user_1,vehicle_1,vehicle,charging,2024-01-01T00:00:00Z,,1.5,2.25,0.5,",
+		"user_1,vehicle_1,vehicle,sessions,2024-01-01T18:00:00Z,2024-01-01T20:00:00Z,3,4,,",
+		"user_1,inverter_1,inverter,production,2024-01-01T00:00:00Z,,10,7,,",
+		"user_2,charger_1,charger,charging,2024-01-01T00:00:00Z,,1.5,2.25,0.5,",
+		"",
+	}, "\n")
+	if buf.String() != expected {
+		t.Errorf("Expected\n%s\ngot\n%s", expected, buf.String())
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(record export.Record) error { return errors.New("disk full") }
+func (failingWriter) Close() error                     { return nil }
+
+func TestExportWriteError(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/users":
+			fmt.Fprint(w, `{"data":[{"id":"user_1"},{"id":"user_2"}],"pagination":{"after":null,"before":null}}`)
+		case "/users/user_1/vehicles", "/users/user_2/vehicles":
+			requests++
+			fmt.Fprint(w, `{"data":[{"id":"vehicle_1"}],"pagination":{"after":null,"before":null}}`)
+		case "/users/user_1/statistics/charging", "/users/user_2/statistics/charging":
+			fmt.Fprint(w, `[{"kwhSum":1,"costSum":1,"date":"2024-01-01T00:00:00Z"}]`)
+		default:
+			fmt.Fprint(w, empty)
+		}
+	}))
+	defer ts.Close()
+
+	sess := session.NewSession(&auth.Authentication{Environment: ts.URL, Access_token: "test_token"})
+	options := export.Options{Start: time.Now().Add(-time.Hour), End: time.Now(), Series: []export.Series{export.SERIES_CHARGING}}
+	err := export.Export(context.Background(), sess, options, failingWriter{})
+	var written *export.WriteError
+	if !errors.As(err, &written) || err.Error() != "disk full" {
+		t.Errorf("Expected the error of the writer, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected the export to stop after the first user, got %d requests", requests)
+	}
+}
+
+func TestWriters(t *testing.T) {
+	saved := 0.25
+	end := time.Date(2024, 1, 1, 3, 0, 0, 0, time.FixedZone("CET", 3600))
+	records := []export.Record{
+		{UserId: "user_1", DeviceId: "vehicle_1", DeviceType: "vehicle", Series: export.SERIES_SESSIONS,
+			BucketStart: time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC), BucketEnd: &end, Kwh: 2, Cost: 1.5, SavedCost: &saved},
+	}
+
+	var jsonl bytes.Buffer
+	w, err := export.NewWriter(export.FORMAT_JSONL, &jsonl)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, record := range records {
+		if err := w.Write(record); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	w.Close()
+	expected := `{"user_id":"user_1","device_id":"vehicle_1","device_type":"vehicle","series":"sessions","bucket_start":"2024-01-01T01:00:00Z","bucket_end":"2024-01-01T02:00:00Z","kwh":2,"cost":1.5,"saved_cost":0.25,"saved_co2_kg":null}` + "\n"
+	if jsonl.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, jsonl.String())
+	}
+
+	var file bytes.Buffer
+	w, _ = export.NewWriter(export.FORMAT_PARQUET, &file)
+	w.Write(records[0])
+	if err := w.Close(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	data := file.Bytes()
+	if !bytes.HasPrefix(data, []byte("PAR1")) || !bytes.HasSuffix(data, []byte("PAR1")) || !bytes.Contains(data, []byte("saved_co2_kg")) {
+		t.Errorf("Expected a Parquet file, got %q", data)
+	}
+
+	if _, err := export.NewWriter("xlsx", &file); err == nil || !strings.Contains(err.Error(), export.EXPORT_FORMAT_ERROR) {
+		t.Errorf("Expected a format error, got %v", err)
+	}
+}
+
+// parquetRow is a record as read back by parquet-go.
+type parquetRow struct {
+	UserId      string   `parquet:"user_id"`
+	DeviceId    string   `parquet:"device_id"`
+	DeviceType  string   `parquet:"device_type"`
+	Series      string   `parquet:"series"`
+	BucketStart int64    `parquet:"bucket_start"`
+	BucketEnd   *int64   `parquet:"bucket_end,optional"`
+	Kwh         float64  `parquet:"kwh"`
+	Cost        float64  `parquet:"cost"`
+	SavedCost   *float64 `parquet:"saved_cost,optional"`
+	SavedCo2Kg  *float64 `parquet:"saved_co2_kg,optional"`
+}
+
+func TestParquetWriter_RoundTrip(t *testing.T) {
+	var file bytes.Buffer
+	w := export.NewParquetWriter(&file)
+	w.RowGroupSize = 3
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var records []export.Record
+	for i := 0; i < 7; i++ {
+		record := export.Record{UserId: fmt.Sprintf("user_%d", i%2), DeviceId: fmt.Sprintf("vehicle_%d", i), DeviceType: "vehicle",
+			Series: export.SERIES_CHARGING, BucketStart: start.Add(time.Duration(i) * time.Hour), Kwh: float64(i) / 2, Cost: float64(i)}
+		if i%3 == 0 {
+			end := record.BucketStart.Add(30 * time.Minute)
+			saved := float64(i) / 4
+			record.Series, record.BucketEnd, record.SavedCost = export.SERIES_SESSIONS, &end, &saved
+		}
+		records = append(records, record)
+		if err := w.Write(record); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	// full row groups are written before Close
+	if file.Len() == 0 {
+		t.Error("Expected the full row groups to be written")
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	read, err := parquet.OpenFile(bytes.NewReader(file.Bytes()), int64(file.Len()))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if groups := len(read.RowGroups()); groups != 3 {
+		t.Errorf("Expected 3 row groups, got %d", groups)
+	}
+	rows, err := parquet.Read[parquetRow](bytes.NewReader(file.Bytes()), int64(file.Len()))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(rows) != len(records) {
+		t.Fatalf("Expected %d rows, got %d", len(records), len(rows))
+	}
+	for i, record := range records {
+		row := rows[i]
+		if row.UserId != record.UserId || row.DeviceId != record.DeviceId || row.DeviceType != record.DeviceType || row.Series != string(record.Series) ||
+			row.BucketStart != record.BucketStart.UnixMilli() || row.Kwh != record.Kwh || row.Cost != record.Cost || row.SavedCo2Kg != nil {
+			t.Errorf("Unexpected row %d: %+v", i, row)
+		}
+		if (row.BucketEnd == nil) != (record.BucketEnd == nil) || (row.BucketEnd != nil && *row.BucketEnd != record.BucketEnd.UnixMilli()) {
+			t.Errorf("Unexpected bucket end of row %d: %v", i, row.BucketEnd)
+		}
+		if (row.SavedCost == nil) != (record.SavedCost == nil) || (row.SavedCost != nil && *row.SavedCost != *record.SavedCost) {
+			t.Errorf("Unexpected saved cost of row %d: %v", i, row.SavedCost)
+		}
+	}
+
+	// a file without records is valid as well
+	file.Reset()
+	w = export.NewParquetWriter(&file)
+	if err := w.Close(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if rows, err := parquet.Read[parquetRow](bytes.NewReader(file.Bytes()), int64(file.Len())); err != nil || len(rows) != 0 {
+		t.Errorf("Expected an empty file, got %d rows and %v", len(rows), err)
+	}
+}
@@ -0,0 +1,362 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// PARQUET_ROW_GROUP_SIZE is the default number of records of a row group.
+const PARQUET_ROW_GROUP_SIZE = 10000
+
+// ParquetWriter writes records as an uncompressed Parquet file. The records
+// are buffered and written as a row group every RowGroupSize records, so the
+// memory used does not grow with the size of the export.
+type ParquetWriter struct {
+	// RowGroupSize is the number of records of a row group, PARQUET_ROW_GROUP_SIZE if 0.
+	RowGroupSize int
+
+	w         io.Writer
+	offset    int64
+	records   []Record
+	rowGroups []rowGroup
+}
+
+// NewParquetWriter creates a writer of Parquet.
+func NewParquetWriter(w io.Writer) *ParquetWriter {
+	return &ParquetWriter{w: w}
+}
+
+func (p *ParquetWriter) Write(record Record) error {
+	p.records = append(p.records, record)
+	size := p.RowGroupSize
+	if size <= 0 {
+		size = PARQUET_ROW_GROUP_SIZE
+	}
+	if len(p.records) < size {
+		return nil
+	}
+	return p.flush()
+}
+
+// Parquet physical types, repetitions, converted types and encodings, as in parquet.thrift.
+const (
+	parquetInt64           = 2
+	parquetDouble          = 5
+	parquetByteArray       = 6
+	parquetRequired        = 0
+	parquetOptional        = 1
+	parquetUTF8            = 0
+	parquetTimestampMillis = 9
+	parquetNone            = -1
+	parquetPlain           = 0
+	parquetRLE             = 3
+	parquetDataPage        = 0
+)
+
+// column describes a column of the file and reads its value from a record,
+// nil for an empty value.
+type column struct {
+	name      string
+	kind      int32
+	converted int32
+	optional  bool
+	value     func(record Record) any
+}
+
+func optional(value *float64) any {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
+
+var parquetColumns = []column{
+	{"user_id", parquetByteArray, parquetUTF8, false, func(record Record) any { return record.UserId }},
+	{"device_id", parquetByteArray, parquetUTF8, false, func(record Record) any { return record.DeviceId }},
+	{"device_type", parquetByteArray, parquetUTF8, false, func(record Record) any { return record.DeviceType }},
+	{"series", parquetByteArray, parquetUTF8, false, func(record Record) any { return string(record.Series) }},
+	{"bucket_start", parquetInt64, parquetTimestampMillis, false, func(record Record) any { return record.BucketStart.UnixMilli() }},
+	{"bucket_end", parquetInt64, parquetTimestampMillis, true, func(record Record) any {
+		if record.BucketEnd == nil {
+			return nil
+		}
+		return record.BucketEnd.UnixMilli()
+	}},
+	{"kwh", parquetDouble, parquetNone, false, func(record Record) any { return record.Kwh }},
+	{"cost", parquetDouble, parquetNone, false, func(record Record) any { return record.Cost }},
+	{"saved_cost", parquetDouble, parquetNone, true, func(record Record) any { return optional(record.SavedCost) }},
+	{"saved_co2_kg", parquetDouble, parquetNone, true, func(record Record) any { return optional(record.SavedCo2Kg) }},
+}
+
+// chunk is a written column chunk of a row group.
+type chunk struct {
+	offset int64
+	size   int64
+}
+
+// rowGroup is a written row group, described in the footer.
+type rowGroup struct {
+	rows   int64
+	chunks []chunk
+}
+
+func (p *ParquetWriter) write(data []byte) error {
+	n, err := p.w.Write(data)
+	p.offset += int64(n)
+	if err != nil {
+		return errors.Join(errors.New(EXPORT_WRITE_ERROR), err)
+	}
+	return nil
+}
+
+// flush writes the buffered records as a row group, after the magic number
+// if it is the first.
+func (p *ParquetWriter) flush() error {
+	var data bytes.Buffer
+	if p.offset == 0 {
+		data.WriteString("PAR1")
+	}
+	if len(p.records) == 0 {
+		return p.write(data.Bytes())
+	}
+
+	group := rowGroup{rows: int64(len(p.records)), chunks: make([]chunk, len(parquetColumns))}
+	for i, col := range parquetColumns {
+		page := p.page(col)
+		var header thrift
+		header.structBegin()
+		header.i32(1, parquetDataPage)
+		header.i32(2, int32(len(page)))
+		header.i32(3, int32(len(page)))
+		header.fieldStruct(5)
+		header.i32(1, int32(len(p.records)))
+		header.i32(2, parquetPlain)
+		header.i32(3, parquetRLE)
+		header.i32(4, parquetRLE)
+		header.structEnd()
+		header.structEnd()
+
+		group.chunks[i] = chunk{offset: p.offset + int64(data.Len()), size: int64(header.Len() + len(page))}
+		data.Write(header.Bytes())
+		data.Write(page)
+	}
+	p.records = p.records[:0]
+	p.rowGroups = append(p.rowGroups, group)
+	return p.write(data.Bytes())
+}
+
+// Close writes the buffered records and the footer of the file.
+func (p *ParquetWriter) Close() error {
+	if err := p.flush(); err != nil {
+		return err
+	}
+	var data bytes.Buffer
+	footer := p.footer()
+	data.Write(footer)
+	binary.Write(&data, binary.LittleEndian, uint32(len(footer)))
+	data.WriteString("PAR1")
+	return p.write(data.Bytes())
+}
+
+// page encodes the values of a column as one data page: the definition levels
+// of an optional column followed by the plain encoded values which are set.
+func (p *ParquetWriter) page(col column) []byte {
+	var page, values bytes.Buffer
+	levels := make([]byte, len(p.records))
+	for i, record := range p.records {
+		value := col.value(record)
+		if value == nil {
+			continue
+		}
+		levels[i] = 1
+		switch v := value.(type) {
+		case string:
+			binary.Write(&values, binary.LittleEndian, uint32(len(v)))
+			values.WriteString(v)
+		case int64:
+			binary.Write(&values, binary.LittleEndian, v)
+		case float64:
+			binary.Write(&values, binary.LittleEndian, math.Float64bits(v))
+		}
+	}
+	if col.optional {
+		encoded := rle(levels)
+		binary.Write(&page, binary.LittleEndian, uint32(len(encoded)))
+		page.Write(encoded)
+	}
+	page.Write(values.Bytes())
+	return page.Bytes()
+}
+
+// rle encodes definition levels of bit width 1 as runs of the RLE/bit-packing hybrid.
+func rle(levels []byte) []byte {
+	var encoded []byte
+	for i := 0; i < len(levels); {
+		j := i
+		for j < len(levels) && levels[j] == levels[i] {
+			j++
+		}
+		encoded = binary.AppendUvarint(encoded, uint64(j-i)<<1)
+		encoded = append(encoded, levels[i])
+		i = j
+	}
+	return encoded
+}
+
+// footer encodes the FileMetaData of the file.
+func (p *ParquetWriter) footer() []byte {
+	var meta thrift
+	meta.structBegin()
+	meta.i32(1, 1)
+
+	meta.fieldList(2, len(parquetColumns)+1)
+	meta.structBegin()
+	meta.binary(4, "schema")
+	meta.i32(5, int32(len(parquetColumns)))
+	meta.structEnd()
+	for _, col := range parquetColumns {
+		meta.structBegin()
+		meta.i32(1, col.kind)
+		repetition := int32(parquetRequired)
+		if col.optional {
+			repetition = parquetOptional
+		}
+		meta.i32(3, repetition)
+		meta.binary(4, col.name)
+		if col.converted != parquetNone {
+			meta.i32(6, col.converted)
+		}
+		meta.structEnd()
+	}
+
+	var rows int64
+	for _, group := range p.rowGroups {
+		rows += group.rows
+	}
+	meta.i64(3, rows)
+	meta.fieldList(4, len(p.rowGroups))
+	for _, group := range p.rowGroups {
+		var total int64
+		meta.structBegin()
+		meta.fieldList(1, len(parquetColumns))
+		for i, col := range parquetColumns {
+			total += group.chunks[i].size
+			meta.structBegin()
+			meta.i64(2, group.chunks[i].offset)
+			meta.fieldStruct(3)
+			meta.i32(1, col.kind)
+			meta.fieldI32List(2, parquetPlain, parquetRLE)
+			meta.fieldStringList(3, col.name)
+			meta.i32(4, 0)
+			meta.i64(5, group.rows)
+			meta.i64(6, group.chunks[i].size)
+			meta.i64(7, group.chunks[i].size)
+			meta.i64(9, group.chunks[i].offset)
+			meta.structEnd()
+			meta.structEnd()
+		}
+		meta.i64(2, total)
+		meta.i64(3, group.rows)
+		meta.structEnd()
+	}
+	meta.binary(6, "enode-gosdk")
+	meta.structEnd()
+	return meta.Bytes()
+}
+
+// thrift encodes structs in the Thrift compact protocol used by Parquet metadata.
+type thrift struct {
+	bytes.Buffer
+	last []int16
+}
+
+// Compact protocol types.
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+func (t *thrift) structBegin() {
+	t.last = append(t.last, 0)
+}
+
+func (t *thrift) structEnd() {
+	t.WriteByte(0)
+	t.last = t.last[:len(t.last)-1]
+}
+
+func (t *thrift) field(id int16, kind byte) {
+	last := &t.last[len(t.last)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		t.WriteByte(byte(delta)<<4 | kind)
+	} else {
+		t.WriteByte(kind)
+		t.varint(int64(id))
+	}
+	*last = id
+}
+
+func (t *thrift) varint(value int64) {
+	// zigzag encoding
+	t.Write(binary.AppendUvarint(nil, uint64((value<<1)^(value>>63))))
+}
+
+func (t *thrift) i32(id int16, value int32) {
+	t.field(id, thriftI32)
+	t.varint(int64(value))
+}
+
+func (t *thrift) i64(id int16, value int64) {
+	t.field(id, thriftI64)
+	t.varint(value)
+}
+
+func (t *thrift) binary(id int16, value string) {
+	t.field(id, thriftBinary)
+	t.Write(binary.AppendUvarint(nil, uint64(len(value))))
+	t.WriteString(value)
+}
+
+// fieldStruct begins a struct field, to be ended with structEnd.
+func (t *thrift) fieldStruct(id int16) {
+	t.field(id, thriftStruct)
+	t.structBegin()
+}
+
+func (t *thrift) listHeader(size int, kind byte) {
+	if size < 15 {
+		t.WriteByte(byte(size)<<4 | kind)
+		return
+	}
+	t.WriteByte(0xF0 | kind)
+	t.Write(binary.AppendUvarint(nil, uint64(size)))
+}
+
+// fieldList begins a list field of structs, followed by its elements.
+func (t *thrift) fieldList(id int16, size int) {
+	t.field(id, thriftList)
+	t.listHeader(size, thriftStruct)
+}
+
+func (t *thrift) fieldI32List(id int16, values ...int32) {
+	t.field(id, thriftList)
+	t.listHeader(len(values), thriftI32)
+	for _, value := range values {
+		t.varint(int64(value))
+	}
+}
+
+func (t *thrift) fieldStringList(id int16, values ...string) {
+	t.field(id, thriftList)
+	t.listHeader(len(values), thriftBinary)
+	for _, value := range values {
+		t.Write(binary.AppendUvarint(nil, uint64(len(value))))
+		t.WriteString(value)
+	}
+}
@@ -0,0 +1,158 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Format is a file format records are written in.
+type Format string
+
+const (
+	FORMAT_CSV     Format = "csv"
+	FORMAT_JSONL   Format = "jsonl"
+	FORMAT_PARQUET Format = "parquet"
+)
+
+// Record is a row of the export. The schema is the same for all series and formats.
+type Record struct {
+	UserId     string `json:"user_id"`
+	DeviceId   string `json:"device_id"`
+	DeviceType string `json:"device_type"`
+	// Series is the statistics the record is taken from, see SERIES_CHARGING.
+	Series      Series    `json:"series"`
+	BucketStart time.Time `json:"bucket_start"`
+	// BucketEnd is set for sessions only, buckets of the other series last for the resolution.
+	BucketEnd *time.Time `json:"bucket_end"`
+	Kwh       float64    `json:"kwh"`
+	// Cost is the cost of the energy consumed, or the value of the energy produced.
+	Cost      float64  `json:"cost"`
+	SavedCost *float64 `json:"saved_cost"`
+	// SavedCo2Kg is reserved for the CO2 saved by smart charging, which the
+	// API does not report yet. It is always empty.
+	SavedCo2Kg *float64 `json:"saved_co2_kg"`
+}
+
+// COLUMNS are the names of the columns in the order they are written.
+var COLUMNS = []string{"user_id", "device_id", "device_type", "series", "bucket_start", "bucket_end", "kwh", "cost", "saved_cost", "saved_co2_kg"}
+
+// Writer writes records to a file.
+type Writer interface {
+	Write(record Record) error
+	// Close flushes the records, it does not close the underlying writer.
+	Close() error
+}
+
+/*
+Creates a writer for a format.
+
+Parameters:
+  - format: The format of the file.
+  - w: The writer the file is written to.
+
+Returns:
+  - The writer of the format.
+  - An error if the format is unknown.
+*/
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FORMAT_CSV:
+		return NewCSVWriter(w), nil
+	case FORMAT_JSONL:
+		return NewJSONLWriter(w), nil
+	case FORMAT_PARQUET:
+		return NewParquetWriter(w), nil
+	}
+	return nil, errors.Join(errors.New(EXPORT_FORMAT_ERROR), fmt.Errorf("got %q", format))
+}
+
+// CSVWriter writes records as CSV with a header row. Times are written in
+// RFC 3339 and empty values as empty fields.
+type CSVWriter struct {
+	w      *csv.Writer
+	header bool
+}
+
+// NewCSVWriter creates a writer of CSV.
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	return &CSVWriter{w: csv.NewWriter(w)}
+}
+
+func (c *CSVWriter) Write(record Record) error {
+	if !c.header {
+		c.header = true
+		if err := c.w.Write(COLUMNS); err != nil {
+			return errors.Join(errors.New(EXPORT_WRITE_ERROR), err)
+		}
+	}
+	row := []string{
+		record.UserId,
+		record.DeviceId,
+		record.DeviceType,
+		string(record.Series),
+		record.BucketStart.UTC().Format(time.RFC3339),
+		"",
+		strconv.FormatFloat(record.Kwh, 'f', -1, 64),
+		strconv.FormatFloat(record.Cost, 'f', -1, 64),
+		optionalFloat(record.SavedCost),
+		optionalFloat(record.SavedCo2Kg),
+	}
+	if record.BucketEnd != nil {
+		row[5] = record.BucketEnd.UTC().Format(time.RFC3339)
+	}
+	if err := c.w.Write(row); err != nil {
+		return errors.Join(errors.New(EXPORT_WRITE_ERROR), err)
+	}
+	return nil
+}
+
+func (c *CSVWriter) Close() error {
+	if !c.header {
+		c.header = true
+		c.w.Write(COLUMNS)
+	}
+	c.w.Flush()
+	if err := c.w.Error(); err != nil {
+		return errors.Join(errors.New(EXPORT_WRITE_ERROR), err)
+	}
+	return nil
+}
+
+func optionalFloat(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
+
+// JSONLWriter writes records as JSON Lines, one object per record. Empty
+// values are written as null.
+type JSONLWriter struct {
+	encoder *json.Encoder
+}
+
+// NewJSONLWriter creates a writer of JSON Lines.
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	return &JSONLWriter{encoder: json.NewEncoder(w)}
+}
+
+func (j *JSONLWriter) Write(record Record) error {
+	record.BucketStart = record.BucketStart.UTC()
+	if record.BucketEnd != nil {
+		end := record.BucketEnd.UTC()
+		record.BucketEnd = &end
+	}
+	if err := j.encoder.Encode(record); err != nil {
+		return errors.Join(errors.New(EXPORT_WRITE_ERROR), err)
+	}
+	return nil
+}
+
+func (j *JSONLWriter) Close() error {
+	return nil
+}
@@ -0,0 +1,222 @@
+// Package guards checks the capabilities of a device before sending it a
+// command, so that an incapable device is reported with the interventions
+// resolving it instead of a generic validation error of the API:
+//
+//	guard := guards.New(sess, languages.GERMAN)
+//	action, err := guard.ControlVehicleCharging(ctx, vehicleId, payload)
+//	var incapable *guards.CapabilityError
+//	if errors.As(err, &incapable) {
+//		for _, intervention := range incapable.Interventions {
+//			fmt.Println(intervention.Resolution.Title)
+//		}
+//	}
+package guards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/addihorn/enode-gosdk/pkg/batteries"
+	"github.com/addihorn/enode-gosdk/pkg/chargers"
+	"github.com/addihorn/enode-gosdk/pkg/enums/languages"
+	"github.com/addihorn/enode-gosdk/pkg/hvacs"
+	"github.com/addihorn/enode-gosdk/pkg/interventions"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+)
+
+const (
+	CAPABILITY_ERROR           string = "guards: the device is not capable of the command"
+	INTERVENTION_RESOLVE_ERROR string = "guards: unable to resolve the interventions of the capability"
+)
+
+// CapabilityError reports a capability a device lacks, with the interventions
+// the user can make to enable it.
+type CapabilityError struct {
+	DeviceId   string
+	VendorType models.VendorType
+	// Capability is the JSON name of the missing capability, e.g. "startCharging".
+	Capability string
+	// Interventions are resolved in the language of the Guard. They are empty
+	// if the vendor offers no way to enable the capability.
+	Interventions []models.Intervention
+}
+
+func (e *CapabilityError) Error() string {
+	message := fmt.Sprintf("%s: %s %s lacks %s", CAPABILITY_ERROR, strings.ToLower(string(e.VendorType)), e.DeviceId, e.Capability)
+	titles := make([]string, len(e.Interventions))
+	for i, intervention := range e.Interventions {
+		titles[i] = intervention.Resolution.Title
+	}
+	if len(titles) > 0 {
+		message += " (" + strings.Join(titles, "; ") + ")"
+	}
+	return message
+}
+
+/*
+Checks that a device has all the given capabilities.
+
+Parameters:
+  - sess: The session used to resolve interventions.
+  - device: The device to check.
+  - language: The language the interventions are resolved in.
+  - capabilities: The JSON names of the required capabilities, e.g. "chargeState".
+
+Returns:
+  - A *CapabilityError for the first capability the device lacks, joined with an error
+    if its interventions could not be resolved. Nil if the device has all capabilities.
+*/
+func Require(ctx context.Context, sess *session.Session, device models.Device, language languages.Language, capabilities ...string) error {
+	available := device.DeviceCapabilities()
+	for _, name := range capabilities {
+		capability, ok := available[name]
+		if ok && capability.IsCapable {
+			continue
+		}
+		incapable := &CapabilityError{DeviceId: device.DeviceId(), VendorType: device.DeviceVendorType(), Capability: name}
+		vendorType := device.DeviceVendorType()
+		params := &interventions.GetInterventionParams{VendorType: &vendorType}
+		if language != "" {
+			lang := interventions.Language(language)
+			params.Language = &lang
+		}
+		for _, id := range capability.InterventionIds {
+			intervention, err := interventions.GetIntervention(ctx, sess, id, params)
+			if err != nil {
+				return errors.Join(incapable, errors.New(INTERVENTION_RESOLVE_ERROR), err)
+			}
+			incapable.Interventions = append(incapable.Interventions, *intervention)
+		}
+		return incapable
+	}
+	return nil
+}
+
+// Guard sends commands to devices after checking their capabilities.
+type Guard struct {
+	sess     *session.Session
+	language languages.Language
+}
+
+/*
+Creates a Guard sending commands with a session.
+
+Parameters:
+  - sess: The session of the client.
+  - language: The language interventions are resolved in. Empty for the API default, en-US.
+
+Returns:
+  - A pointer to the Guard.
+*/
+func New(sess *session.Session, language languages.Language) *Guard {
+	return &Guard{sess: sess, language: language}
+}
+
+// charging lists the capabilities required to start or stop charging. The
+// charge state is required as well, as the resulting action completes once the
+// charge state matches.
+func charging(action models.ChargingAction) []string {
+	if action == models.CHARGING_ACTION_STOP {
+		return []string{"chargeState", "stopCharging"}
+	}
+	return []string{"chargeState", "startCharging"}
+}
+
+// operationModes maps the battery operation modes to the capabilities they require.
+var operationModes = map[models.SetBatteryOperationModePayloadOperationMode]string{
+	models.SET_BATTERY_OPERATION_MODE_PAYLOAD_OPERATION_MODE_IMPORT_FOCUS:  "importFocus",
+	models.SET_BATTERY_OPERATION_MODE_PAYLOAD_OPERATION_MODE_EXPORT_FOCUS:  "exportFocus",
+	models.SET_BATTERY_OPERATION_MODE_PAYLOAD_OPERATION_MODE_TIME_OF_USE:   "timeOfUse",
+	models.SET_BATTERY_OPERATION_MODE_PAYLOAD_OPERATION_MODE_SELF_RELIANCE: "selfReliance",
+}
+
+// ControlVehicleCharging starts or stops charging a vehicle capable of it.
+func (g *Guard) ControlVehicleCharging(ctx context.Context, vehicleId string, payload *models.ControlChargingPayload) (*models.ChargeAction, error) {
+	vehicle, err := vehicles.GetVehicle(ctx, g.sess, vehicleId)
+	if err != nil {
+		return nil, err
+	}
+	if err := Require(ctx, g.sess, vehicle, g.language, charging(payload.Action)...); err != nil {
+		return nil, err
+	}
+	return vehicles.ControlVehicleCharging(ctx, g.sess, vehicleId, payload)
+}
+
+// SetVehicleMaxCurrent sets the max current of a vehicle capable of it.
+func (g *Guard) SetVehicleMaxCurrent(ctx context.Context, vehicleId string, payload *models.TargetMaxCurrent) (*models.MaxCurrentAction, error) {
+	vehicle, err := vehicles.GetVehicle(ctx, g.sess, vehicleId)
+	if err != nil {
+		return nil, err
+	}
+	if err := Require(ctx, g.sess, vehicle, g.language, "setMaxCurrent"); err != nil {
+		return nil, err
+	}
+	return vehicles.SetVehicleMaxCurrent(ctx, g.sess, vehicleId, payload)
+}
+
+// ControlChargerCharging starts or stops charging with a charger capable of it.
+func (g *Guard) ControlChargerCharging(ctx context.Context, chargerId string, payload *models.ControlChargingPayload) (*models.ChargeAction, error) {
+	charger, err := chargers.GetCharger(ctx, g.sess, chargerId)
+	if err != nil {
+		return nil, err
+	}
+	if err := Require(ctx, g.sess, charger, g.language, charging(payload.Action)...); err != nil {
+		return nil, err
+	}
+	return chargers.ControlChargerCharging(ctx, g.sess, chargerId, payload)
+}
+
+// SetChargerMaxCurrent sets the max current of a charger capable of it.
+func (g *Guard) SetChargerMaxCurrent(ctx context.Context, chargerId string, payload *models.TargetMaxCurrent) (*models.MaxCurrentAction, error) {
+	charger, err := chargers.GetCharger(ctx, g.sess, chargerId)
+	if err != nil {
+		return nil, err
+	}
+	if err := Require(ctx, g.sess, charger, g.language, "setMaxCurrent"); err != nil {
+		return nil, err
+	}
+	return chargers.SetChargerMaxCurrent(ctx, g.sess, chargerId, payload)
+}
+
+// SetBatteryOperationMode sets the operation mode of a battery capable of the mode.
+func (g *Guard) SetBatteryOperationMode(ctx context.Context, batteryId string, payload *models.SetBatteryOperationModePayload) (*models.OperationModeAction, error) {
+	battery, err := batteries.GetBattery(ctx, g.sess, batteryId)
+	if err != nil {
+		return nil, err
+	}
+	// unknown modes are left to the API to reject
+	if capability, ok := operationModes[payload.OperationMode]; ok {
+		if err := Require(ctx, g.sess, battery, g.language, capability); err != nil {
+			return nil, err
+		}
+	}
+	return batteries.SetBatteryOperationMode(ctx, g.sess, batteryId, payload)
+}
+
+// SetHvacFollowSchedule makes a HVAC capable of it follow its schedule.
+func (g *Guard) SetHvacFollowSchedule(ctx context.Context, hvacId string) (*models.HvacActionFollowSchedule, error) {
+	hvac, err := hvacs.GetHVAC(ctx, g.sess, hvacId)
+	if err != nil {
+		return nil, err
+	}
+	if err := Require(ctx, g.sess, hvac, g.language, "setFollowSchedule"); err != nil {
+		return nil, err
+	}
+	return hvacs.SetHvacFollowSchedule(ctx, g.sess, hvacId)
+}
+
+// SetHvacPermanentHold sets a permanent hold on a HVAC capable of it.
+func (g *Guard) SetHvacPermanentHold(ctx context.Context, hvacId string, payload *models.HVACSetPermanentHoldPayload) (*models.HvacActionPermanentHold, error) {
+	hvac, err := hvacs.GetHVAC(ctx, g.sess, hvacId)
+	if err != nil {
+		return nil, err
+	}
+	if err := Require(ctx, g.sess, hvac, g.language, "setPermanentHold"); err != nil {
+		return nil, err
+	}
+	return hvacs.SetHvacPermanentHold(ctx, g.sess, hvacId, payload)
+}
@@ -0,0 +1,66 @@
+package guards_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/enums/languages"
+	"github.com/addihorn/enode-gosdk/pkg/guards"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+func TestGuard_ControlVehicleCharging(t *testing.T) {
+	// Create a test server with a vehicle lacking startCharging, recording commands
+	var commands []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/vehicles/vehicle_1":
+			fmt.Fprint(w, `{"id":"vehicle_1","vendor":"TESLA","capabilities":{"chargeState":{"isCapable":true,"interventionIds":[]},"startCharging":{"isCapable":false,"interventionIds":["intervention_1"]},"stopCharging":{"isCapable":true,"interventionIds":[]}}}`)
+		case r.URL.Path == "/interventions/intervention_1":
+			if r.URL.Query().Get("language") != "de-DE" {
+				t.Errorf("Expected the intervention in de-DE, got %q", r.URL.Query().Get("language"))
+			}
+			fmt.Fprint(w, `{"id":"intervention_1","vendor":"TESLA","vendorType":"vehicle","resolution":{"title":"Schlüssel hinzufügen","description":"...","access":"Remote","agent":"User"}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/vehicles/vehicle_1/charging":
+			commands = append(commands, r.URL.Path)
+			fmt.Fprint(w, `{"id":"action_1","state":"PENDING","targetId":"vehicle_1","targetType":"vehicle","kind":"STOP"}`)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	guard := guards.New(session.NewSession(&auth.Authentication{Environment: ts.URL, Access_token: "test_token"}), languages.GERMAN)
+	ctx := context.Background()
+
+	_, err := guard.ControlVehicleCharging(ctx, "vehicle_1", &models.ControlChargingPayload{Action: models.CHARGING_ACTION_START})
+	var incapable *guards.CapabilityError
+	if !errors.As(err, &incapable) {
+		t.Fatalf("Expected a capability error, got %v", err)
+	}
+	if incapable.Capability != "startCharging" || len(incapable.Interventions) != 1 || incapable.Interventions[0].Resolution.Title != "Schlüssel hinzufügen" {
+		t.Errorf("Unexpected capability error %+v", incapable)
+	}
+	if !strings.Contains(err.Error(), "Schlüssel hinzufügen") {
+		t.Errorf("Expected the intervention in the message, got %q", err.Error())
+	}
+	if len(commands) != 0 {
+		t.Errorf("Expected no command to be sent, got %v", commands)
+	}
+
+	if _, err := guard.ControlVehicleCharging(ctx, "vehicle_1", &models.ControlChargingPayload{Action: models.CHARGING_ACTION_STOP}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(commands) != 1 {
+		t.Errorf("Expected the command to be sent, got %v", commands)
+	}
+}
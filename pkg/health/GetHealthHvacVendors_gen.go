@@ -0,0 +1,32 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package health
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Lists the available HVAC vendors, including the activated vendors that your client has access to. Learn more about [vendors requiring activation](https://developers.enode.io/api/capabilities/vehicles?dialog=glossary&value=activation-required).
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+
+Returns:
+  - The []models.HvacHealth returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetHealthHvacVendors(ctx context.Context, sess *session.Session) ([]models.HvacHealth, error) {
+	path := "/health/hvacs"
+
+	var result []models.HvacHealth
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
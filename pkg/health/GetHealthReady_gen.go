@@ -0,0 +1,26 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package health
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Gets the combined health status of the service and all functionalities and dependencies.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+
+Returns:
+  - An error if any occurred during the request. If the request is successful, it returns nil.
+*/
+func GetHealthReady(ctx context.Context, sess *session.Session) error {
+	path := "/health/ready"
+
+	return do(ctx, sess, http.MethodGet, path, nil, nil, nil)
+}
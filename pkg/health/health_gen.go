@@ -0,0 +1,82 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+// Package health wraps the endpoints of the Enode API for the service and vendor health.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/internal/rest"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+const (
+	REST_HEALTH_TRANSFER_ERROR      string = "health: could not transfer request"
+	REST_HEALTH_READ_ERROR          string = "health: could not read response body"
+	REST_HEALTH_PARSE_ERROR         string = "health: unable to parse response data"
+	REST_HEALTH_PAYLOAD_ERROR       string = "health: unable to create request payload"
+	REST_HEALTH_UNAUTHORIZED_ERROR  string = "health: unauthorized access"
+	REST_HEALTH_FORBIDDEN_ERROR     string = "health: access to the resource is forbidden"
+	REST_HEALTH_NOT_FOUND_ERROR     string = "health: requested resource not found"
+	REST_HEALTH_VALIDATION_ERROR    string = "health: invalid request payload input"
+	REST_HEALTH_CONFLICT_ERROR      string = "health: request conflicts with the current state of the resource"
+	REST_HEALTH_UNPROCESSABLE_ERROR string = "health: request could not be processed for the target"
+	REST_HEALTH_RATE_LIMIT_ERROR    string = "health: too many requests"
+	REST_HEALTH_GENERAL_ERROR       string = "health: some kind of error occurred"
+)
+
+// do executes a request and decodes a successful response into result, if set.
+func do(ctx context.Context, sess *session.Session, method, path string, query url.Values, payload, result any) error {
+	req, err := rest.NewRequest(ctx, sess, method, path, query, payload)
+	if err != nil {
+		return errors.Join(errors.New(REST_HEALTH_PAYLOAD_ERROR), err)
+	}
+
+	resp, err := sess.Do(req)
+	if err != nil {
+		return errors.Join(errors.New(REST_HEALTH_TRANSFER_ERROR), err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Join(errors.New(REST_HEALTH_READ_ERROR), err)
+	}
+
+	switch resp.StatusCode {
+	default:
+		return errors.Join(errors.New(REST_HEALTH_GENERAL_ERROR), rest.Problem(resp, body))
+	case http.StatusBadRequest:
+		return errors.Join(errors.New(REST_HEALTH_VALIDATION_ERROR), rest.Problem(resp, body))
+	case http.StatusUnauthorized:
+		return errors.Join(errors.New(REST_HEALTH_UNAUTHORIZED_ERROR), rest.Problem(resp, body))
+	case http.StatusForbidden:
+		return errors.Join(errors.New(REST_HEALTH_FORBIDDEN_ERROR), rest.Problem(resp, body))
+	case http.StatusNotFound:
+		return errors.Join(errors.New(REST_HEALTH_NOT_FOUND_ERROR), rest.Problem(resp, body))
+	case http.StatusConflict:
+		return errors.Join(errors.New(REST_HEALTH_CONFLICT_ERROR), rest.Problem(resp, body))
+	case http.StatusUnprocessableEntity:
+		return errors.Join(errors.New(REST_HEALTH_UNPROCESSABLE_ERROR), rest.Problem(resp, body))
+	case http.StatusTooManyRequests:
+		return errors.Join(errors.New(REST_HEALTH_RATE_LIMIT_ERROR), rest.Problem(resp, body))
+	case http.StatusBadGateway:
+		return errors.Join(errors.New(REST_HEALTH_TRANSFER_ERROR), rest.Problem(resp, body))
+	case http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent:
+		if result == nil {
+			return nil
+		}
+		if len(body) == 0 {
+			return errors.Join(errors.New(REST_HEALTH_READ_ERROR), io.EOF)
+		}
+		if err := json.Unmarshal(body, result); err != nil {
+			return errors.Join(errors.New(REST_HEALTH_PARSE_ERROR), err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package hvacs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Get the status of a smart HVAC unit
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - hvacId: The hvacId of the request.
+
+Returns:
+  - A pointer to the models.HvacSmartStatus object returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetHvacSmartStatus(ctx context.Context, sess *session.Session, hvacId string) (*models.HvacSmartStatus, error) {
+	path := fmt.Sprintf("/hvacs/%s/smart-status", url.PathEscape(hvacId))
+
+	var result models.HvacSmartStatus
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package hvacs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Returns the current state of the requested action.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - actionId: The actionId of the request.
+
+Returns:
+  - A pointer to the models.HvacAction object returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetHvacsAction(ctx context.Context, sess *session.Session, actionId string) (*models.HvacAction, error) {
+	path := fmt.Sprintf("/hvacs/actions/%s", url.PathEscape(actionId))
+
+	var result models.HvacAction
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
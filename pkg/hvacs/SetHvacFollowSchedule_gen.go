@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package hvacs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Tell an HVAC unit to follow the schedule set on the device. Only available if the target's `capabilities.setFollowSchedule.isCapable` is set to `true`. This endpoint can be used to cancel permanent holds. We retry sending the command until the HVAC unit's fields transition to the expected values. Note that this request will complete before any commands are sent to the HVAC unit. You may react to transitions by listening for the `user:vendor-action:updated` webhook event or polling the [HVAC action endpoint](/api/reference#getHvacsAction).
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - hvacId: The hvacId of the request.
+
+Returns:
+  - A pointer to the models.HvacActionFollowSchedule object returned by the API.
+  - An error if any occurred during the request.
+*/
+func SetHvacFollowSchedule(ctx context.Context, sess *session.Session, hvacId string) (*models.HvacActionFollowSchedule, error) {
+	path := fmt.Sprintf("/hvacs/%s/follow-schedule", url.PathEscape(hvacId))
+
+	var result models.HvacActionFollowSchedule
+	if err := do(ctx, sess, http.MethodPost, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,36 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package hvacs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Tell an HVAC unit to enter a permanent hold. Only available if the target's `capabilities.setPermanentHold.isCapable` is set to `true`. We retry sending the command until the HVAC unit's `target` field transition to the expected value. Note that this request will complete before any commands are sent to the HVAC unit. You may react to transitions by listening for the `user:vendor-action:updated` webhook event or polling the [HVAC action endpoint](/api/reference#getHvacsAction).
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - hvacId: The hvacId of the request.
+  - payload: The request body sent to the API.
+
+Returns:
+  - A pointer to the models.HvacActionPermanentHold object returned by the API.
+  - An error if any occurred during the request.
+*/
+func SetHvacPermanentHold(ctx context.Context, sess *session.Session, hvacId string, payload *models.HVACSetPermanentHoldPayload) (*models.HvacActionPermanentHold, error) {
+	path := fmt.Sprintf("/hvacs/%s/permanent-hold", url.PathEscape(hvacId))
+
+	var result models.HvacActionPermanentHold
+	if err := do(ctx, sess, http.MethodPost, path, nil, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,36 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package hvacs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Update the `locationId` field on an HVAC unit.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - hvacId: The hvacId of the request.
+  - payload: The request body sent to the API.
+
+Returns:
+  - A pointer to the models.Hvac object returned by the API.
+  - An error if any occurred during the request.
+*/
+func UpdateHVAC(ctx context.Context, sess *session.Session, hvacId string, payload *models.HvacUpdatePayload) (*models.Hvac, error) {
+	path := fmt.Sprintf("/hvacs/%s", url.PathEscape(hvacId))
+
+	var result models.Hvac
+	if err := do(ctx, sess, http.MethodPut, path, nil, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,66 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package interventions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+// GetInterventionParams holds the query parameters of GetIntervention.
+type GetInterventionParams struct {
+	// Preferred BCP47 language code - Request translation for the specified language. Falls back to `en-US` if not provided or provided language code is unsupported.
+	Language *Language
+	// Only return interventions for the specified vendor type.
+	VendorType *models.VendorType
+	// Only return interventions for the specified vendor.
+	Vendor *models.Vendor
+}
+
+// Values encodes the parameters as URL query values.
+func (params *GetInterventionParams) Values() url.Values {
+	query := url.Values{}
+	if params == nil {
+		return query
+	}
+	if params.Language != nil {
+		query.Set("language", string(*params.Language))
+	}
+	if params.VendorType != nil {
+		query.Set("vendorType", string(*params.VendorType))
+	}
+	if params.Vendor != nil {
+		query.Set("vendor", string(*params.Vendor))
+	}
+	return query
+}
+
+/*
+Returns a single intervention.
+
+The `language` parameter can be used to specify the language of the resolution title and description.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - interventionId: The interventionId of the request.
+  - params: The optional query parameters of the request, may be nil.
+
+Returns:
+  - A pointer to the models.Intervention object returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetIntervention(ctx context.Context, sess *session.Session, interventionId string, params *GetInterventionParams) (*models.Intervention, error) {
+	path := fmt.Sprintf("/interventions/%s", url.PathEscape(interventionId))
+
+	var result models.Intervention
+	if err := do(ctx, sess, http.MethodGet, path, params.Values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,101 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+// Package interventions wraps the endpoints of the Enode API for the interventions a user can make to enable device capabilities.
+package interventions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/internal/rest"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+const (
+	REST_INTERVENTION_TRANSFER_ERROR      string = "interventions: could not transfer request"
+	REST_INTERVENTION_READ_ERROR          string = "interventions: could not read response body"
+	REST_INTERVENTION_PARSE_ERROR         string = "interventions: unable to parse response data"
+	REST_INTERVENTION_PAYLOAD_ERROR       string = "interventions: unable to create request payload"
+	REST_INTERVENTION_UNAUTHORIZED_ERROR  string = "interventions: unauthorized access"
+	REST_INTERVENTION_FORBIDDEN_ERROR     string = "interventions: access to the resource is forbidden"
+	REST_INTERVENTION_NOT_FOUND_ERROR     string = "interventions: requested resource not found"
+	REST_INTERVENTION_VALIDATION_ERROR    string = "interventions: invalid request payload input"
+	REST_INTERVENTION_CONFLICT_ERROR      string = "interventions: request conflicts with the current state of the resource"
+	REST_INTERVENTION_UNPROCESSABLE_ERROR string = "interventions: request could not be processed for the target"
+	REST_INTERVENTION_RATE_LIMIT_ERROR    string = "interventions: too many requests"
+	REST_INTERVENTION_GENERAL_ERROR       string = "interventions: some kind of error occurred"
+)
+
+// Language: Preferred BCP47 language code - Request translation for the specified language. Falls back to `en-US` if not provided or provided language code is unsupported.
+type Language string
+
+const (
+	LANGUAGE_EN_US Language = "en-US"
+	LANGUAGE_EN_GB Language = "en-GB"
+	LANGUAGE_DE_DE Language = "de-DE"
+	LANGUAGE_FR_FR Language = "fr-FR"
+	LANGUAGE_ES_ES Language = "es-ES"
+	LANGUAGE_PT_PT Language = "pt-PT"
+	LANGUAGE_NL_NL Language = "nl-NL"
+	LANGUAGE_NL_BE Language = "nl-BE"
+	LANGUAGE_NB_NO Language = "nb-NO"
+	LANGUAGE_SV_SE Language = "sv-SE"
+	LANGUAGE_DA_DK Language = "da-DK"
+	LANGUAGE_FI_FI Language = "fi-FI"
+	LANGUAGE_RO_RO Language = "ro-RO"
+)
+
+// do executes a request and decodes a successful response into result, if set.
+func do(ctx context.Context, sess *session.Session, method, path string, query url.Values, payload, result any) error {
+	req, err := rest.NewRequest(ctx, sess, method, path, query, payload)
+	if err != nil {
+		return errors.Join(errors.New(REST_INTERVENTION_PAYLOAD_ERROR), err)
+	}
+
+	resp, err := sess.Do(req)
+	if err != nil {
+		return errors.Join(errors.New(REST_INTERVENTION_TRANSFER_ERROR), err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Join(errors.New(REST_INTERVENTION_READ_ERROR), err)
+	}
+
+	switch resp.StatusCode {
+	default:
+		return errors.Join(errors.New(REST_INTERVENTION_GENERAL_ERROR), rest.Problem(resp, body))
+	case http.StatusBadRequest:
+		return errors.Join(errors.New(REST_INTERVENTION_VALIDATION_ERROR), rest.Problem(resp, body))
+	case http.StatusUnauthorized:
+		return errors.Join(errors.New(REST_INTERVENTION_UNAUTHORIZED_ERROR), rest.Problem(resp, body))
+	case http.StatusForbidden:
+		return errors.Join(errors.New(REST_INTERVENTION_FORBIDDEN_ERROR), rest.Problem(resp, body))
+	case http.StatusNotFound:
+		return errors.Join(errors.New(REST_INTERVENTION_NOT_FOUND_ERROR), rest.Problem(resp, body))
+	case http.StatusConflict:
+		return errors.Join(errors.New(REST_INTERVENTION_CONFLICT_ERROR), rest.Problem(resp, body))
+	case http.StatusUnprocessableEntity:
+		return errors.Join(errors.New(REST_INTERVENTION_UNPROCESSABLE_ERROR), rest.Problem(resp, body))
+	case http.StatusTooManyRequests:
+		return errors.Join(errors.New(REST_INTERVENTION_RATE_LIMIT_ERROR), rest.Problem(resp, body))
+	case http.StatusBadGateway:
+		return errors.Join(errors.New(REST_INTERVENTION_TRANSFER_ERROR), rest.Problem(resp, body))
+	case http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent:
+		if result == nil {
+			return nil
+		}
+		if len(body) == 0 {
+			return errors.Join(errors.New(REST_INTERVENTION_READ_ERROR), io.EOF)
+		}
+		if err := json.Unmarshal(body, result); err != nil {
+			return errors.Join(errors.New(REST_INTERVENTION_PARSE_ERROR), err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package inverters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Get Solar Inverter
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - inverterId: The inverterId of the request.
+
+Returns:
+  - A pointer to the models.Inverter object returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetInverter(ctx context.Context, sess *session.Session, inverterId string) (*models.Inverter, error) {
+	path := fmt.Sprintf("/inverters/%s", url.PathEscape(inverterId))
+
+	var result models.Inverter
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
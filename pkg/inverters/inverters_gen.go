@@ -0,0 +1,82 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+// Package inverters wraps the endpoints of the Enode API for the solar inverters of a user.
+package inverters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/internal/rest"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+const (
+	REST_INVERTER_TRANSFER_ERROR      string = "inverters: could not transfer request"
+	REST_INVERTER_READ_ERROR          string = "inverters: could not read response body"
+	REST_INVERTER_PARSE_ERROR         string = "inverters: unable to parse response data"
+	REST_INVERTER_PAYLOAD_ERROR       string = "inverters: unable to create request payload"
+	REST_INVERTER_UNAUTHORIZED_ERROR  string = "inverters: unauthorized access"
+	REST_INVERTER_FORBIDDEN_ERROR     string = "inverters: access to the resource is forbidden"
+	REST_INVERTER_NOT_FOUND_ERROR     string = "inverters: requested resource not found"
+	REST_INVERTER_VALIDATION_ERROR    string = "inverters: invalid request payload input"
+	REST_INVERTER_CONFLICT_ERROR      string = "inverters: request conflicts with the current state of the resource"
+	REST_INVERTER_UNPROCESSABLE_ERROR string = "inverters: request could not be processed for the target"
+	REST_INVERTER_RATE_LIMIT_ERROR    string = "inverters: too many requests"
+	REST_INVERTER_GENERAL_ERROR       string = "inverters: some kind of error occurred"
+)
+
+// do executes a request and decodes a successful response into result, if set.
+func do(ctx context.Context, sess *session.Session, method, path string, query url.Values, payload, result any) error {
+	req, err := rest.NewRequest(ctx, sess, method, path, query, payload)
+	if err != nil {
+		return errors.Join(errors.New(REST_INVERTER_PAYLOAD_ERROR), err)
+	}
+
+	resp, err := sess.Do(req)
+	if err != nil {
+		return errors.Join(errors.New(REST_INVERTER_TRANSFER_ERROR), err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Join(errors.New(REST_INVERTER_READ_ERROR), err)
+	}
+
+	switch resp.StatusCode {
+	default:
+		return errors.Join(errors.New(REST_INVERTER_GENERAL_ERROR), rest.Problem(resp, body))
+	case http.StatusBadRequest:
+		return errors.Join(errors.New(REST_INVERTER_VALIDATION_ERROR), rest.Problem(resp, body))
+	case http.StatusUnauthorized:
+		return errors.Join(errors.New(REST_INVERTER_UNAUTHORIZED_ERROR), rest.Problem(resp, body))
+	case http.StatusForbidden:
+		return errors.Join(errors.New(REST_INVERTER_FORBIDDEN_ERROR), rest.Problem(resp, body))
+	case http.StatusNotFound:
+		return errors.Join(errors.New(REST_INVERTER_NOT_FOUND_ERROR), rest.Problem(resp, body))
+	case http.StatusConflict:
+		return errors.Join(errors.New(REST_INVERTER_CONFLICT_ERROR), rest.Problem(resp, body))
+	case http.StatusUnprocessableEntity:
+		return errors.Join(errors.New(REST_INVERTER_UNPROCESSABLE_ERROR), rest.Problem(resp, body))
+	case http.StatusTooManyRequests:
+		return errors.Join(errors.New(REST_INVERTER_RATE_LIMIT_ERROR), rest.Problem(resp, body))
+	case http.StatusBadGateway:
+		return errors.Join(errors.New(REST_INVERTER_TRANSFER_ERROR), rest.Problem(resp, body))
+	case http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent:
+		if result == nil {
+			return nil
+		}
+		if len(body) == 0 {
+			return errors.Join(errors.New(REST_INVERTER_READ_ERROR), io.EOF)
+		}
+		if err := json.Unmarshal(body, result); err != nil {
+			return errors.Join(errors.New(REST_INVERTER_PARSE_ERROR), err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package locations
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Fetch a Location.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - locationId: The locationId of the request.
+
+Returns:
+  - A pointer to the models.LocationResponse object returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetLocation(ctx context.Context, sess *session.Session, locationId string) (*models.LocationResponse, error) {
+	path := fmt.Sprintf("/locations/%s", url.PathEscape(locationId))
+
+	var result models.LocationResponse
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
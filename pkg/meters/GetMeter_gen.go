@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package meters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Get Meter
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - meterId: The meterId of the request.
+
+Returns:
+  - A pointer to the models.Meter object returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetMeter(ctx context.Context, sess *session.Session, meterId string) (*models.Meter, error) {
+	path := fmt.Sprintf("/meters/%s", url.PathEscape(meterId))
+
+	var result models.Meter
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,151 @@
+// Package metrics instruments the requests of a session. The Recorder
+// interface is independent of a metrics library, Prometheus exposes the
+// recorded metrics in the Prometheus text format without further dependencies:
+//
+//	registry := metrics.NewRegistry()
+//	recorder := metrics.NewPrometheus(registry)
+//	sess.HttpClient = &http.Client{Transport: metrics.NewTransport(nil, recorder)}
+//	http.Handle("/metrics", registry)
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/internal/rest"
+	"github.com/addihorn/enode-gosdk/pkg/endpoints"
+)
+
+const (
+	// UNKNOWN_ENDPOINT labels requests to paths which are not in the specification.
+	UNKNOWN_ENDPOINT = "unknown"
+	// STATUS_CLASS_ERROR labels requests which failed without a response.
+	STATUS_CLASS_ERROR = "error"
+)
+
+// Recorder receives the measurements of the client, e.g. to forward them to
+// Prometheus or OpenTelemetry. Its methods are called concurrently.
+type Recorder interface {
+	// Request is called when a response was received or the request failed.
+	// endpoint is the path template of the operation, e.g. /users/{userId},
+	// statusClass is 2xx to 5xx or STATUS_CLASS_ERROR, problemType is the type
+	// of the problem details of an error response, if any.
+	Request(endpoint, method, statusClass, problemType string, duration time.Duration)
+	// Retry is called before a request is sent again.
+	Retry(endpoint, method string)
+	// RateLimitWait is called with the time a request waited for the rate limiter.
+	RateLimitWait(wait time.Duration)
+	// TokenRefresh is called after the access token was refreshed, with the error if it failed.
+	TokenRefresh(err error)
+}
+
+// Endpoint returns the path template of the operation of a request, or UNKNOWN_ENDPOINT.
+func Endpoint(req *http.Request) string {
+	if endpoint, ok := endpoints.ForRequest(req); ok {
+		return endpoint.Template
+	}
+	return UNKNOWN_ENDPOINT
+}
+
+// StatusClass returns the class of a status code, e.g. 4xx for 404.
+func StatusClass(status int) string {
+	if status < 100 || status > 599 {
+		return STATUS_CLASS_ERROR
+	}
+	return string(rune('0'+status/100)) + "xx"
+}
+
+// Transport records the requests sent through it, to be used as transport of
+// a session's HttpClient. Stacked below the transports of pkg/retry and
+// pkg/ratelimit, it records every attempt of a request.
+type Transport struct {
+	// Base sends the requests, http.DefaultTransport if nil.
+	Base     http.RoundTripper
+	Recorder Recorder
+}
+
+// NewTransport returns a Transport recording to recorder, wrapping base.
+func NewTransport(base http.RoundTripper, recorder Recorder) *Transport {
+	return &Transport{Base: base, Recorder: recorder}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	duration := time.Since(start)
+
+	if err != nil {
+		t.Recorder.Request(Endpoint(req), req.Method, STATUS_CLASS_ERROR, "", duration)
+		return resp, err
+	}
+	problemType := ""
+	if resp.StatusCode >= http.StatusBadRequest {
+		problemType = rest.ProblemType(resp)
+	}
+	t.Recorder.Request(Endpoint(req), req.Method, StatusClass(resp.StatusCode), problemType, duration)
+	return resp, nil
+}
+
+// OnRetry returns a hook for retry.Transport.OnRetry, counting the retries with recorder.
+func OnRetry(recorder Recorder) func(req *http.Request, attempt int, resp *http.Response, err error) {
+	return func(req *http.Request, attempt int, resp *http.Response, err error) {
+		recorder.Retry(Endpoint(req), req.Method)
+	}
+}
+
+// Prometheus records the measurements as metrics of a Registry:
+//
+//   - enode_sdk_requests_total{endpoint, method, status_class}
+//   - enode_sdk_request_errors_total{endpoint, method, status_class, problem_type}, for 4xx, 5xx and failed requests
+//   - enode_sdk_request_duration_seconds{endpoint, method, status_class}
+//   - enode_sdk_retries_total{endpoint, method}
+//   - enode_sdk_ratelimit_wait_seconds
+//   - enode_sdk_token_refreshes_total{result}, result being success or failure
+type Prometheus struct {
+	requests  *CounterVec
+	errors    *CounterVec
+	durations *HistogramVec
+	retries   *CounterVec
+	waits     *HistogramVec
+	refreshes *CounterVec
+}
+
+// NewPrometheus registers the metrics of the client with registry.
+func NewPrometheus(registry *Registry) *Prometheus {
+	return &Prometheus{
+		requests:  registry.Counter("enode_sdk_requests_total", "Requests sent to the Enode API.", "endpoint", "method", "status_class"),
+		errors:    registry.Counter("enode_sdk_request_errors_total", "Requests to the Enode API which failed or were answered with an error status.", "endpoint", "method", "status_class", "problem_type"),
+		durations: registry.Histogram("enode_sdk_request_duration_seconds", "Latency of requests to the Enode API.", nil, "endpoint", "method", "status_class"),
+		retries:   registry.Counter("enode_sdk_retries_total", "Requests to the Enode API sent again.", "endpoint", "method"),
+		waits:     registry.Histogram("enode_sdk_ratelimit_wait_seconds", "Time requests waited for the client side rate limiter.", nil),
+		refreshes: registry.Counter("enode_sdk_token_refreshes_total", "Refreshes of the access token.", "result"),
+	}
+}
+
+func (p *Prometheus) Request(endpoint, method, statusClass, problemType string, duration time.Duration) {
+	p.requests.Inc(endpoint, method, statusClass)
+	p.durations.Observe(duration.Seconds(), endpoint, method, statusClass)
+	if statusClass == "4xx" || statusClass == "5xx" || statusClass == STATUS_CLASS_ERROR {
+		p.errors.Inc(endpoint, method, statusClass, problemType)
+	}
+}
+
+func (p *Prometheus) Retry(endpoint, method string) {
+	p.retries.Inc(endpoint, method)
+}
+
+func (p *Prometheus) RateLimitWait(wait time.Duration) {
+	p.waits.Observe(wait.Seconds())
+}
+
+func (p *Prometheus) TokenRefresh(err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	p.refreshes.Inc(result)
+}
@@ -0,0 +1,111 @@
+package metrics_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/enodetest"
+	"github.com/addihorn/enode-gosdk/pkg/metrics"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/ratelimit"
+	"github.com/addihorn/enode-gosdk/pkg/retry"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/users"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+)
+
+func TestPrometheus(t *testing.T) {
+	server := enodetest.NewServer()
+	defer server.Close()
+	vehicle := server.AddVehicle("user_1", models.VehicleWithLocation{})
+
+	registry := metrics.NewRegistry()
+	recorder := metrics.NewPrometheus(registry)
+	authentication, err := auth.New(auth.Config{
+		ClientId:       enodetest.CLIENT_ID,
+		ClientSecret:   enodetest.CLIENT_SECRET,
+		Environment:    server.URL,
+		OnTokenRefresh: recorder.TokenRefresh,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	limited, err := ratelimit.NewTransport(metrics.NewTransport(nil, recorder), ratelimit.Limit{Requests: 300, Per: time.Minute, Burst: 10})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	limited.Limiter.OnWait = recorder.RateLimitWait
+	retrying := retry.NewTransport(limited)
+	retrying.MinBackoff = time.Millisecond
+	retrying.OnRetry = metrics.OnRetry(recorder)
+	sess := session.NewSession(authentication)
+	sess.HttpClient = &http.Client{Transport: retrying}
+
+	server.Inject(enodetest.Fault{Path: "/vehicles/", Status: http.StatusBadGateway, Times: 1})
+	if _, err := vehicles.GetVehicle(context.Background(), sess, vehicle.Id); err != nil {
+		t.Fatalf("Expected the retry to succeed, got %v", err)
+	}
+	if _, err := users.GetUser(sess, "missing"); err == nil {
+		t.Fatal("Expected an error for an unknown user")
+	}
+	if err := authentication.Refresh(context.Background(), authentication.Token()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	registry.WriteTo(&buf)
+	output := buf.String()
+	for _, expected := range []string{
+		`enode_sdk_requests_total{endpoint="/vehicles/{vehicleId}",method="GET",status_class="2xx"} 1`,
+		`enode_sdk_requests_total{endpoint="/vehicles/{vehicleId}",method="GET",status_class="5xx"} 1`,
+		`enode_sdk_request_errors_total{endpoint="/vehicles/{vehicleId}",method="GET",status_class="5xx",problem_type="https://developers.enode.com/api/problems/502"} 1`,
+		`enode_sdk_request_errors_total{endpoint="/users/{userId}",method="GET",status_class="4xx",problem_type="https://developers.enode.com/api/problems/404"} 1`,
+		`enode_sdk_request_duration_seconds_count{endpoint="/users/{userId}",method="GET",status_class="4xx"} 1`,
+		`enode_sdk_retries_total{endpoint="/vehicles/{vehicleId}",method="GET"} 1`,
+		`enode_sdk_token_refreshes_total{result="success"} 1`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %s in\n%s", expected, output)
+		}
+	}
+	if strings.Contains(output, vehicle.Id) {
+		t.Errorf("Expected no raw IDs in\n%s", output)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	registry := metrics.NewRegistry()
+	histogram := registry.Histogram("latency_seconds", "Latency.", []float64{1, 0.1}, "path")
+	gauge := registry.Gauge("level", "Battery \\ level.\nIn percent.", "id")
+	registry.Counter("unused_total", "Never incremented.")
+
+	histogram.Observe(0.05, "/a")
+	histogram.Observe(0.5, "/a")
+	histogram.Observe(5, "/a")
+	gauge.Set(80, `vehicle "1"`)
+	gauge.Set(20, "vehicle_2")
+	gauge.Delete("vehicle_2")
+
+	var buf bytes.Buffer
+	registry.WriteTo(&buf)
+	expected := `# HELP latency_seconds Latency.
+# TYPE latency_seconds histogram
+latency_seconds_bucket{path="/a",le="0.1"} 1
+latency_seconds_bucket{path="/a",le="1"} 2
+latency_seconds_bucket{path="/a",le="+Inf"} 3
+latency_seconds_sum{path="/a"} 5.55
+latency_seconds_count{path="/a"} 3
+# HELP level Battery \\ level.\nIn percent.
+# TYPE level gauge
+level{id="vehicle \"1\""} 80
+`
+	if buf.String() != expected {
+		t.Errorf("Expected\n%s\ngot\n%s", expected, buf.String())
+	}
+}
@@ -0,0 +1,171 @@
+package models
+
+import "time"
+
+// Device is implemented by the models of all device types, so devices of
+// different types can be listed and displayed together. The methods carry a
+// Device prefix, as the models use the plain names for their fields.
+type Device interface {
+	DeviceId() string
+	DeviceUserId() string
+	DeviceVendorType() VendorType
+	DeviceVendor() string
+	DeviceBrand() string
+	DeviceIsReachable() bool
+	DeviceLastSeen() time.Time
+	DeviceLocation() DeviceLocation
+	// DeviceCapabilities returns the capabilities of the device by their JSON name.
+	DeviceCapabilities() map[string]Capability
+}
+
+// DeviceLocation is where a device is, as far as it is known.
+type DeviceLocation struct {
+	// LocationId is the ID of the Location the device is assigned to or positioned at.
+	LocationId *string  `json:"locationId"`
+	Latitude   *float64 `json:"latitude"`
+	Longitude  *float64 `json:"longitude"`
+}
+
+var (
+	_ Device = Vehicle{}
+	_ Device = VehicleWithLocation{}
+	_ Device = Charger{}
+	_ Device = Hvac{}
+	_ Device = Battery{}
+	_ Device = Inverter{}
+	_ Device = Meter{}
+)
+
+func (v Vehicle) DeviceId() string             { return v.Id }
+func (v Vehicle) DeviceUserId() string         { return v.UserId }
+func (v Vehicle) DeviceVendorType() VendorType { return VENDOR_TYPE_VEHICLE }
+func (v Vehicle) DeviceVendor() string         { return string(v.Vendor) }
+func (v Vehicle) DeviceIsReachable() bool      { return v.IsReachable != nil && *v.IsReachable }
+func (v Vehicle) DeviceLastSeen() time.Time    { return v.LastSeen }
+
+func (v Vehicle) DeviceLocation() DeviceLocation {
+	return DeviceLocation{Latitude: v.Location.Latitude, Longitude: v.Location.Longitude}
+}
+
+func (v Vehicle) DeviceBrand() string {
+	if v.Information.Brand == nil {
+		return ""
+	}
+	return string(*v.Information.Brand)
+}
+
+func (v Vehicle) DeviceCapabilities() map[string]Capability {
+	return map[string]Capability{
+		"information":   v.Capabilities.Information,
+		"chargeState":   v.Capabilities.ChargeState,
+		"location":      v.Capabilities.Location,
+		"odometer":      v.Capabilities.Odometer,
+		"setMaxCurrent": v.Capabilities.SetMaxCurrent,
+		"startCharging": v.Capabilities.StartCharging,
+		"stopCharging":  v.Capabilities.StopCharging,
+		"smartCharging": v.Capabilities.SmartCharging,
+	}
+}
+
+// DeviceLocation adds the Location the vehicle is positioned at.
+func (v VehicleWithLocation) DeviceLocation() DeviceLocation {
+	location := v.Vehicle.DeviceLocation()
+	location.LocationId = v.LocationId
+	return location
+}
+
+func (c Charger) DeviceId() string               { return c.Id }
+func (c Charger) DeviceUserId() string           { return c.UserId }
+func (c Charger) DeviceVendorType() VendorType   { return VENDOR_TYPE_CHARGER }
+func (c Charger) DeviceVendor() string           { return string(c.Vendor) }
+func (c Charger) DeviceBrand() string            { return string(c.Information.Brand) }
+func (c Charger) DeviceIsReachable() bool        { return c.IsReachable }
+func (c Charger) DeviceLastSeen() time.Time      { return c.LastSeen }
+func (c Charger) DeviceLocation() DeviceLocation { return DeviceLocation{LocationId: c.LocationId} }
+
+func (c Charger) DeviceCapabilities() map[string]Capability {
+	return map[string]Capability{
+		"information":   c.Capabilities.Information,
+		"chargeState":   c.Capabilities.ChargeState,
+		"startCharging": c.Capabilities.StartCharging,
+		"stopCharging":  c.Capabilities.StopCharging,
+		"setMaxCurrent": c.Capabilities.SetMaxCurrent,
+	}
+}
+
+func (h Hvac) DeviceId() string               { return h.Id }
+func (h Hvac) DeviceUserId() string           { return h.UserId }
+func (h Hvac) DeviceVendorType() VendorType   { return VENDOR_TYPE_HVAC }
+func (h Hvac) DeviceVendor() string           { return string(h.Vendor) }
+func (h Hvac) DeviceBrand() string            { return string(h.Information.Brand) }
+func (h Hvac) DeviceIsReachable() bool        { return h.IsReachable }
+func (h Hvac) DeviceLastSeen() time.Time      { return h.LastSeen }
+func (h Hvac) DeviceLocation() DeviceLocation { return DeviceLocation{LocationId: h.LocationId} }
+
+// DeviceCapabilities returns the capabilities of the HVAC with the Capability shape,
+// the supported modes and ranges are available on Capabilities.
+func (h Hvac) DeviceCapabilities() map[string]Capability {
+	return map[string]Capability{
+		"setFollowSchedule": h.Capabilities.SetFollowSchedule,
+		"setPermanentHold":  h.Capabilities.SetPermanentHold,
+	}
+}
+
+func (b Battery) DeviceId() string             { return b.Id }
+func (b Battery) DeviceUserId() string         { return b.UserId }
+func (b Battery) DeviceVendorType() VendorType { return VENDOR_TYPE_BATTERY }
+func (b Battery) DeviceVendor() string         { return string(b.Vendor) }
+func (b Battery) DeviceBrand() string          { return string(b.Information.Brand) }
+func (b Battery) DeviceIsReachable() bool      { return b.IsReachable }
+func (b Battery) DeviceLastSeen() time.Time    { return b.LastSeen }
+
+func (b Battery) DeviceLocation() DeviceLocation {
+	return DeviceLocation{LocationId: b.LocationId, Latitude: b.Location.Latitude, Longitude: b.Location.Longitude}
+}
+
+func (b Battery) DeviceCapabilities() map[string]Capability {
+	return map[string]Capability{
+		"exportFocus":  b.Capabilities.ExportFocus,
+		"importFocus":  b.Capabilities.ImportFocus,
+		"timeOfUse":    b.Capabilities.TimeOfUse,
+		"selfReliance": b.Capabilities.SelfReliance,
+	}
+}
+
+func (i Inverter) DeviceId() string             { return i.Id }
+func (i Inverter) DeviceUserId() string         { return i.UserId }
+func (i Inverter) DeviceVendorType() VendorType { return VENDOR_TYPE_INVERTER }
+func (i Inverter) DeviceVendor() string         { return string(i.Vendor) }
+func (i Inverter) DeviceBrand() string          { return string(i.Information.Brand) }
+func (i Inverter) DeviceIsReachable() bool      { return i.IsReachable }
+func (i Inverter) DeviceLastSeen() time.Time    { return i.LastSeen }
+
+func (i Inverter) DeviceLocation() DeviceLocation {
+	return DeviceLocation{LocationId: i.ChargingLocationId, Latitude: i.Location.Latitude, Longitude: i.Location.Longitude}
+}
+
+func (i Inverter) DeviceCapabilities() map[string]Capability {
+	return map[string]Capability{
+		"productionState":      i.Capabilities.ProductionState,
+		"productionStatistics": i.Capabilities.ProductionStatistics,
+	}
+}
+
+func (m Meter) DeviceId() string             { return m.Id }
+func (m Meter) DeviceUserId() string         { return m.UserId }
+func (m Meter) DeviceVendorType() VendorType { return VENDOR_TYPE_METER }
+func (m Meter) DeviceVendor() string         { return string(m.Vendor) }
+func (m Meter) DeviceBrand() string          { return string(m.Information.Brand) }
+func (m Meter) DeviceIsReachable() bool      { return m.IsReachable }
+func (m Meter) DeviceLastSeen() time.Time    { return m.LastSeen }
+
+func (m Meter) DeviceLocation() DeviceLocation {
+	return DeviceLocation{Latitude: m.Location.Latitude, Longitude: m.Location.Longitude}
+}
+
+func (m Meter) DeviceCapabilities() map[string]Capability {
+	return map[string]Capability{
+		"measuresConsumption": m.Capabilities.MeasuresConsumption,
+		"measuresProduction":  m.Capabilities.MeasuresProduction,
+	}
+}
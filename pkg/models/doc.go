@@ -0,0 +1,7 @@
+// Package models holds the schemas of the Enode API.
+//
+// The types are generated from the OpenAPI specification in refs/ by
+// cmd/enode-gen. Run go generate after updating the specification.
+package models
+
+//go:generate go run ../../cmd/enode-gen -spec ../../refs/openapi3_1.json -out ../..
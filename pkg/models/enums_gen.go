@@ -0,0 +1,979 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package models
+
+// BatteryVendor: Machine-friendly name of the vendor. Use this in API requests.
+type BatteryVendor string
+
+const (
+	BATTERY_VENDOR_TESLA   BatteryVendor = "TESLA"
+	BATTERY_VENDOR_ENPHASE BatteryVendor = "ENPHASE"
+	BATTERY_VENDOR_HUAWEI  BatteryVendor = "HUAWEI"
+)
+
+// BatteryChargeStateStatus: The power delivery state of the battery.
+type BatteryChargeStateStatus string
+
+const (
+	BATTERY_CHARGE_STATE_STATUS_CHARGING    BatteryChargeStateStatus = "CHARGING"
+	BATTERY_CHARGE_STATE_STATUS_DISCHARGING BatteryChargeStateStatus = "DISCHARGING"
+	BATTERY_CHARGE_STATE_STATUS_IDLE        BatteryChargeStateStatus = "IDLE"
+	BATTERY_CHARGE_STATE_STATUS_FAULT       BatteryChargeStateStatus = "FAULT"
+	BATTERY_CHARGE_STATE_STATUS_UNKNOWN     BatteryChargeStateStatus = "UNKNOWN"
+)
+
+// BatteryConfigOperationMode: The current operation mode of the battery.
+//
+//   - `IMPORT_FOCUS`: Prioritizes charging the battery. Draws power from the grid and any excess solar for charging.
+//   - `EXPORT_FOCUS`: Prioritizes discharging energy stored in the battery back to the grid.
+//   - `TIME_OF_USE`: Maximizes energy cost savings in accordance with a user-defined utility rate plan. Energy may be consumed from solar, battery, or grid sources, depending on the current prices and your user's settings in the OEM app. Energy may be exported to the grid from solar or battery sources, depending on current prices and your user's settings in the OEM app.
+//   - `SELF_RELIANCE`: Minimizes household reliance on the grid. Prioritize using own energy from solar or battery for household consumption before importing from grid. Energy may be exported to the grid from solar, depending on excess solar and your user's settings in the OEM app.
+type BatteryConfigOperationMode string
+
+const (
+	BATTERY_CONFIG_OPERATION_MODE_IMPORT_FOCUS  BatteryConfigOperationMode = "IMPORT_FOCUS"
+	BATTERY_CONFIG_OPERATION_MODE_EXPORT_FOCUS  BatteryConfigOperationMode = "EXPORT_FOCUS"
+	BATTERY_CONFIG_OPERATION_MODE_TIME_OF_USE   BatteryConfigOperationMode = "TIME_OF_USE"
+	BATTERY_CONFIG_OPERATION_MODE_SELF_RELIANCE BatteryConfigOperationMode = "SELF_RELIANCE"
+)
+
+// BatteryBrand: A formatted and properly cased OEM brand name, suitable for reading by humans. May contain special characters.
+type BatteryBrand string
+
+const (
+	BATTERY_BRAND_TESLA   BatteryBrand = "Tesla"
+	BATTERY_BRAND_ENPHASE BatteryBrand = "Enphase"
+	BATTERY_BRAND_HUAWEI  BatteryBrand = "HUAWEI"
+)
+
+// SetBatteryOperationModePayloadOperationMode: Desired operation mode of the battery.
+//
+//   - `IMPORT_FOCUS`: Prioritizes charging the battery. Draws power from the grid and any excess solar for charging.
+//   - `EXPORT_FOCUS`: Prioritizes discharging energy stored in the battery back to the grid.
+//   - `TIME_OF_USE`: Maximizes energy cost savings in accordance with a user-defined utility rate plan. Energy may be consumed from solar, battery, or grid sources, depending on the current prices and your user's settings in the OEM app. Energy may be exported to the grid from solar or battery sources, depending on current prices and your user's settings in the OEM app.
+//   - `SELF_RELIANCE`: Minimizes household reliance on the grid. Prioritize using own energy from solar or battery for household consumption before importing from grid. Energy may be exported to the grid from solar, depending on excess solar and your user's settings in the OEM app.
+type SetBatteryOperationModePayloadOperationMode string
+
+const (
+	SET_BATTERY_OPERATION_MODE_PAYLOAD_OPERATION_MODE_IMPORT_FOCUS  SetBatteryOperationModePayloadOperationMode = "IMPORT_FOCUS"
+	SET_BATTERY_OPERATION_MODE_PAYLOAD_OPERATION_MODE_EXPORT_FOCUS  SetBatteryOperationModePayloadOperationMode = "EXPORT_FOCUS"
+	SET_BATTERY_OPERATION_MODE_PAYLOAD_OPERATION_MODE_TIME_OF_USE   SetBatteryOperationModePayloadOperationMode = "TIME_OF_USE"
+	SET_BATTERY_OPERATION_MODE_PAYLOAD_OPERATION_MODE_SELF_RELIANCE SetBatteryOperationModePayloadOperationMode = "SELF_RELIANCE"
+)
+
+// ActionState: The real-time status of an action executed on a target.
+//
+//   - `PENDING`: The initial state. Enode is actively sending commands and monitoring the target for changes.
+//   - `CONFIRMED`: Successful transition of the target to the desired state.
+//   - `FAILED`: The target did not respond to the action before timing out. Enode has ceased sending additional commands.
+//   - `CANCELLED`: A required precondition was not met during the action's timeout window or another action has been sent to the target, overriding this one.
+type ActionState string
+
+const (
+	ACTION_STATE_PENDING   ActionState = "PENDING"
+	ACTION_STATE_CONFIRMED ActionState = "CONFIRMED"
+	ACTION_STATE_FAILED    ActionState = "FAILED"
+	ACTION_STATE_CANCELLED ActionState = "CANCELLED"
+)
+
+type OperationModeActionTargetType string
+
+const (
+	OPERATION_MODE_ACTION_TARGET_TYPE_BATTERY OperationModeActionTargetType = "battery"
+)
+
+// ChargerVendor: Machine-friendly name of the vendor. Use this in API requests.
+type ChargerVendor string
+
+const (
+	CHARGER_VENDOR_ZAPTEC      ChargerVendor = "ZAPTEC"
+	CHARGER_VENDOR_EASEE       ChargerVendor = "EASEE"
+	CHARGER_VENDOR_WALLBOX     ChargerVendor = "WALLBOX"
+	CHARGER_VENDOR_EO          ChargerVendor = "EO"
+	CHARGER_VENDOR_CHARGEAMPS  ChargerVendor = "CHARGEAMPS"
+	CHARGER_VENDOR_EVBOX       ChargerVendor = "EVBOX"
+	CHARGER_VENDOR_GOE         ChargerVendor = "GOE"
+	CHARGER_VENDOR_FRONIUS     ChargerVendor = "FRONIUS"
+	CHARGER_VENDOR_CHARGEPOINT ChargerVendor = "CHARGEPOINT"
+	CHARGER_VENDOR_ENELX       ChargerVendor = "ENELX"
+	CHARGER_VENDOR_TESLA       ChargerVendor = "TESLA"
+	CHARGER_VENDOR_OHME        ChargerVendor = "OHME"
+)
+
+// ChargerPowerDeliveryState: The current state of power delivery between the vehicle and charger.
+//
+//   - `UNKNOWN`: The state of power delivery is currently unknown.
+//   - `UNPLUGGED`: The vehicle is not connected to the charger.
+//   - `PLUGGED_IN:INITIALIZING`: The charging station is preparing to deliver power to the vehicle. It is expected for this state to shortly transition into PLUGGED_IN:CHARGING.
+//   - `PLUGGED_IN:CHARGING`: The charger is actively delivering power to the vehicle, causing the battery level to increase.
+//   - `PLUGGED_IN:STOPPED`: The vehicle is plugged in, but the charger has been stopped. It is possible to transition into a charging state by sending a start command.
+//   - `PLUGGED_IN:NO_POWER`: The charger attempted to initialize charging, however no external power was accepted by the vehicle. It is not possible to transition into a charging state with a remote command until there is some user intervention to resolve the issue.
+//   - `PLUGGED_IN:FAULT`: A malfunction in the charging process is preventing power from being delivered. Possible causes include a charging cable not being properly locked, extreme temperatures, or malfunctions in either the charging station or the vehicle's internal system. It is not possible to transition into a charging state with a remote command until there is some user intervention to resolve the issue.
+type ChargerPowerDeliveryState string
+
+const (
+	CHARGER_POWER_DELIVERY_STATE_UNKNOWN                 ChargerPowerDeliveryState = "UNKNOWN"
+	CHARGER_POWER_DELIVERY_STATE_UNPLUGGED               ChargerPowerDeliveryState = "UNPLUGGED"
+	CHARGER_POWER_DELIVERY_STATE_PLUGGED_IN_INITIALIZING ChargerPowerDeliveryState = "PLUGGED_IN:INITIALIZING"
+	CHARGER_POWER_DELIVERY_STATE_PLUGGED_IN_CHARGING     ChargerPowerDeliveryState = "PLUGGED_IN:CHARGING"
+	CHARGER_POWER_DELIVERY_STATE_PLUGGED_IN_STOPPED      ChargerPowerDeliveryState = "PLUGGED_IN:STOPPED"
+	CHARGER_POWER_DELIVERY_STATE_PLUGGED_IN_NO_POWER     ChargerPowerDeliveryState = "PLUGGED_IN:NO_POWER"
+	CHARGER_POWER_DELIVERY_STATE_PLUGGED_IN_FAULT        ChargerPowerDeliveryState = "PLUGGED_IN:FAULT"
+)
+
+// ChargerBrand: A formatted and properly cased OEM brand name, suitable for reading by humans. May contain special characters.
+type ChargerBrand string
+
+const (
+	CHARGER_BRAND_ZAPTEC      ChargerBrand = "Zaptec"
+	CHARGER_BRAND_EASEE       ChargerBrand = "Easee"
+	CHARGER_BRAND_WALLBOX     ChargerBrand = "Wallbox"
+	CHARGER_BRAND_EO          ChargerBrand = "EO"
+	CHARGER_BRAND_EVBOX       ChargerBrand = "EVBox"
+	CHARGER_BRAND_CHARGE_AMPS ChargerBrand = "Charge Amps"
+	CHARGER_BRAND_GO_E        ChargerBrand = "go-e"
+	CHARGER_BRAND_FRONIUS     ChargerBrand = "Fronius"
+	CHARGER_BRAND_CHARGEPOINT ChargerBrand = "ChargePoint"
+	CHARGER_BRAND_ENEL_X      ChargerBrand = "Enel X"
+	CHARGER_BRAND_TESLA       ChargerBrand = "Tesla"
+	CHARGER_BRAND_OHME        ChargerBrand = "Ohme"
+)
+
+type Scopes string
+
+const (
+	SCOPES_BATTERY_CONTROL_OPERATION_MODE Scopes = "battery:control:operation_mode"
+	SCOPES_BATTERY_READ_DATA              Scopes = "battery:read:data"
+	SCOPES_BATTERY_READ_LOCATION          Scopes = "battery:read:location"
+	SCOPES_CHARGER_CONTROL_CHARGING       Scopes = "charger:control:charging"
+	SCOPES_CHARGER_READ_DATA              Scopes = "charger:read:data"
+	SCOPES_HVAC_CONTROL_MODE              Scopes = "hvac:control:mode"
+	SCOPES_HVAC_READ_DATA                 Scopes = "hvac:read:data"
+	SCOPES_INVERTER_READ_DATA             Scopes = "inverter:read:data"
+	SCOPES_INVERTER_READ_LOCATION         Scopes = "inverter:read:location"
+	SCOPES_METER_READ_DATA                Scopes = "meter:read:data"
+	SCOPES_METER_READ_LOCATION            Scopes = "meter:read:location"
+	SCOPES_VEHICLE_CONTROL_CHARGING       Scopes = "vehicle:control:charging"
+	SCOPES_VEHICLE_READ_DATA              Scopes = "vehicle:read:data"
+	SCOPES_VEHICLE_READ_LOCATION          Scopes = "vehicle:read:location"
+)
+
+type ChargingAction string
+
+const (
+	CHARGING_ACTION_START ChargingAction = "START"
+	CHARGING_ACTION_STOP  ChargingAction = "STOP"
+)
+
+type ChargeableVendorType string
+
+const (
+	CHARGEABLE_VENDOR_TYPE_VEHICLE ChargeableVendorType = "vehicle"
+	CHARGEABLE_VENDOR_TYPE_CHARGER ChargeableVendorType = "charger"
+)
+
+// ChargingActionFailureReasonType: A machine-readable high level error category.
+//
+//   - `NO_RESPONSE`: The chargeable device did not react to our charge commands within the action's timeout window.
+//   - `FAILED_PRECONDITION`: The chargeable device did not meet all required preconditions for this action to be executed during the action's timeout window.
+//   - `UNNECESSARY`: The action was not carried out given that the device was already in the desired state.
+//   - `CONFLICT`: A newer action for this chargeable has been created. This action is now abandoned.
+//   - `REQUESTED_CANCELLATION`: This action was cancelled by request of the controlling owner. The controlling owner may refer to another Enode entity which initiated the command, such as a schedule or smart override.
+//   - `NOT_FOUND`: The chargeable was deleted while the action was `PENDING`.
+type ChargingActionFailureReasonType string
+
+const (
+	CHARGING_ACTION_FAILURE_REASON_TYPE_NO_RESPONSE            ChargingActionFailureReasonType = "NO_RESPONSE"
+	CHARGING_ACTION_FAILURE_REASON_TYPE_FAILED_PRECONDITION    ChargingActionFailureReasonType = "FAILED_PRECONDITION"
+	CHARGING_ACTION_FAILURE_REASON_TYPE_CONFLICT               ChargingActionFailureReasonType = "CONFLICT"
+	CHARGING_ACTION_FAILURE_REASON_TYPE_NOT_FOUND              ChargingActionFailureReasonType = "NOT_FOUND"
+	CHARGING_ACTION_FAILURE_REASON_TYPE_UNNECESSARY            ChargingActionFailureReasonType = "UNNECESSARY"
+	CHARGING_ACTION_FAILURE_REASON_TYPE_REQUESTED_CANCELLATION ChargingActionFailureReasonType = "REQUESTED_CANCELLATION"
+)
+
+// VehicleVendor: Machine-friendly name of the vendor. Use this in API requests.
+type VehicleVendor string
+
+const (
+	VEHICLE_VENDOR_AUDI       VehicleVendor = "AUDI"
+	VEHICLE_VENDOR_BMW        VehicleVendor = "BMW"
+	VEHICLE_VENDOR_HONDA      VehicleVendor = "HONDA"
+	VEHICLE_VENDOR_HYUNDAI    VehicleVendor = "HYUNDAI"
+	VEHICLE_VENDOR_JAGUAR     VehicleVendor = "JAGUAR"
+	VEHICLE_VENDOR_LANDROVER  VehicleVendor = "LANDROVER"
+	VEHICLE_VENDOR_KIA        VehicleVendor = "KIA"
+	VEHICLE_VENDOR_MERCEDES   VehicleVendor = "MERCEDES"
+	VEHICLE_VENDOR_MINI       VehicleVendor = "MINI"
+	VEHICLE_VENDOR_NISSAN     VehicleVendor = "NISSAN"
+	VEHICLE_VENDOR_PEUGEOT    VehicleVendor = "PEUGEOT"
+	VEHICLE_VENDOR_PORSCHE    VehicleVendor = "PORSCHE"
+	VEHICLE_VENDOR_RENAULT    VehicleVendor = "RENAULT"
+	VEHICLE_VENDOR_SEAT       VehicleVendor = "SEAT"
+	VEHICLE_VENDOR_SKODA      VehicleVendor = "SKODA"
+	VEHICLE_VENDOR_TESLA      VehicleVendor = "TESLA"
+	VEHICLE_VENDOR_VOLKSWAGEN VehicleVendor = "VOLKSWAGEN"
+	VEHICLE_VENDOR_VOLVO      VehicleVendor = "VOLVO"
+	VEHICLE_VENDOR_FORD       VehicleVendor = "FORD"
+	VEHICLE_VENDOR_OPEL       VehicleVendor = "OPEL"
+	VEHICLE_VENDOR_DS         VehicleVendor = "DS"
+	VEHICLE_VENDOR_TOYOTA     VehicleVendor = "TOYOTA"
+	VEHICLE_VENDOR_LEXUS      VehicleVendor = "LEXUS"
+	VEHICLE_VENDOR_CITROEN    VehicleVendor = "CITROEN"
+	VEHICLE_VENDOR_CUPRA      VehicleVendor = "CUPRA"
+	VEHICLE_VENDOR_VAUXHALL   VehicleVendor = "VAUXHALL"
+	VEHICLE_VENDOR_FIAT       VehicleVendor = "FIAT"
+	VEHICLE_VENDOR_RIVIAN     VehicleVendor = "RIVIAN"
+	VEHICLE_VENDOR_NIO        VehicleVendor = "NIO"
+	VEHICLE_VENDOR_CHEVROLET  VehicleVendor = "CHEVROLET"
+	VEHICLE_VENDOR_GMC        VehicleVendor = "GMC"
+	VEHICLE_VENDOR_CADILLAC   VehicleVendor = "CADILLAC"
+	VEHICLE_VENDOR_XPENG      VehicleVendor = "XPENG"
+)
+
+type ChargeableVendor string
+
+const (
+	CHARGEABLE_VENDOR_AUDI        ChargeableVendor = "AUDI"
+	CHARGEABLE_VENDOR_BMW         ChargeableVendor = "BMW"
+	CHARGEABLE_VENDOR_HONDA       ChargeableVendor = "HONDA"
+	CHARGEABLE_VENDOR_HYUNDAI     ChargeableVendor = "HYUNDAI"
+	CHARGEABLE_VENDOR_JAGUAR      ChargeableVendor = "JAGUAR"
+	CHARGEABLE_VENDOR_LANDROVER   ChargeableVendor = "LANDROVER"
+	CHARGEABLE_VENDOR_KIA         ChargeableVendor = "KIA"
+	CHARGEABLE_VENDOR_MERCEDES    ChargeableVendor = "MERCEDES"
+	CHARGEABLE_VENDOR_MINI        ChargeableVendor = "MINI"
+	CHARGEABLE_VENDOR_NISSAN      ChargeableVendor = "NISSAN"
+	CHARGEABLE_VENDOR_PEUGEOT     ChargeableVendor = "PEUGEOT"
+	CHARGEABLE_VENDOR_PORSCHE     ChargeableVendor = "PORSCHE"
+	CHARGEABLE_VENDOR_RENAULT     ChargeableVendor = "RENAULT"
+	CHARGEABLE_VENDOR_SEAT        ChargeableVendor = "SEAT"
+	CHARGEABLE_VENDOR_SKODA       ChargeableVendor = "SKODA"
+	CHARGEABLE_VENDOR_TESLA       ChargeableVendor = "TESLA"
+	CHARGEABLE_VENDOR_VOLKSWAGEN  ChargeableVendor = "VOLKSWAGEN"
+	CHARGEABLE_VENDOR_VOLVO       ChargeableVendor = "VOLVO"
+	CHARGEABLE_VENDOR_FORD        ChargeableVendor = "FORD"
+	CHARGEABLE_VENDOR_OPEL        ChargeableVendor = "OPEL"
+	CHARGEABLE_VENDOR_DS          ChargeableVendor = "DS"
+	CHARGEABLE_VENDOR_TOYOTA      ChargeableVendor = "TOYOTA"
+	CHARGEABLE_VENDOR_LEXUS       ChargeableVendor = "LEXUS"
+	CHARGEABLE_VENDOR_CITROEN     ChargeableVendor = "CITROEN"
+	CHARGEABLE_VENDOR_CUPRA       ChargeableVendor = "CUPRA"
+	CHARGEABLE_VENDOR_VAUXHALL    ChargeableVendor = "VAUXHALL"
+	CHARGEABLE_VENDOR_FIAT        ChargeableVendor = "FIAT"
+	CHARGEABLE_VENDOR_RIVIAN      ChargeableVendor = "RIVIAN"
+	CHARGEABLE_VENDOR_NIO         ChargeableVendor = "NIO"
+	CHARGEABLE_VENDOR_CHEVROLET   ChargeableVendor = "CHEVROLET"
+	CHARGEABLE_VENDOR_GMC         ChargeableVendor = "GMC"
+	CHARGEABLE_VENDOR_CADILLAC    ChargeableVendor = "CADILLAC"
+	CHARGEABLE_VENDOR_XPENG       ChargeableVendor = "XPENG"
+	CHARGEABLE_VENDOR_ZAPTEC      ChargeableVendor = "ZAPTEC"
+	CHARGEABLE_VENDOR_EASEE       ChargeableVendor = "EASEE"
+	CHARGEABLE_VENDOR_WALLBOX     ChargeableVendor = "WALLBOX"
+	CHARGEABLE_VENDOR_EO          ChargeableVendor = "EO"
+	CHARGEABLE_VENDOR_CHARGEAMPS  ChargeableVendor = "CHARGEAMPS"
+	CHARGEABLE_VENDOR_EVBOX       ChargeableVendor = "EVBOX"
+	CHARGEABLE_VENDOR_GOE         ChargeableVendor = "GOE"
+	CHARGEABLE_VENDOR_FRONIUS     ChargeableVendor = "FRONIUS"
+	CHARGEABLE_VENDOR_CHARGEPOINT ChargeableVendor = "CHARGEPOINT"
+	CHARGEABLE_VENDOR_ENELX       ChargeableVendor = "ENELX"
+	CHARGEABLE_VENDOR_OHME        ChargeableVendor = "OHME"
+	CHARGEABLE_VENDOR_ENPHASE     ChargeableVendor = "ENPHASE"
+	CHARGEABLE_VENDOR_HUAWEI      ChargeableVendor = "HUAWEI"
+)
+
+type ChargerOnlySmartOverrideResponseTargetType string
+
+const (
+	CHARGER_ONLY_SMART_OVERRIDE_RESPONSE_TARGET_TYPE_CHARGER ChargerOnlySmartOverrideResponseTargetType = "charger"
+)
+
+// ChargerState: An enum value that describes the current smart charging state of the charger. Every charger is in exactly one state, at all times
+type ChargerState string
+
+const (
+	CHARGER_STATE_DISABLED               ChargerState = "DISABLED"
+	CHARGER_STATE_CHARGER_NOT_REACHABLE  ChargerState = "CHARGER_NOT_REACHABLE"
+	CHARGER_STATE_VEHICLE_NOT_PLUGGED_IN ChargerState = "VEHICLE_NOT_PLUGGED_IN"
+	CHARGER_STATE_CHARGING_PAUSED        ChargerState = "CHARGING_PAUSED"
+	CHARGER_STATE_CHARGING               ChargerState = "CHARGING"
+	CHARGER_STATE_AWAITING_PRICES        ChargerState = "AWAITING_PRICES"
+)
+
+type ChargerSmartChargingIntervalsItemStatus string
+
+const (
+	CHARGER_SMART_CHARGING_INTERVALS_ITEM_STATUS_IN_PROGRESS ChargerSmartChargingIntervalsItemStatus = "IN_PROGRESS"
+	CHARGER_SMART_CHARGING_INTERVALS_ITEM_STATUS_COMPLETED   ChargerSmartChargingIntervalsItemStatus = "COMPLETED"
+	CHARGER_SMART_CHARGING_INTERVALS_ITEM_STATUS_PLANNED     ChargerSmartChargingIntervalsItemStatus = "PLANNED"
+)
+
+// HvacVendor: Machine-friendly name of the vendor. Use this in API requests.
+type HvacVendor string
+
+const (
+	HVAC_VENDOR_TADO       HvacVendor = "TADO"
+	HVAC_VENDOR_MILL       HvacVendor = "MILL"
+	HVAC_VENDOR_ADAX       HvacVendor = "ADAX"
+	HVAC_VENDOR_ECOBEE     HvacVendor = "ECOBEE"
+	HVAC_VENDOR_SENSIBO    HvacVendor = "SENSIBO"
+	HVAC_VENDOR_HONEYWELL  HvacVendor = "HONEYWELL"
+	HVAC_VENDOR_RESIDEO    HvacVendor = "RESIDEO"
+	HVAC_VENDOR_MITSUBISHI HvacVendor = "MITSUBISHI"
+	HVAC_VENDOR_MICROMATIC HvacVendor = "MICROMATIC"
+	HVAC_VENDOR_NIBE       HvacVendor = "NIBE"
+	HVAC_VENDOR_PANASONIC  HvacVendor = "PANASONIC"
+	HVAC_VENDOR_TOSHIBA    HvacVendor = "TOSHIBA"
+	HVAC_VENDOR_DAIKIN     HvacVendor = "DAIKIN"
+	HVAC_VENDOR_NEST       HvacVendor = "NEST"
+	HVAC_VENDOR_FUJITSU    HvacVendor = "FUJITSU"
+	HVAC_VENDOR_BOSCH      HvacVendor = "BOSCH"
+	HVAC_VENDOR_NETATMO    HvacVendor = "NETATMO"
+)
+
+// HvacBrand: A formatted and properly cased OEM brand name, suitable for reading by humans. May contain special characters.
+type HvacBrand string
+
+const (
+	HVAC_BRAND_TADO          HvacBrand = "Tado"
+	HVAC_BRAND_MILL          HvacBrand = "Mill"
+	HVAC_BRAND_ADAX          HvacBrand = "ADAX"
+	HVAC_BRAND_ECOBEE        HvacBrand = "Ecobee"
+	HVAC_BRAND_SENSIBO       HvacBrand = "Sensibo"
+	HVAC_BRAND_HONEYWELL_TCC HvacBrand = "Honeywell TCC"
+	HVAC_BRAND_RESIDEO       HvacBrand = "Resideo"
+	HVAC_BRAND_MITSUBISHI    HvacBrand = "Mitsubishi"
+	HVAC_BRAND_MICRO_MATIC   HvacBrand = "Micro Matic"
+	HVAC_BRAND_NIBE          HvacBrand = "NIBE"
+	HVAC_BRAND_PANASONIC     HvacBrand = "Panasonic"
+	HVAC_BRAND_TOSHIBA       HvacBrand = "Toshiba"
+	HVAC_BRAND_DAIKIN        HvacBrand = "DAIKIN"
+	HVAC_BRAND_NEST          HvacBrand = "Nest"
+	HVAC_BRAND_FUJITSU       HvacBrand = "Fujitsu"
+	HVAC_BRAND_BOSCH         HvacBrand = "Bosch"
+	HVAC_BRAND_NETATMO       HvacBrand = "Netatmo"
+)
+
+type HvacMode string
+
+const (
+	HVAC_MODE_OFF  HvacMode = "OFF"
+	HVAC_MODE_AUTO HvacMode = "AUTO"
+	HVAC_MODE_COOL HvacMode = "COOL"
+	HVAC_MODE_HEAT HvacMode = "HEAT"
+)
+
+type HvacHoldType string
+
+const (
+	HVAC_HOLD_TYPE_PERMANENT HvacHoldType = "PERMANENT"
+	HVAC_HOLD_TYPE_SCHEDULED HvacHoldType = "SCHEDULED"
+)
+
+// HvacInformationCategory: HVAC category
+type HvacInformationCategory string
+
+const (
+	HVAC_INFORMATION_CATEGORY_HEATING    HvacInformationCategory = "HEATING"
+	HVAC_INFORMATION_CATEGORY_COOLING    HvacInformationCategory = "COOLING"
+	HVAC_INFORMATION_CATEGORY_HEAT_PUMP  HvacInformationCategory = "HEAT_PUMP"
+	HVAC_INFORMATION_CATEGORY_AGGREGATOR HvacInformationCategory = "AGGREGATOR"
+)
+
+type CoolTargetStateMode string
+
+const (
+	COOL_TARGET_STATE_MODE_COOL CoolTargetStateMode = "COOL"
+)
+
+type PermanentHoldType string
+
+const (
+	PERMANENT_HOLD_TYPE_PERMANENT PermanentHoldType = "PERMANENT"
+)
+
+type HeatTargetStateMode string
+
+const (
+	HEAT_TARGET_STATE_MODE_HEAT HeatTargetStateMode = "HEAT"
+)
+
+type AutoTargetStateMode string
+
+const (
+	AUTO_TARGET_STATE_MODE_AUTO AutoTargetStateMode = "AUTO"
+)
+
+type OffTargetStateMode string
+
+const (
+	OFF_TARGET_STATE_MODE_OFF OffTargetStateMode = "OFF"
+)
+
+type HvacTargetType string
+
+const (
+	HVAC_TARGET_TYPE_HVAC HvacTargetType = "hvac"
+)
+
+type ScheduledTargetStateHoldType string
+
+const (
+	SCHEDULED_TARGET_STATE_HOLD_TYPE_SCHEDULED ScheduledTargetStateHoldType = "SCHEDULED"
+)
+
+type VendorType string
+
+const (
+	VENDOR_TYPE_VEHICLE  VendorType = "vehicle"
+	VENDOR_TYPE_CHARGER  VendorType = "charger"
+	VENDOR_TYPE_HVAC     VendorType = "hvac"
+	VENDOR_TYPE_INVERTER VendorType = "inverter"
+	VENDOR_TYPE_BATTERY  VendorType = "battery"
+	VENDOR_TYPE_METER    VendorType = "meter"
+)
+
+// InverterVendor: Machine-friendly name of the vendor. Use this in API requests.
+type InverterVendor string
+
+const (
+	INVERTER_VENDOR_APSYSTEMS InverterVendor = "APSYSTEMS"
+	INVERTER_VENDOR_CSISOLAR  InverterVendor = "CSISolar"
+	INVERTER_VENDOR_DEYE      InverterVendor = "Deye"
+	INVERTER_VENDOR_ENPHASE   InverterVendor = "ENPHASE"
+	INVERTER_VENDOR_FOXESS    InverterVendor = "FOXESS"
+	INVERTER_VENDOR_FRONIUS   InverterVendor = "FRONIUS"
+	INVERTER_VENDOR_GOODWE    InverterVendor = "GOODWE"
+	INVERTER_VENDOR_GROWATT   InverterVendor = "GROWATT"
+	INVERTER_VENDOR_HOYMILES  InverterVendor = "Hoymiles"
+	INVERTER_VENDOR_HUAWEI    InverterVendor = "HUAWEI"
+	INVERTER_VENDOR_INVT      InverterVendor = "INVT"
+	INVERTER_VENDOR_SMA       InverterVendor = "SMA"
+	INVERTER_VENDOR_SOFAR     InverterVendor = "SOFAR"
+	INVERTER_VENDOR_SOLAREDGE InverterVendor = "SOLAREDGE"
+	INVERTER_VENDOR_SOLARK    InverterVendor = "SOLARK"
+	INVERTER_VENDOR_SOLAX     InverterVendor = "SOLAX"
+	INVERTER_VENDOR_SOLIS     InverterVendor = "SOLIS"
+	INVERTER_VENDOR_SOLPLANET InverterVendor = "SOLPLANET"
+	INVERTER_VENDOR_SUNGROW   InverterVendor = "SUNGROW"
+	INVERTER_VENDOR_SUNSYNK   InverterVendor = "SUNSYNK"
+	INVERTER_VENDOR_TESLA     InverterVendor = "TESLA"
+	INVERTER_VENDOR_TSUN      InverterVendor = "TSUN"
+)
+
+// MeterVendor: Machine-friendly name of the vendor. Use this in API requests.
+type MeterVendor string
+
+const (
+	METER_VENDOR_ENPHASE MeterVendor = "ENPHASE"
+	METER_VENDOR_HUAWEI  MeterVendor = "HUAWEI"
+	METER_VENDOR_TESLA   MeterVendor = "TESLA"
+)
+
+// InverterBrand: A formatted and properly cased OEM brand name, suitable for reading by humans. May contain special characters.
+type InverterBrand string
+
+const (
+	INVERTER_BRAND_APSYSTEMS InverterBrand = "APsystems"
+	INVERTER_BRAND_CSISOLAR  InverterBrand = "CSISolar"
+	INVERTER_BRAND_DEYE      InverterBrand = "Deye"
+	INVERTER_BRAND_ENPHASE   InverterBrand = "Enphase"
+	INVERTER_BRAND_FOXESS    InverterBrand = "FOXESS"
+	INVERTER_BRAND_FRONIUS   InverterBrand = "Fronius"
+	INVERTER_BRAND_GOODWE    InverterBrand = "GoodWe"
+	INVERTER_BRAND_GROWATT   InverterBrand = "Growatt"
+	INVERTER_BRAND_HOYMILES  InverterBrand = "Hoymiles"
+	INVERTER_BRAND_HUAWEI    InverterBrand = "Huawei"
+	INVERTER_BRAND_INVT      InverterBrand = "INVT"
+	INVERTER_BRAND_SMA       InverterBrand = "SMA"
+	INVERTER_BRAND_SOFAR     InverterBrand = "Sofar"
+	INVERTER_BRAND_SOLAREDGE InverterBrand = "SolarEdge"
+	INVERTER_BRAND_SOLARK    InverterBrand = "SolArk"
+	INVERTER_BRAND_SOLAX     InverterBrand = "Solax"
+	INVERTER_BRAND_SOLIS     InverterBrand = "Solis"
+	INVERTER_BRAND_SOLPLANET InverterBrand = "Solplanet"
+	INVERTER_BRAND_SUNGROW   InverterBrand = "Sungrow"
+	INVERTER_BRAND_SUNSYNK   InverterBrand = "SUNSYNK"
+	INVERTER_BRAND_TESLA     InverterBrand = "Tesla"
+	INVERTER_BRAND_TSUN      InverterBrand = "TSUN"
+)
+
+// VehicleBrand: A formatted and properly cased OEM brand name, suitable for reading by humans. May contain special characters.
+type VehicleBrand string
+
+const (
+	VEHICLE_BRAND_AUDI       VehicleBrand = "Audi"
+	VEHICLE_BRAND_BMW        VehicleBrand = "BMW"
+	VEHICLE_BRAND_HONDA      VehicleBrand = "Honda"
+	VEHICLE_BRAND_HYUNDAI    VehicleBrand = "Hyundai"
+	VEHICLE_BRAND_JAGUAR     VehicleBrand = "Jaguar"
+	VEHICLE_BRAND_LAND_ROVER VehicleBrand = "Land Rover"
+	VEHICLE_BRAND_KIA        VehicleBrand = "Kia"
+	VEHICLE_BRAND_MERCEDES   VehicleBrand = "Mercedes"
+	VEHICLE_BRAND_MINI       VehicleBrand = "MINI"
+	VEHICLE_BRAND_NISSAN     VehicleBrand = "Nissan"
+	VEHICLE_BRAND_PEUGEOT    VehicleBrand = "Peugeot"
+	VEHICLE_BRAND_PORSCHE    VehicleBrand = "Porsche"
+	VEHICLE_BRAND_RENAULT    VehicleBrand = "Renault"
+	VEHICLE_BRAND_SEAT       VehicleBrand = "SEAT"
+	VEHICLE_BRAND_ŠKODA      VehicleBrand = "ŠKODA"
+	VEHICLE_BRAND_TESLA      VehicleBrand = "Tesla"
+	VEHICLE_BRAND_VOLKSWAGEN VehicleBrand = "Volkswagen"
+	VEHICLE_BRAND_VOLVO      VehicleBrand = "Volvo"
+	VEHICLE_BRAND_FORD       VehicleBrand = "Ford"
+	VEHICLE_BRAND_OPEL       VehicleBrand = "Opel"
+	VEHICLE_BRAND_DS         VehicleBrand = "DS"
+	VEHICLE_BRAND_TOYOTA     VehicleBrand = "Toyota"
+	VEHICLE_BRAND_LEXUS      VehicleBrand = "Lexus"
+	VEHICLE_BRAND_CITROËN    VehicleBrand = "Citroën"
+	VEHICLE_BRAND_CUPRA      VehicleBrand = "Cupra"
+	VEHICLE_BRAND_VAUXHALL   VehicleBrand = "Vauxhall"
+	VEHICLE_BRAND_FIAT       VehicleBrand = "Fiat"
+	VEHICLE_BRAND_RIVIAN     VehicleBrand = "Rivian"
+	VEHICLE_BRAND_NIO        VehicleBrand = "Nio"
+	VEHICLE_BRAND_CHEVROLET  VehicleBrand = "Chevrolet"
+	VEHICLE_BRAND_GMC        VehicleBrand = "GMC"
+	VEHICLE_BRAND_CADILLAC   VehicleBrand = "Cadillac"
+	VEHICLE_BRAND_XPENG      VehicleBrand = "XPENG"
+)
+
+// MeterBrand: A formatted and properly cased OEM brand name, suitable for reading by humans. May contain special characters.
+type MeterBrand string
+
+const (
+	METER_BRAND_ENPHASE MeterBrand = "Enphase"
+	METER_BRAND_HUAWEI  MeterBrand = "Huawei"
+	METER_BRAND_TESLA   MeterBrand = "Tesla"
+)
+
+// Vendor: Vendor to be unlinked.
+type Vendor string
+
+const (
+	VENDOR_APSYSTEMS   Vendor = "APSYSTEMS"
+	VENDOR_CSISOLAR    Vendor = "CSISolar"
+	VENDOR_DEYE        Vendor = "Deye"
+	VENDOR_ENPHASE     Vendor = "ENPHASE"
+	VENDOR_FOXESS      Vendor = "FOXESS"
+	VENDOR_FRONIUS     Vendor = "FRONIUS"
+	VENDOR_GOODWE      Vendor = "GOODWE"
+	VENDOR_GROWATT     Vendor = "GROWATT"
+	VENDOR_HOYMILES    Vendor = "Hoymiles"
+	VENDOR_HUAWEI      Vendor = "HUAWEI"
+	VENDOR_INVT        Vendor = "INVT"
+	VENDOR_SMA         Vendor = "SMA"
+	VENDOR_SOFAR       Vendor = "SOFAR"
+	VENDOR_SOLAREDGE   Vendor = "SOLAREDGE"
+	VENDOR_SOLARK      Vendor = "SOLARK"
+	VENDOR_SOLAX       Vendor = "SOLAX"
+	VENDOR_SOLIS       Vendor = "SOLIS"
+	VENDOR_SOLPLANET   Vendor = "SOLPLANET"
+	VENDOR_SUNGROW     Vendor = "SUNGROW"
+	VENDOR_SUNSYNK     Vendor = "SUNSYNK"
+	VENDOR_TESLA       Vendor = "TESLA"
+	VENDOR_TSUN        Vendor = "TSUN"
+	VENDOR_AUDI        Vendor = "AUDI"
+	VENDOR_BMW         Vendor = "BMW"
+	VENDOR_HONDA       Vendor = "HONDA"
+	VENDOR_HYUNDAI     Vendor = "HYUNDAI"
+	VENDOR_JAGUAR      Vendor = "JAGUAR"
+	VENDOR_LANDROVER   Vendor = "LANDROVER"
+	VENDOR_KIA         Vendor = "KIA"
+	VENDOR_MERCEDES    Vendor = "MERCEDES"
+	VENDOR_MINI        Vendor = "MINI"
+	VENDOR_NISSAN      Vendor = "NISSAN"
+	VENDOR_PEUGEOT     Vendor = "PEUGEOT"
+	VENDOR_PORSCHE     Vendor = "PORSCHE"
+	VENDOR_RENAULT     Vendor = "RENAULT"
+	VENDOR_SEAT        Vendor = "SEAT"
+	VENDOR_SKODA       Vendor = "SKODA"
+	VENDOR_VOLKSWAGEN  Vendor = "VOLKSWAGEN"
+	VENDOR_VOLVO       Vendor = "VOLVO"
+	VENDOR_FORD        Vendor = "FORD"
+	VENDOR_OPEL        Vendor = "OPEL"
+	VENDOR_DS          Vendor = "DS"
+	VENDOR_TOYOTA      Vendor = "TOYOTA"
+	VENDOR_LEXUS       Vendor = "LEXUS"
+	VENDOR_CITROEN     Vendor = "CITROEN"
+	VENDOR_CUPRA       Vendor = "CUPRA"
+	VENDOR_VAUXHALL    Vendor = "VAUXHALL"
+	VENDOR_FIAT        Vendor = "FIAT"
+	VENDOR_RIVIAN      Vendor = "RIVIAN"
+	VENDOR_NIO         Vendor = "NIO"
+	VENDOR_CHEVROLET   Vendor = "CHEVROLET"
+	VENDOR_GMC         Vendor = "GMC"
+	VENDOR_CADILLAC    Vendor = "CADILLAC"
+	VENDOR_XPENG       Vendor = "XPENG"
+	VENDOR_TADO        Vendor = "TADO"
+	VENDOR_MILL        Vendor = "MILL"
+	VENDOR_ADAX        Vendor = "ADAX"
+	VENDOR_ECOBEE      Vendor = "ECOBEE"
+	VENDOR_SENSIBO     Vendor = "SENSIBO"
+	VENDOR_HONEYWELL   Vendor = "HONEYWELL"
+	VENDOR_RESIDEO     Vendor = "RESIDEO"
+	VENDOR_MITSUBISHI  Vendor = "MITSUBISHI"
+	VENDOR_MICROMATIC  Vendor = "MICROMATIC"
+	VENDOR_NIBE        Vendor = "NIBE"
+	VENDOR_PANASONIC   Vendor = "PANASONIC"
+	VENDOR_TOSHIBA     Vendor = "TOSHIBA"
+	VENDOR_DAIKIN      Vendor = "DAIKIN"
+	VENDOR_NEST        Vendor = "NEST"
+	VENDOR_FUJITSU     Vendor = "FUJITSU"
+	VENDOR_BOSCH       Vendor = "BOSCH"
+	VENDOR_NETATMO     Vendor = "NETATMO"
+	VENDOR_ZAPTEC      Vendor = "ZAPTEC"
+	VENDOR_EASEE       Vendor = "EASEE"
+	VENDOR_WALLBOX     Vendor = "WALLBOX"
+	VENDOR_EO          Vendor = "EO"
+	VENDOR_CHARGEAMPS  Vendor = "CHARGEAMPS"
+	VENDOR_EVBOX       Vendor = "EVBOX"
+	VENDOR_GOE         Vendor = "GOE"
+	VENDOR_CHARGEPOINT Vendor = "CHARGEPOINT"
+	VENDOR_ENELX       Vendor = "ENELX"
+	VENDOR_OHME        Vendor = "OHME"
+)
+
+// InterventionDomain: The domain the intervention is related to. i.e. Is the intervention related to the vendor service account or a setting on the device.
+type InterventionDomain string
+
+const (
+	INTERVENTION_DOMAIN_ACCOUNT InterventionDomain = "Account"
+	INTERVENTION_DOMAIN_DEVICE  InterventionDomain = "Device"
+)
+
+// InterventionResolutionAccess: Where the intervention needs to be resolved. i.e. Remotely using the vendor's app or directly on the device.
+type InterventionResolutionAccess string
+
+const (
+	INTERVENTION_RESOLUTION_ACCESS_REMOTE   InterventionResolutionAccess = "Remote"
+	INTERVENTION_RESOLUTION_ACCESS_PHYSICAL InterventionResolutionAccess = "Physical"
+)
+
+// InterventionResolutionAgent: Who can resolve the intervention. i.e. A user can resolve the intervention themselves, or a licensed service retailer is needed.
+type InterventionResolutionAgent string
+
+const (
+	INTERVENTION_RESOLUTION_AGENT_USER       InterventionResolutionAgent = "User"
+	INTERVENTION_RESOLUTION_AGENT_THIRDPARTY InterventionResolutionAgent = "ThirdParty"
+)
+
+// ScheduleState: An enum value that describes the current state of the Schedule
+type ScheduleState string
+
+const (
+	SCHEDULE_STATE_ALIGNED             ScheduleState = "ALIGNED"
+	SCHEDULE_STATE_MISALIGNED          ScheduleState = "MISALIGNED"
+	SCHEDULE_STATE_PENDING             ScheduleState = "PENDING"
+	SCHEDULE_STATE_INACTIVE_OVERRIDDEN ScheduleState = "INACTIVE:OVERRIDDEN"
+	SCHEDULE_STATE_INACTIVE_DISABLED   ScheduleState = "INACTIVE:DISABLED"
+	SCHEDULE_STATE_INACTIVE_AWAY       ScheduleState = "INACTIVE:AWAY"
+	SCHEDULE_STATE_INACTIVE_INCAPABLE  ScheduleState = "INACTIVE:INCAPABLE"
+)
+
+type ChargeScheduleStatusScheduleType string
+
+const (
+	CHARGE_SCHEDULE_STATUS_SCHEDULE_TYPE_CHARGE ChargeScheduleStatusScheduleType = "CHARGE"
+)
+
+type TemperatureScheduleStatusScheduleType string
+
+const (
+	TEMPERATURE_SCHEDULE_STATUS_SCHEDULE_TYPE_TEMPERATURE TemperatureScheduleStatusScheduleType = "TEMPERATURE"
+)
+
+// InverterStatisticsBucketsUnit: The unit of measurement for power production data. Always kWh.
+type InverterStatisticsBucketsUnit string
+
+const (
+	INVERTER_STATISTICS_BUCKETS_UNIT_KWH InverterStatisticsBucketsUnit = "kWh"
+)
+
+// LinkUserPayloadLanguage: Sets the language for Link UI. We recommend matching this to the language of your application. If your language is not yet supported, you can specify `browser` which will assess your user's browser language via the `Accept-Language` header ([learn more](https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Accept-Language)), and falls back to `en-US` if no match is found.
+type LinkUserPayloadLanguage string
+
+const (
+	LINK_USER_PAYLOAD_LANGUAGE_BROWSER LinkUserPayloadLanguage = "browser"
+	LINK_USER_PAYLOAD_LANGUAGE_DA_DK   LinkUserPayloadLanguage = "da-DK"
+	LINK_USER_PAYLOAD_LANGUAGE_DE_DE   LinkUserPayloadLanguage = "de-DE"
+	LINK_USER_PAYLOAD_LANGUAGE_EN_US   LinkUserPayloadLanguage = "en-US"
+	LINK_USER_PAYLOAD_LANGUAGE_EN_GB   LinkUserPayloadLanguage = "en-GB"
+	LINK_USER_PAYLOAD_LANGUAGE_ES_ES   LinkUserPayloadLanguage = "es-ES"
+	LINK_USER_PAYLOAD_LANGUAGE_FI_FI   LinkUserPayloadLanguage = "fi-FI"
+	LINK_USER_PAYLOAD_LANGUAGE_FR_FR   LinkUserPayloadLanguage = "fr-FR"
+	LINK_USER_PAYLOAD_LANGUAGE_IT_IT   LinkUserPayloadLanguage = "it-IT"
+	LINK_USER_PAYLOAD_LANGUAGE_NB_NO   LinkUserPayloadLanguage = "nb-NO"
+	LINK_USER_PAYLOAD_LANGUAGE_NL_NL   LinkUserPayloadLanguage = "nl-NL"
+	LINK_USER_PAYLOAD_LANGUAGE_NL_BE   LinkUserPayloadLanguage = "nl-BE"
+	LINK_USER_PAYLOAD_LANGUAGE_PT_PT   LinkUserPayloadLanguage = "pt-PT"
+	LINK_USER_PAYLOAD_LANGUAGE_RO_RO   LinkUserPayloadLanguage = "ro-RO"
+	LINK_USER_PAYLOAD_LANGUAGE_SV_SE   LinkUserPayloadLanguage = "sv-SE"
+)
+
+// LinkUserPayloadColorScheme: Sets the color scheme (light or dark) of Link UI. If your UI is only available in one of the color schemes, and does not follow the operating system color scheme, we recommend setting either `light` or `dark`. Defaults to  `system`, which follows the users operating system settings.
+type LinkUserPayloadColorScheme string
+
+const (
+	LINK_USER_PAYLOAD_COLOR_SCHEME_SYSTEM LinkUserPayloadColorScheme = "system"
+	LINK_USER_PAYLOAD_COLOR_SCHEME_LIGHT  LinkUserPayloadColorScheme = "light"
+	LINK_USER_PAYLOAD_COLOR_SCHEME_DARK   LinkUserPayloadColorScheme = "dark"
+)
+
+// VehiclePowerDeliveryState: The current state of power delivery between the vehicle and charger.
+//
+//   - `UNKNOWN`: The state of power delivery is currently unknown.
+//   - `UNPLUGGED`: The vehicle is not connected to the charger.
+//   - `PLUGGED_IN:INITIALIZING`: The charging station is preparing to deliver power to the vehicle. It is expected for this state to shortly transition into PLUGGED_IN:CHARGING.
+//   - `PLUGGED_IN:CHARGING`: The vehicle is actively receiving power from the charger, causing the battery level to increase.
+//   - `PLUGGED_IN:COMPLETE`: The charging process has finished and the vehicle's battery has reached the target charge limit.
+//   - `PLUGGED_IN:STOPPED`: The charging process has been intentionally stopped by either the vehicle or the charger. The vehicle is still plugged in and waiting for the charging process to resume.
+//   - `PLUGGED_IN:NO_POWER`: The vehicle attempted to initialize charging, however no external power was available. It is not possible to transition into a charging state with a remote command until there is some user intervention to resolve the issue.
+//   - `PLUGGED_IN:FAULT`: A malfunction in the charging process is preventing power from being delivered. Possible causes include a charging cable not being properly locked, extreme temperatures, or malfunctions in either the charging station or the vehicle's internal system. It is not possible to transition into a charging state with a remote command until there is some user intervention to resolve the issue.
+type VehiclePowerDeliveryState string
+
+const (
+	VEHICLE_POWER_DELIVERY_STATE_UNKNOWN                 VehiclePowerDeliveryState = "UNKNOWN"
+	VEHICLE_POWER_DELIVERY_STATE_UNPLUGGED               VehiclePowerDeliveryState = "UNPLUGGED"
+	VEHICLE_POWER_DELIVERY_STATE_PLUGGED_IN_INITIALIZING VehiclePowerDeliveryState = "PLUGGED_IN:INITIALIZING"
+	VEHICLE_POWER_DELIVERY_STATE_PLUGGED_IN_CHARGING     VehiclePowerDeliveryState = "PLUGGED_IN:CHARGING"
+	VEHICLE_POWER_DELIVERY_STATE_PLUGGED_IN_STOPPED      VehiclePowerDeliveryState = "PLUGGED_IN:STOPPED"
+	VEHICLE_POWER_DELIVERY_STATE_PLUGGED_IN_COMPLETE     VehiclePowerDeliveryState = "PLUGGED_IN:COMPLETE"
+	VEHICLE_POWER_DELIVERY_STATE_PLUGGED_IN_NO_POWER     VehiclePowerDeliveryState = "PLUGGED_IN:NO_POWER"
+	VEHICLE_POWER_DELIVERY_STATE_PLUGGED_IN_FAULT        VehiclePowerDeliveryState = "PLUGGED_IN:FAULT"
+)
+
+type VehicleChargeActionTargetType string
+
+const (
+	VEHICLE_CHARGE_ACTION_TARGET_TYPE_VEHICLE VehicleChargeActionTargetType = "vehicle"
+)
+
+// FailureCondition: An enum value that describes the current SmartCharging state of the vehicle. Every vehicle is in exactly one state, at all times
+type FailureCondition string
+
+const (
+	FAILURE_CONDITION_STOP_FAILED        FailureCondition = "STOP_FAILED"
+	FAILURE_CONDITION_START_FAILED       FailureCondition = "START_FAILED"
+	FAILURE_CONDITION_FINISHED_LATE      FailureCondition = "FINISHED_LATE"
+	FAILURE_CONDITION_UNKNOWN            FailureCondition = "UNKNOWN"
+	FAILURE_CONDITION_CHARGE_INTERRUPTED FailureCondition = "CHARGE_INTERRUPTED"
+)
+
+// VehicleSmartChargingPlanFinalState: The final state of the Smart Charging Plan when it ended
+type VehicleSmartChargingPlanFinalState string
+
+const (
+	VEHICLE_SMART_CHARGING_PLAN_FINAL_STATE_PLAN_ENDED_FINISHED         VehicleSmartChargingPlanFinalState = "PLAN:ENDED:FINISHED"
+	VEHICLE_SMART_CHARGING_PLAN_FINAL_STATE_PLAN_ENDED_UNPLUGGED        VehicleSmartChargingPlanFinalState = "PLAN:ENDED:UNPLUGGED"
+	VEHICLE_SMART_CHARGING_PLAN_FINAL_STATE_PLAN_ENDED_FAILED           VehicleSmartChargingPlanFinalState = "PLAN:ENDED:FAILED"
+	VEHICLE_SMART_CHARGING_PLAN_FINAL_STATE_PLAN_ENDED_DISABLED         VehicleSmartChargingPlanFinalState = "PLAN:ENDED:DISABLED"
+	VEHICLE_SMART_CHARGING_PLAN_FINAL_STATE_PLAN_ENDED_DEADLINE_CHANGED VehicleSmartChargingPlanFinalState = "PLAN:ENDED:DEADLINE_CHANGED"
+)
+
+// SmartChargeState: An enum value that describes the current Smart Charging state of the vehicle. Every vehicle is in exactly one state, at all times. If the returned state is `UNKNOWN`, this might indicate that the vehicle is no longer capable of smart charging. In this case we recommend that you check the capabilities of the vehicle to determine what should be communicated to users. If we don't have pricing data or tariffs for the location of the vehicle, the state will also be `UNKNOWN`. In this case, we recommend that you contact Enode staff.
+type SmartChargeState string
+
+const (
+	SMART_CHARGE_STATE_DISABLED                               SmartChargeState = "DISABLED"
+	SMART_CHARGE_STATE_CONSIDERING                            SmartChargeState = "CONSIDERING"
+	SMART_CHARGE_STATE_UNKNOWN                                SmartChargeState = "UNKNOWN"
+	SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPING                SmartChargeState = "PLAN:EXECUTING:STOPPING"
+	SMART_CHARGE_STATE_PLAN_EXECUTING_STOP_FAILED             SmartChargeState = "PLAN:EXECUTING:STOP_FAILED"
+	SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED                 SmartChargeState = "PLAN:EXECUTING:STOPPED"
+	SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED_AWAITING_PRICES SmartChargeState = "PLAN:EXECUTING:STOPPED:AWAITING_PRICES"
+	SMART_CHARGE_STATE_PLAN_EXECUTING_STARTING                SmartChargeState = "PLAN:EXECUTING:STARTING"
+	SMART_CHARGE_STATE_PLAN_EXECUTING_START_FAILED            SmartChargeState = "PLAN:EXECUTING:START_FAILED"
+	SMART_CHARGE_STATE_PLAN_EXECUTING_STARTED                 SmartChargeState = "PLAN:EXECUTING:STARTED"
+	SMART_CHARGE_STATE_PLAN_EXECUTING_CHARGE_INTERRUPTED      SmartChargeState = "PLAN:EXECUTING:CHARGE_INTERRUPTED"
+	SMART_CHARGE_STATE_PLAN_EXECUTING_OVERRIDDEN              SmartChargeState = "PLAN:EXECUTING:OVERRIDDEN"
+	SMART_CHARGE_STATE_PLAN_ENDED_FINISHED                    SmartChargeState = "PLAN:ENDED:FINISHED"
+	SMART_CHARGE_STATE_PLAN_ENDED_UNPLUGGED                   SmartChargeState = "PLAN:ENDED:UNPLUGGED"
+	SMART_CHARGE_STATE_PLAN_ENDED_FAILED                      SmartChargeState = "PLAN:ENDED:FAILED"
+	SMART_CHARGE_STATE_PLAN_ENDED_DISABLED                    SmartChargeState = "PLAN:ENDED:DISABLED"
+	SMART_CHARGE_STATE_PLAN_ENDED_DEADLINE_CHANGED            SmartChargeState = "PLAN:ENDED:DEADLINE_CHANGED"
+	SMART_CHARGE_STATE_FULLY_CHARGED                          SmartChargeState = "FULLY_CHARGED"
+)
+
+// TestFirehoseWebhookStatus: Indicates if the test webhook event was delivered successfully to your configured webhook endpoint.
+type TestFirehoseWebhookStatus string
+
+const (
+	TEST_FIREHOSE_WEBHOOK_STATUS_SUCCESS TestFirehoseWebhookStatus = "SUCCESS"
+	TEST_FIREHOSE_WEBHOOK_STATUS_FAILURE TestFirehoseWebhookStatus = "FAILURE"
+)
+
+type WebhookEvent string
+
+const (
+	WEBHOOK_EVENT_ALL                                        WebhookEvent = "*"
+	WEBHOOK_EVENT_USER_VEHICLE_DISCOVERED                    WebhookEvent = "user:vehicle:discovered"
+	WEBHOOK_EVENT_USER_VEHICLE_UPDATED                       WebhookEvent = "user:vehicle:updated"
+	WEBHOOK_EVENT_USER_VEHICLE_DELETED                       WebhookEvent = "user:vehicle:deleted"
+	WEBHOOK_EVENT_USER_VEHICLE_SMART_CHARGING_STATUS_UPDATED WebhookEvent = "user:vehicle:smart-charging-status-updated"
+	WEBHOOK_EVENT_USER_CHARGE_ACTION_UPDATED                 WebhookEvent = "user:charge-action:updated"
+	WEBHOOK_EVENT_USER_VENDOR_ACTION_UPDATED                 WebhookEvent = "user:vendor-action:updated"
+	WEBHOOK_EVENT_USER_SCHEDULE_EXECUTION_UPDATED            WebhookEvent = "user:schedule:execution-updated"
+	WEBHOOK_EVENT_USER_CHARGER_DISCOVERED                    WebhookEvent = "user:charger:discovered"
+	WEBHOOK_EVENT_USER_CHARGER_UPDATED                       WebhookEvent = "user:charger:updated"
+	WEBHOOK_EVENT_USER_CHARGER_DELETED                       WebhookEvent = "user:charger:deleted"
+	WEBHOOK_EVENT_USER_HVAC_DISCOVERED                       WebhookEvent = "user:hvac:discovered"
+	WEBHOOK_EVENT_USER_HVAC_UPDATED                          WebhookEvent = "user:hvac:updated"
+	WEBHOOK_EVENT_USER_HVAC_DELETED                          WebhookEvent = "user:hvac:deleted"
+	WEBHOOK_EVENT_USER_INVERTER_DISCOVERED                   WebhookEvent = "user:inverter:discovered"
+	WEBHOOK_EVENT_USER_INVERTER_UPDATED                      WebhookEvent = "user:inverter:updated"
+	WEBHOOK_EVENT_USER_INVERTER_DELETED                      WebhookEvent = "user:inverter:deleted"
+	WEBHOOK_EVENT_USER_CREDENTIALS_INVALIDATED               WebhookEvent = "user:credentials:invalidated"
+	WEBHOOK_EVENT_USER_BATTERY_DISCOVERED                    WebhookEvent = "user:battery:discovered"
+	WEBHOOK_EVENT_USER_BATTERY_UPDATED                       WebhookEvent = "user:battery:updated"
+	WEBHOOK_EVENT_USER_BATTERY_DELETED                       WebhookEvent = "user:battery:deleted"
+	WEBHOOK_EVENT_ENODE_WEBHOOK_TEST                         WebhookEvent = "enode:webhook:test"
+	WEBHOOK_EVENT_USER_METER_DISCOVERED                      WebhookEvent = "user:meter:discovered"
+	WEBHOOK_EVENT_USER_METER_UPDATED                         WebhookEvent = "user:meter:updated"
+	WEBHOOK_EVENT_USER_METER_DELETED                         WebhookEvent = "user:meter:deleted"
+)
+
+// VendorHealthStatus: Ready-state of the Vendor. Currently always `READY`.
+type VendorHealthStatus string
+
+const (
+	VENDOR_HEALTH_STATUS_READY               VendorHealthStatus = "READY"
+	VENDOR_HEALTH_STATUS_ELEVATED_ERROR_RATE VendorHealthStatus = "ELEVATED_ERROR_RATE"
+	VENDOR_HEALTH_STATUS_OUTAGE              VendorHealthStatus = "OUTAGE"
+)
+
+type SystemHeartbeatEvent string
+
+const (
+	SYSTEM_HEARTBEAT_EVENT_SYSTEM_HEARTBEAT SystemHeartbeatEvent = "system:heartbeat"
+)
+
+type UserVehicleDiscoveredEvent string
+
+const (
+	USER_VEHICLE_DISCOVERED_EVENT_USER_VEHICLE_DISCOVERED UserVehicleDiscoveredEvent = "user:vehicle:discovered"
+)
+
+type UserVehicleUpdatedEvent string
+
+const (
+	USER_VEHICLE_UPDATED_EVENT_USER_VEHICLE_UPDATED UserVehicleUpdatedEvent = "user:vehicle:updated"
+)
+
+type UserVehicleDeletedEvent string
+
+const (
+	USER_VEHICLE_DELETED_EVENT_USER_VEHICLE_DELETED UserVehicleDeletedEvent = "user:vehicle:deleted"
+)
+
+type UserVehicleSmartChargingStatusUpdatedEvent string
+
+const (
+	USER_VEHICLE_SMART_CHARGING_STATUS_UPDATED_EVENT_USER_VEHICLE_SMART_CHARGING_STATUS_UPDATED UserVehicleSmartChargingStatusUpdatedEvent = "user:vehicle:smart-charging-status-updated"
+)
+
+type UserActionUpdatedEvent string
+
+const (
+	USER_ACTION_UPDATED_EVENT_USER_VENDOR_ACTION_UPDATED UserActionUpdatedEvent = "user:vendor-action:updated"
+)
+
+type UserTemperatureScheduleStatusUpdatedEvent string
+
+const (
+	USER_TEMPERATURE_SCHEDULE_STATUS_UPDATED_EVENT_USER_SCHEDULE_EXECUTION_UPDATED UserTemperatureScheduleStatusUpdatedEvent = "user:schedule:execution-updated"
+)
+
+type UserChargerUpdatedEvent string
+
+const (
+	USER_CHARGER_UPDATED_EVENT_USER_CHARGER_UPDATED UserChargerUpdatedEvent = "user:charger:updated"
+)
+
+type UserChargerDeletedEvent string
+
+const (
+	USER_CHARGER_DELETED_EVENT_USER_CHARGER_DELETED UserChargerDeletedEvent = "user:charger:deleted"
+)
+
+type UserChargerDiscoveredEvent string
+
+const (
+	USER_CHARGER_DISCOVERED_EVENT_USER_CHARGER_DISCOVERED UserChargerDiscoveredEvent = "user:charger:discovered"
+)
+
+type UserCredentialsInvalidatedEvent string
+
+const (
+	USER_CREDENTIALS_INVALIDATED_EVENT_USER_CREDENTIALS_INVALIDATED UserCredentialsInvalidatedEvent = "user:credentials:invalidated"
+)
+
+type UserHvacDiscoveredEvent string
+
+const (
+	USER_HVAC_DISCOVERED_EVENT_USER_HVAC_DISCOVERED UserHvacDiscoveredEvent = "user:hvac:discovered"
+)
+
+type UserHvacUpdatedEvent string
+
+const (
+	USER_HVAC_UPDATED_EVENT_USER_HVAC_UPDATED UserHvacUpdatedEvent = "user:hvac:updated"
+)
+
+type UserHvacDeletedEvent string
+
+const (
+	USER_HVAC_DELETED_EVENT_USER_HVAC_DELETED UserHvacDeletedEvent = "user:hvac:deleted"
+)
+
+type UserInverterDiscoveredEvent string
+
+const (
+	USER_INVERTER_DISCOVERED_EVENT_USER_INVERTER_DISCOVERED UserInverterDiscoveredEvent = "user:inverter:discovered"
+)
+
+type UserInverterUpdatedEvent string
+
+const (
+	USER_INVERTER_UPDATED_EVENT_USER_INVERTER_UPDATED UserInverterUpdatedEvent = "user:inverter:updated"
+)
+
+type UserInverterDeletedEvent string
+
+const (
+	USER_INVERTER_DELETED_EVENT_USER_INVERTER_DELETED UserInverterDeletedEvent = "user:inverter:deleted"
+)
+
+type UserBatteryDiscoveredEvent string
+
+const (
+	USER_BATTERY_DISCOVERED_EVENT_USER_BATTERY_DISCOVERED UserBatteryDiscoveredEvent = "user:battery:discovered"
+)
+
+type UserBatteryUpdatedEvent string
+
+const (
+	USER_BATTERY_UPDATED_EVENT_USER_BATTERY_UPDATED UserBatteryUpdatedEvent = "user:battery:updated"
+)
+
+type UserBatteryDeletedEvent string
+
+const (
+	USER_BATTERY_DELETED_EVENT_USER_BATTERY_DELETED UserBatteryDeletedEvent = "user:battery:deleted"
+)
+
+type UserMeterDiscoveredEvent string
+
+const (
+	USER_METER_DISCOVERED_EVENT_USER_METER_DISCOVERED UserMeterDiscoveredEvent = "user:meter:discovered"
+)
+
+type UserMeterUpdatedEvent string
+
+const (
+	USER_METER_UPDATED_EVENT_USER_METER_UPDATED UserMeterUpdatedEvent = "user:meter:updated"
+)
+
+type UserMeterDeletedEvent string
+
+const (
+	USER_METER_DELETED_EVENT_USER_METER_DELETED UserMeterDeletedEvent = "user:meter:deleted"
+)
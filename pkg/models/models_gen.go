@@ -0,0 +1,1956 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package models
+
+import (
+	"time"
+)
+
+// API_VERSION is the version of the Enode API the models are generated from.
+const API_VERSION = "2024-01-01"
+
+// BatteryChargeState: Latest information about the battery. `null` values indicate we are unable to determine a value for the field based on the information coming from the vendor.
+type BatteryChargeState struct {
+	// The power delivery state of the battery.
+	Status *BatteryChargeStateStatus `json:"status"`
+	// Battery capacity in kWh.
+	BatteryCapacity *float64 `json:"batteryCapacity"`
+	// Remaining battery charge level in percent.
+	BatteryLevel *float64 `json:"batteryLevel"`
+	// The current charge rate in kW. A positive value indicates that the battery is charging.
+	ChargeRate *float64 `json:"chargeRate"`
+	// Minimum charge level for a battery, expressed as a percentage. Batteries will not discharge below this user-set reserve level except in emergency power situations.
+	DischargeLimit *float64 `json:"dischargeLimit"`
+	// Time of last received charge state update
+	LastUpdated *time.Time `json:"lastUpdated"`
+}
+
+type BatteryConfig struct {
+	// The current operation mode of the battery.
+	//
+	//  * `IMPORT_FOCUS`: Prioritizes charging the battery. Draws power from the grid and any excess solar for charging.
+	//  * `EXPORT_FOCUS`: Prioritizes discharging energy stored in the battery back to the grid.
+	//  * `TIME_OF_USE`: Maximizes energy cost savings in accordance with a user-defined utility rate plan. Energy may be consumed from solar, battery, or grid sources, depending on the current prices and your user's settings in the OEM app. Energy may be exported to the grid from solar or battery sources, depending on current prices and your user's settings in the OEM app.
+	//  * `SELF_RELIANCE`: Minimizes household reliance on the grid. Prioritize using own energy from solar or battery for household consumption before importing from grid. Energy may be exported to the grid from solar, depending on excess solar and your user's settings in the OEM app.
+	OperationMode *BatteryConfigOperationMode `json:"operationMode"`
+	// Time of last received configuration update
+	LastUpdated *time.Time `json:"lastUpdated"`
+}
+
+// BatteryInformation: Descriptive information about the battery
+type BatteryInformation struct {
+	// Battery vendor ID
+	Id string `json:"id"`
+	// Battery brand
+	Brand BatteryBrand `json:"brand"`
+	// Battery model
+	Model string `json:"model"`
+	// Name of the site, as set by the user on the device/vendor. If no user-specified name is available, we construct a fallback name using the vendor/device/model names.
+	SiteName string `json:"siteName"`
+	// Battery installation date
+	InstallationDate time.Time `json:"installationDate"`
+}
+
+// BatteryLocation: Battery's GPS coordinates
+type BatteryLocation struct {
+	// Longitude in degrees
+	Longitude *float64 `json:"longitude"`
+	// Latitude in degrees
+	Latitude *float64 `json:"latitude"`
+}
+
+type Capability struct {
+	// The entity is fully capable of providing this data or functionality. If false, support is partial or missing.
+	IsCapable bool `json:"isCapable"`
+	// IDs of interventions the user can make to alter the availability of this capability. Please refer to [the interventions guide](/docs/interventions#accessing-interventions-via-our-api) for more information.
+	InterventionIds []string `json:"interventionIds"`
+}
+
+// BatteryCapabilities: A collection of descriptors that describe the capabilities of this specific battery
+type BatteryCapabilities struct {
+	// Supports EXPORT_FOCUS operation mode.
+	ExportFocus Capability `json:"exportFocus"`
+	// Supports IMPORT_FOCUS operation mode.
+	ImportFocus Capability `json:"importFocus"`
+	// Supports TIME_OF_USE operation mode.
+	TimeOfUse Capability `json:"timeOfUse"`
+	// Supports SELF_RELIANCE operation mode.
+	SelfReliance Capability `json:"selfReliance"`
+}
+
+// PaginationCursors: Cursors to the pages before and after current page. See the [Pagination](/api/reference#pagination) section for reference.
+type PaginationCursors struct {
+	After  *string `json:"after"`
+	Before *string `json:"before"`
+}
+
+// PaginatedBatteryList: Paginated response of batteries
+type PaginatedBatteryList struct {
+	// Paginated list of batteries
+	Data       []Battery         `json:"data"`
+	Pagination PaginationCursors `json:"pagination"`
+}
+
+type Battery struct {
+	// Unique identifier for the battery object
+	Id string `json:"id"`
+	// The ID of the user that linked this battery.
+	UserId string        `json:"userId"`
+	Vendor BatteryVendor `json:"vendor"`
+	// ID of the location the battery is currently positioned at (if any).
+	LocationId *string `json:"locationId"`
+	// The last time Enode successfully communicated with the vendor or when the battery was initially linked.
+	LastSeen time.Time `json:"lastSeen"`
+	// Whether live data from the battery is currently reachable from Enode's perspective. This 'reachability' may refer to reading from a cache operated by the battery's cloud service if that service has determined that its cache is valid.
+	IsReachable  bool                `json:"isReachable"`
+	ChargeState  BatteryChargeState  `json:"chargeState"`
+	Config       BatteryConfig       `json:"config"`
+	Information  BatteryInformation  `json:"information"`
+	Location     BatteryLocation     `json:"location"`
+	Capabilities BatteryCapabilities `json:"capabilities"`
+	// Scopes that the user has granted for this battery.
+	Scopes []string `json:"scopes"`
+}
+
+// SetBatteryOperationModePayload: Target battery operation mode
+type SetBatteryOperationModePayload struct {
+	// Desired operation mode of the battery.
+	//
+	//  * `IMPORT_FOCUS`: Prioritizes charging the battery. Draws power from the grid and any excess solar for charging.
+	//  * `EXPORT_FOCUS`: Prioritizes discharging energy stored in the battery back to the grid.
+	//  * `TIME_OF_USE`: Maximizes energy cost savings in accordance with a user-defined utility rate plan. Energy may be consumed from solar, battery, or grid sources, depending on the current prices and your user's settings in the OEM app. Energy may be exported to the grid from solar or battery sources, depending on current prices and your user's settings in the OEM app.
+	//  * `SELF_RELIANCE`: Minimizes household reliance on the grid. Prioritize using own energy from solar or battery for household consumption before importing from grid. Energy may be exported to the grid from solar, depending on excess solar and your user's settings in the OEM app.
+	OperationMode SetBatteryOperationModePayloadOperationMode `json:"operationMode"`
+}
+
+type OperationModeAction struct {
+	// The ID of the action.
+	Id string `json:"id"`
+	// The ID of the user that owns the target of this action.
+	UserId string `json:"userId"`
+	// Time when this action was created
+	CreatedAt time.Time `json:"createdAt"`
+	// Time when this action was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Time when the action transitioned to a non-pending state.
+	CompletedAt *time.Time  `json:"completedAt"`
+	State       ActionState `json:"state"`
+	// ID of the battery which this action is controlling.
+	TargetId    string                         `json:"targetId"`
+	TargetType  OperationModeActionTargetType  `json:"targetType"`
+	TargetState SetBatteryOperationModePayload `json:"targetState"`
+}
+
+// Problem: Standard Problem Error
+type Problem struct {
+	// A URI reference that identifies the problem type.
+	Type string `json:"type"`
+	// A short, human-readable summary of the problem type.
+	Title string `json:"title"`
+	// A human-readable explanation specific to this occurrence of the problem.
+	Detail string `json:"detail"`
+}
+
+// ChargerChargeState: Latest information about the charger. `null` values indicate we are unable to determine a value for the field based on the information coming from the vendor.
+type ChargerChargeState struct {
+	// Indicates whether the charger has a vehicle plugged into it (regardless of whether that vehicle is actually charging)
+	IsPluggedIn *bool `json:"isPluggedIn"`
+	// Indicates whether the charger is currently delivering power to the vehicle and actively charging its battery.
+	IsCharging *bool `json:"isCharging"`
+	// The current charge rate in kW.
+	//
+	// This property is only available when the charger is actively charging a vehicle, and is `null` any other time.
+	ChargeRate *float64 `json:"chargeRate"`
+	// Time of latest charge state update
+	LastUpdated *time.Time `json:"lastUpdated"`
+	// Desired max current in amperes, if set
+	MaxCurrent         *float64                  `json:"maxCurrent"`
+	PowerDeliveryState ChargerPowerDeliveryState `json:"powerDeliveryState"`
+}
+
+// ChargerInformation: Descriptive information about the Charger
+type ChargerInformation struct {
+	// Charger brand
+	Brand ChargerBrand `json:"brand"`
+	// Charger model
+	Model string `json:"model"`
+	// Charger production year
+	Year *float64 `json:"year"`
+}
+
+// ChargerCapabilities: A collection of descriptors that describe the capabilities of this specific charger
+type ChargerCapabilities struct {
+	// Full availability of information data.
+	Information Capability `json:"information"`
+	// Full availability of chargeState data.
+	ChargeState Capability `json:"chargeState"`
+	// Supports START charging command.
+	StartCharging Capability `json:"startCharging"`
+	// Supports STOP charging command.
+	StopCharging Capability `json:"stopCharging"`
+	// Ability to set the max charge rate.
+	SetMaxCurrent Capability `json:"setMaxCurrent"`
+}
+
+type Charger struct {
+	// Charger ID
+	Id string `json:"id"`
+	// The ID of the user that linked this charger.
+	UserId string        `json:"userId"`
+	Vendor ChargerVendor `json:"vendor"`
+	// The last time Enode successfully communicated with the charger.
+	LastSeen time.Time `json:"lastSeen"`
+	// Whether live data from the charger is currently reachable from Enode's perspective. This 'reachability' may refer to reading from a cache operated by the charger's cloud service if that service has determined that its cache is valid.
+	IsReachable bool `json:"isReachable"`
+	// ID of the location the charger is currently positioned at (if any).
+	LocationId   *string             `json:"locationId"`
+	ChargeState  ChargerChargeState  `json:"chargeState"`
+	Information  ChargerInformation  `json:"information"`
+	Capabilities ChargerCapabilities `json:"capabilities"`
+	// Scopes that the user has granted for this charger.
+	Scopes []Scopes `json:"scopes"`
+}
+
+// PaginatedChargerList: Paginated response of chargers
+type PaginatedChargerList struct {
+	// Paginated list of chargers
+	Data       []Charger         `json:"data"`
+	Pagination PaginationCursors `json:"pagination"`
+}
+
+type ChargerUpdatePayload struct {
+	LocationId *string `json:"locationId"`
+}
+
+// ChargingActionFailureReason: Information about why was this action not executed successfully.
+type ChargingActionFailureReason struct {
+	// A machine-readable high level error category.
+	//
+	//  * `NO_RESPONSE`: The chargeable device did not react to our charge commands within the action's timeout window.
+	//  * `FAILED_PRECONDITION`: The chargeable device did not meet all required preconditions for this action to be executed during the action's timeout window.
+	//  * `UNNECESSARY`: The action was not carried out given that the device was already in the desired state.
+	//  * `CONFLICT`: A newer action for this chargeable has been created. This action is now abandoned.
+	//  * `REQUESTED_CANCELLATION`: This action was cancelled by request of the controlling owner. The controlling owner may refer to another Enode entity which initiated the command, such as a schedule or smart override.
+	//  * `NOT_FOUND`: The chargeable was deleted while the action was `PENDING`.
+	Type ChargingActionFailureReasonType `json:"type"`
+	// A human-readable explanation of why the charging action was unsuccessful.
+	Detail string `json:"detail"`
+}
+
+type ChargeAction struct {
+	// The ID of the action.
+	Id string `json:"id"`
+	// The ID of the user that owns the target of this action.
+	UserId string `json:"userId"`
+	// Time when this action was created
+	CreatedAt time.Time `json:"createdAt"`
+	// Time when this action was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Time when the action transitioned to a non-pending state.
+	CompletedAt *time.Time  `json:"completedAt"`
+	State       ActionState `json:"state"`
+	// ID of the target which this action is controlling.
+	TargetId   string               `json:"targetId"`
+	TargetType ChargeableVendorType `json:"targetType"`
+	// The charging action to perform
+	Kind          ChargingAction               `json:"kind"`
+	FailureReason *ChargingActionFailureReason `json:"failureReason"`
+}
+
+// TargetMaxCurrent: Target maximum current for entity
+type TargetMaxCurrent struct {
+	// Desired max current in ampere
+	MaxCurrent float64 `json:"maxCurrent"`
+}
+
+type MaxCurrentAction struct {
+	// The ID of the action.
+	Id string `json:"id"`
+	// The ID of the user that owns the target of this action.
+	UserId string `json:"userId"`
+	// Time when this action was created
+	CreatedAt time.Time `json:"createdAt"`
+	// Time when this action was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Time when the action transitioned to a non-pending state.
+	CompletedAt *time.Time  `json:"completedAt"`
+	State       ActionState `json:"state"`
+	// ID of the entity which this action is controlling.
+	TargetId      string                       `json:"targetId"`
+	TargetType    ChargeableVendorType         `json:"targetType"`
+	TargetState   TargetMaxCurrent             `json:"targetState"`
+	FailureReason *ChargingActionFailureReason `json:"failureReason"`
+}
+
+// SmartOverrideStatus: This field populates after using Vehicle [Create Smart Override](/api/reference#vehicleCreateSmartOverride) or Charger [Create Smart Override](/api/reference#chargerCreateSmartOverride). Once populated, the target enters an overridden state, stopping Enode from sending Smart Charging actions. The Smart Override persists until the target ceases charging for any reason or is manually ended via Vehicle [End Smart Override](/api/reference#vehicleEndSmartOverride) or Charger [End Smart Override](/api/reference#chargerEndSmartOverride).
+type SmartOverrideStatus struct {
+	// Time at which the smart override was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Time at which the smart override was ended. If null, the smart override is still active.
+	EndedAt    *time.Time           `json:"endedAt"`
+	TargetType ChargeableVendorType `json:"targetType"`
+	// ID of the target which this smart override is affecting.
+	TargetId string `json:"targetId"`
+	// The ID of the Action responsible for starting charging on the target. Use the [Get Vehicle Action](/api/reference#getVehiclesAction) or the [Get Charger Action](/api/reference#getChargersAction) endpoints to monitor action results.
+	VendorActionId *string `json:"vendorActionId"`
+}
+
+type ChargerOnlySmartOverrideResponse struct {
+	// Time at which the smart override was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Time at which the smart override was ended. If null, the smart override is still active.
+	EndedAt *time.Time `json:"endedAt"`
+	// ID of the target which this smart override is affecting.
+	TargetId string `json:"targetId"`
+	// The ID of the Action responsible for starting charging on the target. Use the [Get Vehicle Action](/api/reference#getVehiclesAction) or the [Get Charger Action](/api/reference#getChargersAction) endpoints to monitor action results.
+	VendorActionId *string `json:"vendorActionId"`
+	// ID of the User
+	UserId     string                                     `json:"userId"`
+	Vendor     ChargeableVendor                           `json:"vendor"`
+	TargetType ChargerOnlySmartOverrideResponseTargetType `json:"targetType"`
+}
+
+type PartialChargerSmartChargingPolicy struct {
+	// When enabled, this charger's charging status may be controlled by [Smart Charging](/docs/smart-charging/introduction)
+	IsEnabled *bool `json:"isEnabled,omitempty"`
+	// The `HH:MM` deadline for the cycle. If a timezone is set on the charging location at which the smart charging occurs, the deadline is interpreted in that timezone, otherwise UTC is used.
+	Deadline *string `json:"deadline,omitempty"`
+	// The `HH:MM` charging duration for each cycle.
+	ChargingDuration *string `json:"chargingDuration,omitempty"`
+}
+
+type ChargerSmartChargingPolicy struct {
+	// When enabled, this charger's charging status may be controlled by [Smart Charging](/docs/smart-charging/introduction)
+	IsEnabled bool `json:"isEnabled"`
+	// The `HH:MM` deadline for the cycle. If a timezone is set on the charging location at which the smart charging occurs, the deadline is interpreted in that timezone, otherwise UTC is used.
+	Deadline string `json:"deadline"`
+	// The `HH:MM` charging duration for each cycle.
+	ChargingDuration string `json:"chargingDuration"`
+}
+
+type ChargerSmartChargingIntervalsItem struct {
+	Status ChargerSmartChargingIntervalsItemStatus `json:"status"`
+	// The start time of this charging interval
+	StartTime string `json:"startTime"`
+	// The end time of this charging interval
+	EndTime string `json:"endTime"`
+}
+
+type ChargerSmartChargingIntervals []ChargerSmartChargingIntervalsItem
+
+type NullableTemperatureRange struct {
+	// The minimum allowable temperature, inclusive.
+	Min *float64 `json:"min"`
+	// The maximum allowable temperature, inclusive.
+	Max *float64 `json:"max"`
+}
+
+type NullableTemperatureDifferenceRange struct {
+	// The minimum allowable difference, inclusive.
+	Min *float64 `json:"min"`
+	// The maximum allowable difference, inclusive.
+	Max *float64 `json:"max"`
+}
+
+type Hvac struct {
+	// HVAC unit ID
+	Id string `json:"id"`
+	// The ID of the user that linked this hvac.
+	UserId string     `json:"userId"`
+	Vendor HvacVendor `json:"vendor"`
+	// The last time Enode successfully communicated with the vendor or when the HVAC unit was initially linked.
+	LastSeen time.Time `json:"lastSeen"`
+	// Whether live data from the HVAC unit is currently reachable from Enode's perspective. It can happen that this 'reachability' refers to reading from a cache operated by the vendor's cloud service, if that service has determined that its cache is valid.
+	IsReachable bool `json:"isReachable"`
+	// The current rate of energy consumption in kW. An inactive HVAC will have a consumption rate of 0. HVACs not supporting consumption rate will report null. This value is currently only supported by a small number of devices.
+	ConsumptionRate *float64 `json:"consumptionRate"`
+	// Descriptive information about the HVAC unit
+	Information HvacInformation `json:"information"`
+	// An object describing valid states for this HVAC unit.
+	Capabilities HvacCapabilities `json:"capabilities"`
+	// Latest information about temperature. These values replace the deprecated top-level fields and include a freshness indicator. `null` values indicate we are unable to determine a value for the field based on the information coming from the vendor.
+	TemperatureState HvacTemperatureState `json:"temperatureState"`
+	// Latest information about the thermostat state. These values replace the deprecated top-level fields and include a freshness indicator. `null` values indicate we are unable to determine a value for the field based on the information coming from the vendor.
+	ThermostatState HvacThermostatState `json:"thermostatState"`
+	// Replaced by `thermostatState.mode`. The HVAC's mode.
+	Mode *HvacMode `json:"mode"`
+	// Replaced by `thermostatState.heatSetpoint`. If mode allows, heat when `currentTemperature` falls below this point.
+	//
+	// Deprecated: HeatSetpoint is deprecated by the Enode API.
+	HeatSetpoint *float64 `json:"heatSetpoint"`
+	// Replaced by `thermostatState.coolSetpoint`. If mode allows, cool when `currentTemperature` rises above this point.
+	//
+	// Deprecated: CoolSetpoint is deprecated by the Enode API.
+	CoolSetpoint *float64 `json:"coolSetpoint"`
+	// Replaced by `thermostatState.holdType`. The duration the setpoints and mode are expected to be held. If `SCHEDULED`, the device is being controlled by an OEM schedule configured on the device.
+	HoldType *HvacHoldType `json:"holdType"`
+	// Replaced by `temperatureState.isActive`. Whether the HVAC unit is actively heating or cooling.
+	//
+	// Deprecated: IsActive is deprecated by the Enode API.
+	IsActive bool `json:"isActive"`
+	// Replaced by `temperatureState.currentTemperature`. Current air temperature reported by device in degrees Celsius.
+	//
+	// Deprecated: CurrentTemperature is deprecated by the Enode API.
+	CurrentTemperature *float64 `json:"currentTemperature"`
+	// Scopes that the user has granted for this HVAC unit.
+	Scopes []string `json:"scopes"`
+	// ID of the location the HVAC unit is housed at (if any)
+	LocationId *string `json:"locationId"`
+}
+
+// HvacInformation: Descriptive information about the HVAC unit
+type HvacInformation struct {
+	// Display name of OEM/vendor
+	Brand HvacBrand `json:"brand"`
+	// Device model name
+	Model *string `json:"model"`
+	// Name of the device, as set by the user on the device/vendor. If no user-specified name is available, we construct a fallback name using the vendor/device/model names.
+	DisplayName string `json:"displayName"`
+	// Name of the group the device belongs to, as set by the user on the device/vendor. Groups are typically presented as "rooms" or "zones".
+	GroupName *string `json:"groupName"`
+	// HVAC category
+	Category HvacInformationCategory `json:"category"`
+}
+
+// HvacCapabilities: An object describing valid states for this HVAC unit.
+type HvacCapabilities struct {
+	// A list of valid modes for this HVAC unit.
+	CapableModes []HvacMode `json:"capableModes"`
+	// A list of valid hold types for this HVAC unit.
+	//
+	// Deprecated: Check the `setFollowSchedule` and `setPermanentHold` capabilities instead.
+	//
+	// Deprecated: CapableHoldTypes is deprecated by the Enode API.
+	CapableHoldTypes []HvacHoldType `json:"capableHoldTypes"`
+	// The range of allowable values for `coolSetpoint`.
+	CoolSetpointRange *NullableTemperatureRange `json:"coolSetpointRange"`
+	// The range of allowable values for `heatSetpoint`.
+	HeatSetpointRange *NullableTemperatureRange `json:"heatSetpointRange"`
+	// A constraint specifying the minimum and maximum allowable difference between `heatSetpoint` and `coolSetpoint`. Only applicable in `AUTO` mode.
+	SetpointDifferenceRange *NullableTemperatureDifferenceRange `json:"setpointDifferenceRange"`
+	// Supports following a schedule set on the device.
+	SetFollowSchedule Capability `json:"setFollowSchedule"`
+	// Supports setting a permanent hold.
+	SetPermanentHold Capability `json:"setPermanentHold"`
+}
+
+// HvacTemperatureState: Latest information about temperature. These values replace the deprecated top-level fields and include a freshness indicator. `null` values indicate we are unable to determine a value for the field based on the information coming from the vendor.
+type HvacTemperatureState struct {
+	// Current air temperature reported by device in degrees Celsius.
+	CurrentTemperature *float64 `json:"currentTemperature"`
+	// Whether the HVAC unit is actively heating or cooling.
+	IsActive bool `json:"isActive"`
+	// Time of last temperature state update. Reflects when the OEM reported a change or Enode recorded a change in any field, whichever is newer
+	LastUpdated *time.Time `json:"lastUpdated"`
+}
+
+// HvacThermostatState: Latest information about the thermostat state. These values replace the deprecated top-level fields and include a freshness indicator. `null` values indicate we are unable to determine a value for the field based on the information coming from the vendor.
+type HvacThermostatState struct {
+	// The HVAC's mode.
+	Mode *HvacMode `json:"mode"`
+	// If mode allows, heat when `currentTemperature` falls below this point.
+	HeatSetpoint *float64 `json:"heatSetpoint"`
+	// If mode allows, cool when `currentTemperature` rises above this point.
+	CoolSetpoint *float64 `json:"coolSetpoint"`
+	// The duration the setpoints and mode are expected to be held. If `SCHEDULED`, the device is being controlled by an OEM schedule configured on the device.
+	HoldType *HvacHoldType `json:"holdType"`
+	// Time of last thermostat state update. Reflects when the OEM reported a change or Enode recorded a change in any field, whichever is newer.
+	LastUpdated *time.Time `json:"lastUpdated"`
+}
+
+// PaginatedHVACList: Paginated response of HVAC units
+type PaginatedHVACList struct {
+	// List of paginated HVAC units
+	Data       []Hvac            `json:"data"`
+	Pagination PaginationCursors `json:"pagination"`
+}
+
+type PartialHvacSmartPolicy struct {
+	// When enabled, this HVAC unit is under smart control.
+	IsEnabled *bool `json:"isEnabled,omitempty"`
+}
+
+type HvacSmartPolicy struct {
+	// When enabled, this HVAC unit is under smart control.
+	IsEnabled bool `json:"isEnabled"`
+}
+
+type HvacSmartInterval struct {
+	// Clock time from which this rule should apply each day.
+	From string `json:"from"`
+	// Clock time until which this rule should apply each day.
+	To string `json:"to"`
+	// Heat setpoint for the interval.
+	HeatSetpoint float64 `json:"heatSetpoint"`
+}
+
+type HvacSmartStatus struct {
+	// ID of the HVAC unit.
+	HvacId string `json:"hvacId"`
+	// ID of the user who owns the HVAC unit.
+	UserId    string              `json:"userId"`
+	Intervals []HvacSmartInterval `json:"intervals"`
+}
+
+type HvacUpdatePayload struct {
+	LocationId *string `json:"locationId"`
+}
+
+// CoolTargetState: Target mode for an HVAC unit
+type CoolTargetState struct {
+	CoolSetpoint float64             `json:"coolSetpoint"`
+	Mode         CoolTargetStateMode `json:"mode"`
+	HoldType     PermanentHoldType   `json:"holdType"`
+}
+
+// HeatTargetState: Target mode for an HVAC unit
+type HeatTargetState struct {
+	HeatSetpoint float64             `json:"heatSetpoint"`
+	Mode         HeatTargetStateMode `json:"mode"`
+	HoldType     PermanentHoldType   `json:"holdType"`
+}
+
+// AutoTargetState: Target mode for an HVAC unit
+type AutoTargetState struct {
+	CoolSetpoint float64             `json:"coolSetpoint"`
+	HeatSetpoint float64             `json:"heatSetpoint"`
+	Mode         AutoTargetStateMode `json:"mode"`
+	HoldType     PermanentHoldType   `json:"holdType"`
+}
+
+// OffTargetState: Target mode for an HVAC unit
+type OffTargetState struct {
+	Mode     OffTargetStateMode `json:"mode"`
+	HoldType PermanentHoldType  `json:"holdType"`
+}
+
+type HvacActionPermanentHold struct {
+	// The ID of the action.
+	Id string `json:"id"`
+	// The ID of the user that owns the target of this action.
+	UserId string `json:"userId"`
+	// Time when this action was created
+	CreatedAt time.Time `json:"createdAt"`
+	// Time when this action was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Time when the action transitioned to a non-pending state.
+	CompletedAt *time.Time  `json:"completedAt"`
+	State       ActionState `json:"state"`
+	// ID of the entity asset (HVAC) which this action is controlling.
+	TargetId   string                   `json:"targetId"`
+	TargetType HvacTargetType           `json:"targetType"`
+	Target     PermanentHoldTargetState `json:"target"`
+}
+
+type ScheduledTargetState struct {
+	HoldType ScheduledTargetStateHoldType `json:"holdType"`
+}
+
+type HvacActionFollowSchedule struct {
+	// The ID of the action.
+	Id string `json:"id"`
+	// The ID of the user that owns the target of this action.
+	UserId string `json:"userId"`
+	// Time when this action was created
+	CreatedAt time.Time `json:"createdAt"`
+	// Time when this action was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Time when the action transitioned to a non-pending state.
+	CompletedAt *time.Time  `json:"completedAt"`
+	State       ActionState `json:"state"`
+	// ID of the entity asset (HVAC) which this action is controlling.
+	TargetId   string               `json:"targetId"`
+	TargetType HvacTargetType       `json:"targetType"`
+	Target     ScheduledTargetState `json:"target"`
+}
+
+// CoolPermanentHold: Target mode for an HVAC unit
+type CoolPermanentHold struct {
+	CoolSetpoint float64             `json:"coolSetpoint"`
+	Mode         CoolTargetStateMode `json:"mode"`
+}
+
+// HeatPermanentHold: Target mode for an HVAC unit
+type HeatPermanentHold struct {
+	HeatSetpoint float64             `json:"heatSetpoint"`
+	Mode         HeatTargetStateMode `json:"mode"`
+}
+
+// AutoPermanentHold: Target mode for an HVAC unit
+type AutoPermanentHold struct {
+	CoolSetpoint float64             `json:"coolSetpoint"`
+	HeatSetpoint float64             `json:"heatSetpoint"`
+	Mode         AutoTargetStateMode `json:"mode"`
+}
+
+// OffPermanentHold: Target mode for an HVAC unit
+type OffPermanentHold struct {
+	Mode OffTargetStateMode `json:"mode"`
+}
+
+type Intervention struct {
+	Id string `json:"id"`
+	// Machine-friendly representation of the OEM's name.
+	Vendor Vendor `json:"vendor"`
+	// Type of device this intervention relates to.
+	VendorType VendorType `json:"vendorType"`
+	// A formatted and properly cased OEM brand name, suitable for reading by humans. May contain special characters.
+	Brand string `json:"brand"`
+	// ISO 8601 timestamp of when the intervention was introduced.
+	IntroducedAt time.Time `json:"introducedAt"`
+	// The domain the intervention is related to. i.e. Is the intervention related to the vendor service account or a setting on the device.
+	Domain     InterventionDomain     `json:"domain"`
+	Resolution InterventionResolution `json:"resolution"`
+}
+
+type InterventionResolution struct {
+	// A localized title for the intervention.
+	Title string `json:"title"`
+	// A localized description of how to resolve the intervention. Formatted as Markdown.
+	Description string `json:"description"`
+	// Where the intervention needs to be resolved. i.e. Remotely using the vendor's app or directly on the device.
+	Access InterventionResolutionAccess `json:"access"`
+	// Who can resolve the intervention. i.e. A user can resolve the intervention themselves, or a licensed service retailer is needed.
+	Agent InterventionResolutionAgent `json:"agent"`
+}
+
+type InterventionsList []Intervention
+
+// InverterProductionState: Latest information about solar production. `null` values indicate we are unable to determine a value for the field based on the information coming from the vendor.
+type InverterProductionState struct {
+	// The current production rate in kW.
+	ProductionRate *float64 `json:"productionRate"`
+	// Whether the solar inverter is actively producing energy or not.
+	IsProducing *bool `json:"isProducing"`
+	// The total lifetime production in kWh.
+	TotalLifetimeProduction *float64 `json:"totalLifetimeProduction"`
+	// Time of last received production state update
+	LastUpdated *time.Time `json:"lastUpdated"`
+}
+
+// InverterInformation: Descriptive information about the solar inverter
+type InverterInformation struct {
+	// Solar inverter vendor ID
+	Id string `json:"id"`
+	// Solar inverter brand
+	Brand InverterBrand `json:"brand"`
+	// Solar inverter model
+	Model string `json:"model"`
+	// Name of the site, as set by the user on the device/vendor. If no user-specified name is available, we construct a fallback name using the vendor/device/model names.
+	SiteName string `json:"siteName"`
+	// Solar inverter installation date
+	InstallationDate time.Time `json:"installationDate"`
+}
+
+// InverterLocation: Solar inverter's GPS coordinates
+type InverterLocation struct {
+	// Longitude in degrees
+	Longitude *float64 `json:"longitude"`
+	// Latitude in degrees
+	Latitude *float64 `json:"latitude"`
+}
+
+// InverterCapabilities: A collection of descriptors that describe the capabilities of this specific inverter.
+//
+// Note: [Sample-based statistics](/api/reference#getProductionStatistics) collected by Enode rely on the `productionState` capability and are not affected by this capability.
+type InverterCapabilities struct {
+	// Full availability of productionState data.
+	ProductionState Capability `json:"productionState"`
+	// Availability of production statistics directly from the vendor.
+	ProductionStatistics Capability `json:"productionStatistics"`
+}
+
+type Inverter struct {
+	// Solar Inverter ID
+	Id string `json:"id"`
+	// The ID of the user that linked this inverter.
+	UserId string         `json:"userId"`
+	Vendor InverterVendor `json:"vendor"`
+	// ID of the charging location the solar inverter is currently positioned at (if any).
+	ChargingLocationId *string `json:"chargingLocationId"`
+	// The last time Enode received live data or when the solar inverter was initially linked.
+	LastSeen time.Time `json:"lastSeen"`
+	// Whether live data from the solar inverter is currently reachable from Enode's perspective. This 'reachability' may refer to reading from a cache operated by the solar inverter's cloud service if that service has determined that its cache is valid.
+	IsReachable     bool                    `json:"isReachable"`
+	ProductionState InverterProductionState `json:"productionState"`
+	Information     InverterInformation     `json:"information"`
+	Location        InverterLocation        `json:"location"`
+	// IANA TZ database timezone name representing the location of this inverter.
+	Timezone *string `json:"timezone"`
+	// Scopes that the user has granted for this inverter.
+	Scopes       []string             `json:"scopes"`
+	Capabilities InverterCapabilities `json:"capabilities"`
+}
+
+// PaginatedInverterList: Paginated response of solar inverters
+type PaginatedInverterList struct {
+	// Paginated list of solar inverters
+	Data       []Inverter        `json:"data"`
+	Pagination PaginationCursors `json:"pagination"`
+}
+
+type PartialLocation struct {
+	// User-supplied name for the Location
+	Name *string `json:"name,omitempty"`
+	// Latitude in degrees
+	Latitude *float64 `json:"latitude,omitempty"`
+	// Longitude in degrees
+	Longitude *float64 `json:"longitude,omitempty"`
+	// An IANA TZ database timezone name. This value will be used to convert rules and deadlines for tariffs, smart charging, and schedules into local time. Defaults to `UTC`.
+	TimezoneName *string `json:"timezoneName,omitempty"`
+}
+
+type LocationPayload struct {
+	// User-supplied name for the Location
+	Name string `json:"name"`
+	// Latitude in degrees
+	Latitude float64 `json:"latitude"`
+	// Longitude in degrees
+	Longitude float64 `json:"longitude"`
+	// An IANA TZ database timezone name. This value will be used to convert rules and deadlines for tariffs, smart charging, and schedules into local time. Defaults to `UTC`.
+	TimezoneName string `json:"timezoneName"`
+}
+
+type LocationResponse struct {
+	// The ID of the Location.
+	Id string `json:"id"`
+	// User ID the location belongs to
+	UserId string `json:"userId"`
+	// User-supplied name for the Location
+	Name string `json:"name"`
+	// Latitude in degrees
+	Latitude float64 `json:"latitude"`
+	// Longitude in degrees
+	Longitude float64 `json:"longitude"`
+	// An IANA TZ database timezone name. This value will be used to convert rules and deadlines for tariffs, smart charging, and schedules into local time. Defaults to `UTC`.
+	TimezoneName *string `json:"timezoneName,omitempty"`
+}
+
+// PaginatedLocationList: Paginated response of locations
+type PaginatedLocationList struct {
+	// Paginated list of locations
+	Data       []LocationResponse `json:"data"`
+	Pagination PaginationCursors  `json:"pagination"`
+}
+
+type LocationUpdatePayload struct {
+	// User-supplied name for the Location
+	Name string `json:"name"`
+	// Latitude in degrees
+	Latitude float64 `json:"latitude"`
+	// Longitude in degrees
+	Longitude float64 `json:"longitude"`
+	// An IANA TZ database timezone name. This value will be used to convert rules and deadlines for tariffs, smart charging, and schedules into local time. Defaults to `UTC`.
+	TimezoneName string `json:"timezoneName"`
+}
+
+// MeterInformation: Descriptive information about the meter
+type MeterInformation struct {
+	Brand MeterBrand `json:"brand"`
+	// Meter model
+	Model string `json:"model"`
+	// Name of the site, as set by the user on the device/vendor. If no user-specified name is available, we construct a fallback name using the vendor/device/model names.
+	SiteName string `json:"siteName"`
+	// Meter installation date
+	InstallationDate time.Time `json:"installationDate"`
+}
+
+// MeterEnergyState: Latest information about meter load. `null` values indicate we are unable to determine a value for the field based on the information coming from the vendor.
+type MeterEnergyState struct {
+	// Indicates the current load on the meter.
+	//
+	//  * Positive value: Power is imported from the grid to the site, indicating consumption.
+	//  * Negative value: Power is exported back to the grid, typically when the site generates excess electricity (e.g. from solar panels, or battery discharge).
+	Power *float64 `json:"power"`
+	// Time of last received energy state update.
+	LastUpdated *time.Time `json:"lastUpdated"`
+}
+
+// MeterLocation: Meter's GPS coordinates
+type MeterLocation struct {
+	// Longitude in degrees
+	Longitude *float64 `json:"longitude"`
+	// Latitude in degrees
+	Latitude *float64 `json:"latitude"`
+}
+
+// MeterCapabilities: The specific meter's capabilities for recording energy consumption and production data.
+type MeterCapabilities struct {
+	// Indicates if the meter can measure consumed energy.
+	MeasuresConsumption Capability `json:"measuresConsumption"`
+	// Indicates if the meter can measure energy produced or generated.
+	MeasuresProduction Capability `json:"measuresProduction"`
+}
+
+// PaginatedMeterList: Paginated response of meters
+type PaginatedMeterList struct {
+	// Paginated list of meters
+	Data       []Meter           `json:"data"`
+	Pagination PaginationCursors `json:"pagination"`
+}
+
+type Meter struct {
+	// Unique identifier for the meter object
+	Id string `json:"id"`
+	// The ID of the user that linked this meter.
+	UserId string      `json:"userId"`
+	Vendor MeterVendor `json:"vendor"`
+	// The last time Enode successfully communicated with the vendor or when the meter was initially linked.
+	LastSeen time.Time `json:"lastSeen"`
+	// Whether live data from the meter is currently reachable from Enode's perspective. This 'reachability' may refer to reading from a cache operated by the meter's cloud service if that service has determined that its cache is valid.
+	IsReachable  bool              `json:"isReachable"`
+	Information  MeterInformation  `json:"information"`
+	EnergyState  MeterEnergyState  `json:"energyState"`
+	Location     MeterLocation     `json:"location"`
+	Capabilities MeterCapabilities `json:"capabilities"`
+	// Scopes that the user has granted for this meter.
+	Scopes []string `json:"scopes"`
+}
+
+type ScheduleFilters struct {
+	// An interval composed of two clock times during which this rule applies each day. `to` always resolves to a timestamp after `from`, and thus may span across midnight and fall on the next day.
+	HourMinute *ScheduleFiltersHourMinute `json:"hourMinute,omitempty"`
+	// UTC timestamp from which this rule should apply.
+	FromTimestamp *time.Time `json:"fromTimestamp,omitempty"`
+	// UTC timestamp until which this rule should apply.
+	ToTimestamp *time.Time `json:"toTimestamp,omitempty"`
+	// An array of weekdays to which this rule should apply. A weekday starts with 0 for Monday and ends with 6 for Sunday.
+	Weekdays []int `json:"weekdays,omitempty"`
+}
+
+// ScheduleFiltersHourMinute: An interval composed of two clock times during which this rule applies each day. `to` always resolves to a timestamp after `from`, and thus may span across midnight and fall on the next day.
+type ScheduleFiltersHourMinute struct {
+	// Clock time from which this rule should apply each day.
+	From string `json:"from"`
+	// Clock time until which this rule should apply each day.
+	To string `json:"to"`
+}
+
+type PartialChargeSchedule struct {
+	// Whether this Schedule should be attempting to control the target's charge state.
+	IsEnabled *bool `json:"isEnabled,omitempty"`
+	// When no rule is active, the default charge state for the target.
+	DefaultShouldCharge *bool `json:"defaultShouldCharge,omitempty"`
+	// Each rule sets a value for `shouldCharge`, either `true` or `false`. All other properties of the rule are optional filters that limit the times to which this rule applies.
+	Rules []PartialChargeScheduleRule `json:"rules,omitempty"`
+	// ID of the asset (Vehicle/Charger) to which this schedule applies
+	TargetId   *string               `json:"targetId,omitempty"`
+	TargetType *ChargeableVendorType `json:"targetType,omitempty"`
+	// ID of the Location to which this schedule applies. The behavior of a null value differs based on the `targetType`. For chargers, a null value is essentially ignored and the schedule is applied even if the charger isn't at a charging location. This is designed to prevent schedules from controlling vehicles at public chargers where the user doesn't pay for electricity.
+	LocationId *string `json:"locationId,omitempty"`
+}
+
+type PartialChargeScheduleRule struct {
+	ScheduleFilters
+	// Whether a chargeable asset should want to charge while this rule is active.
+	ShouldCharge bool `json:"shouldCharge"`
+}
+
+type ChargeScheduleResponse struct {
+	// Whether this Schedule should be attempting to control the target's charge state.
+	IsEnabled bool `json:"isEnabled"`
+	// When no rule is active, the default charge state for the target.
+	DefaultShouldCharge bool `json:"defaultShouldCharge"`
+	// Each rule sets a value for `shouldCharge`, either `true` or `false`. All other properties of the rule are optional filters that limit the times to which this rule applies.
+	Rules []PartialChargeScheduleRule `json:"rules"`
+	// ID of the asset (Vehicle/Charger) to which this schedule applies
+	TargetId   string               `json:"targetId"`
+	TargetType ChargeableVendorType `json:"targetType"`
+	// ID of the Location to which this schedule applies. The behavior of a null value differs based on the `targetType`. For chargers, a null value is essentially ignored and the schedule is applied even if the charger isn't at a charging location. This is designed to prevent schedules from controlling vehicles at public chargers where the user doesn't pay for electricity.
+	LocationId *string `json:"locationId"`
+	Id         string  `json:"id"`
+}
+
+type PartialTemperatureSchedule struct {
+	// Whether this Schedule should be attempting to control the HVAC's temperature settings.
+	IsEnabled *bool `json:"isEnabled,omitempty"`
+	// ID of the HVAC asset to which this schedule applies.
+	TargetId   *string         `json:"targetId,omitempty"`
+	TargetType *HvacTargetType `json:"targetType,omitempty"`
+	// The target state to apply when no rules are active.
+	DefaultTargetState *TemperatureTargetState `json:"defaultTargetState,omitempty"`
+	// Each rule sets a value for `holdType` and associated settings. All other properties of the rule are optional filters that limit the times to which this rule applies.
+	Rules []PartialTemperatureScheduleRule `json:"rules,omitempty"`
+}
+
+type PartialTemperatureScheduleRule struct {
+	ScheduleFilters
+	// The target state to apply when this rule is active.
+	TargetState TemperatureTargetState `json:"targetState"`
+}
+
+type TemperatureScheduleResponse struct {
+	// Whether this Schedule should be attempting to control the HVAC's temperature settings.
+	IsEnabled bool `json:"isEnabled"`
+	// ID of the HVAC asset to which this schedule applies.
+	TargetId   string         `json:"targetId"`
+	TargetType HvacTargetType `json:"targetType"`
+	// The target state to apply when no rules are active.
+	DefaultTargetState TemperatureTargetState `json:"defaultTargetState"`
+	// Each rule sets a value for `holdType` and associated settings. All other properties of the rule are optional filters that limit the times to which this rule applies.
+	Rules []PartialTemperatureScheduleRule `json:"rules"`
+	Id    string                           `json:"id"`
+}
+
+// PaginatedScheduleList: Paginated response of schedules
+type PaginatedScheduleList struct {
+	// Paginated list of schedules
+	Data       []ScheduleResponse `json:"data"`
+	Pagination PaginationCursors  `json:"pagination"`
+}
+
+type ChargeSchedule struct {
+	// Whether this Schedule should be attempting to control the target's charge state.
+	IsEnabled bool `json:"isEnabled"`
+	// When no rule is active, the default charge state for the target.
+	DefaultShouldCharge bool `json:"defaultShouldCharge"`
+	// Each rule sets a value for `shouldCharge`, either `true` or `false`. All other properties of the rule are optional filters that limit the times to which this rule applies.
+	Rules []PartialChargeScheduleRule `json:"rules"`
+	// ID of the asset (Vehicle/Charger) to which this schedule applies
+	TargetId   string               `json:"targetId"`
+	TargetType ChargeableVendorType `json:"targetType"`
+	// ID of the Location to which this schedule applies. The behavior of a null value differs based on the `targetType`. For chargers, a null value is essentially ignored and the schedule is applied even if the charger isn't at a charging location. This is designed to prevent schedules from controlling vehicles at public chargers where the user doesn't pay for electricity.
+	LocationId *string `json:"locationId"`
+}
+
+type TemperatureSchedule struct {
+	// Whether this Schedule should be attempting to control the HVAC's temperature settings.
+	IsEnabled bool `json:"isEnabled"`
+	// ID of the HVAC asset to which this schedule applies.
+	TargetId   string         `json:"targetId"`
+	TargetType HvacTargetType `json:"targetType"`
+	// The target state to apply when no rules are active.
+	DefaultTargetState TemperatureTargetState `json:"defaultTargetState"`
+	// Each rule sets a value for `holdType` and associated settings. All other properties of the rule are optional filters that limit the times to which this rule applies.
+	Rules []PartialTemperatureScheduleRule `json:"rules"`
+}
+
+// ChargeScheduleStatus: The state of a Charge Schedule at a particular point in time.
+type ChargeScheduleStatus struct {
+	// ID of the schedule.
+	ScheduleId   string                           `json:"scheduleId"`
+	ScheduleType ChargeScheduleStatusScheduleType `json:"scheduleType"`
+	// Time at which any value of the status last changed
+	ChangedAt time.Time     `json:"changedAt"`
+	State     ScheduleState `json:"state"`
+	// Whether the target is currently actually charging
+	IsCharging bool `json:"isCharging"`
+	// Whether the target is currently expected to be charging
+	IsChargingExpected bool `json:"isChargingExpected"`
+	// Collection of booleans that - when combined via AND operator - forms the `isChargingExpected` value
+	IsChargingExpectedParts ChargeScheduleStatusIsChargingExpectedParts `json:"isChargingExpectedParts"`
+	// List of upcoming transitions of the `shouldCharge` or `targetTemperature` value. A maximum of 2 items are returned.
+	UpcomingTransitions []ChargeScheduleStatusUpcomingTransition `json:"upcomingTransitions"`
+	SmartOverride       *SmartOverrideStatus                     `json:"smartOverride"`
+}
+
+// ChargeScheduleStatusIsChargingExpectedParts: Collection of booleans that - when combined via AND operator - forms the `isChargingExpected` value
+type ChargeScheduleStatusIsChargingExpectedParts struct {
+	NeedsCharge  bool `json:"needsCharge"`
+	IsPluggedIn  bool `json:"isPluggedIn"`
+	ShouldCharge bool `json:"shouldCharge"`
+}
+
+type ChargeScheduleStatusUpcomingTransition struct {
+	// ISO8601 UTC timestamp
+	At           time.Time `json:"at"`
+	ShouldCharge bool      `json:"shouldCharge"`
+}
+
+// TemperatureScheduleStatus: The state of a Temperature Schedule at a particular point in time.
+type TemperatureScheduleStatus struct {
+	ScheduleId   string                                `json:"scheduleId"`
+	ScheduleType TemperatureScheduleStatusScheduleType `json:"scheduleType"`
+	// Time at which any value of the status last changed
+	ChangedAt time.Time                       `json:"changedAt"`
+	State     ScheduleState                   `json:"state"`
+	Current   *NullableTemperatureTargetState `json:"current"`
+	Expected  TemperatureTargetState          `json:"expected"`
+	// List of upcoming transitions. A maximum of 2 items are returned.
+	UpcomingTransitions []TemperatureScheduleStatusUpcomingTransition `json:"upcomingTransitions"`
+}
+
+type TemperatureScheduleStatusUpcomingTransition struct {
+	// ISO8601 UTC timestamp
+	At     time.Time              `json:"at"`
+	Target TemperatureTargetState `json:"target"`
+}
+
+type Aggregates struct {
+	// The minimum amount for this period.
+	Min float64 `json:"min"`
+	// The maximum amount for this period.
+	Max float64 `json:"max"`
+	// The average for this period.
+	Mean float64 `json:"mean"`
+}
+
+type NullableAggregates struct {
+	// The minimum amount for this period.
+	Min *float64 `json:"min"`
+	// The maximum amount for this period.
+	Max *float64 `json:"max"`
+	// The average for this period.
+	Mean *float64 `json:"mean"`
+}
+
+type ChargingStatisticsTimeseriesItem struct {
+	// Aggregate statistics in kW for the time bucket
+	Kw Aggregates `json:"kw"`
+	// Total power consumption in kWh for the time bucket
+	KwhSum float64 `json:"kwhSum"`
+	// Aggregate per kWh price statistics for the time bucket. Currency is `NOK` for Norway, Sweden, Denmark, Finland, Estonia, Latvia, Lithuania; and `EUR` for Austria, Belgium, Germany, France, Netherlands, Poland, Spain. Unless different price data has been provided via the Tariffs endpoints
+	Price Aggregates `json:"price"`
+	// Total cost for the consumed kWh for the time bucket
+	CostSum float64 `json:"costSum"`
+	// Aggregate per kWh price statistics for the time bucket if Smart Charging has actively stopped consumption from occurring during this `resolution`. This can be used to show the potential price if Smart Charging had not been active. Currency is `NOK` for Norway, Sweden, Denmark, Finland, Estonia, Latvia, Lithuania; and `EUR` for Austria, Belgium, Germany, France, Netherlands, Poland, Spain. Unless different price data has been provided via the Tariffs endpoints
+	NonSmartPrice NullableAggregates `json:"nonSmartPrice"`
+	// Total estimated savings achieved by Smart Charging for this `resolution`. If `null`, no part of this `resolution` was impacted by Smart Charging. Currency is `NOK` for Norway, Sweden, Denmark, Finland, Estonia, Latvia, Lithuania; and `EUR` for Austria, Belgium, Germany, France, Netherlands, Poland, Spain. Unless different price data has been provided via the Tariffs endpoints
+	EstimatedSavings *float64 `json:"estimatedSavings"`
+	// The timestamp that represents the start of this `resolution`
+	Date time.Time `json:"date"`
+}
+
+type ChargingStatisticsTimeseries []ChargingStatisticsTimeseriesItem
+
+type SessionsStatisticsTimeseriesItem struct {
+	// Aggregate statistics in kW for the session
+	Kw Aggregates `json:"kw"`
+	// Total power consumption in kWh for the session
+	KwhSum float64 `json:"kwhSum"`
+	// Aggregate per kWh price statistics for the session. Currency is `NOK` for Norway, Sweden, Denmark, Finland, Estonia, Latvia, Lithuania; and `EUR` for Austria, Belgium, Germany, France, Netherlands, Poland, Spain. Unless different price data has been provided via the Tariffs endpoints
+	Price Aggregates `json:"price"`
+	// Total cost for the consumed kWh for this session
+	CostSum float64 `json:"costSum"`
+	// ID of the asset for this session.
+	Id string `json:"id"`
+	// Charging Location ID for this session.
+	LocationId *string `json:"locationId"`
+	// Start time of the session.
+	From time.Time `json:"from"`
+	// End time of the session.
+	To time.Time `json:"to"`
+	// If Smart Charging has actively stopped the charging session from happening at another time; these fields show the aggregate per kWh price statistics that would have occurred without Smart Charging. This can be used to show the potential price if Smart Charging had not been active. This is expressed in NOK unless different price data has been provided via the Tariffs endpoints
+	NonSmartPrice NullableAggregates `json:"nonSmartPrice"`
+	// Total estimated savings achieved by Smart Charging for this session. If null, no part of this session was impacted by Smart Charging. This is expressed in NOK unless different price data has been provided via the Tariffs endpoints
+	EstimatedSavings *float64 `json:"estimatedSavings"`
+}
+
+type SessionsStatisticsTimeseries []SessionsStatisticsTimeseriesItem
+
+type ProductionStatisticsParams struct {
+	// The ID of the User
+	UserId string `json:"userId"`
+}
+
+type ProductionStatisticsTimeseriesItem struct {
+	// Aggregate statistics for production rate in kW
+	Kw Aggregates `json:"kw"`
+	// Total power production in kWh
+	KwhSum float64 `json:"kwhSum"`
+	// Aggregate per kWh price statistics for the time bucket. This is expressed in NOK unless different price data has been provided via the Tariffs endpoints
+	Price Aggregates `json:"price"`
+	// Uses the local day ahead market price to determine the total value of the energy produced during the time bucket. Requires the user to have a [Location](/api/reference#locations) at the same geo coordinates as the inverter itself. This is expressed in NOK unless different price data has been provided via the Tariffs endpoints
+	EarningsSum float64 `json:"earningsSum"`
+	// The start timestamp of this sample. If `utcOffset` has been defined, this value will be offset accordingly.
+	Date time.Time `json:"date"`
+}
+
+type ProductionStatisticsTimeseries []ProductionStatisticsTimeseriesItem
+
+type InverterStatisticsTimeseriesBucket struct {
+	// Date (UTC) at the start of the data point.
+	Date time.Time `json:"date"`
+	// The energy produced (kWh) in the specified interval.
+	Value float64 `json:"value"`
+}
+
+type InverterStatisticsBuckets struct {
+	// The unit of measurement for power production data. Always kWh.
+	Unit InverterStatisticsBucketsUnit `json:"unit"`
+	// An array of data points representing the power production statistics.
+	Data []InverterStatisticsTimeseriesBucket `json:"data"`
+}
+
+type InverterStatistics struct {
+	// Contains information about power production statistics.
+	Production InverterStatisticsBuckets `json:"production"`
+}
+
+// TariffRateName: Per-tariff unique interval name
+type TariffRateName string
+
+// TariffIntervalCost: Rate cost (decimal string)
+type TariffIntervalCost string
+
+type TariffRate struct {
+	Name TariffRateName     `json:"name"`
+	Cost TariffIntervalCost `json:"cost"`
+}
+
+type Tariff []TariffRate
+
+type LocationTariffScheduleItem struct {
+	// Weekday to apply the named tariff. A weekday starts with 0 for Monday, and ends with 6 for Sunday. If not specified, named tariff is applied for entire week
+	Weekday int `json:"weekday"`
+	// Interval from time (inclusive, UTC)
+	FromHourMinute string `json:"fromHourMinute"`
+	// Interval to time (exclusive, UTC)
+	ToHourMinute string `json:"toHourMinute"`
+	// Energy Provider Tariff ID
+	TariffId string `json:"tariffId"`
+	// Rate name
+	TariffName string `json:"tariffName"`
+}
+
+type LocationTariffSchedule []LocationTariffScheduleItem
+
+type TariffRateInterval struct {
+	// Rate name
+	Name string `json:"name"`
+	// An array of weekdays to apply the named tariff. A weekday starts with 0 for Monday, and ends with 6 for Sunday. If not specified, named tariff is applied for entire week
+	Weekdays []int `json:"weekdays,omitempty"`
+	// Interval from time (inclusive), format `HH:mm`. Interpreted in the timezone specified in the `timezoneName` field of the Location.
+	From string `json:"from"`
+	// Interval to time (exclusive), format `HH:mm`. Interpreted in the timezone specified in the `timezoneName` field of the Location.
+	To string `json:"to"`
+}
+
+type LocationTariffInterval struct {
+	// The Tariff ID
+	TariffId string `json:"tariffId"`
+	// List of time intervals at which to apply the specified tariff rates.
+	TariffIntervals []TariffRateInterval `json:"tariffIntervals"`
+}
+
+type UsersListEntry struct {
+	// ID of the user.
+	Id string `json:"id"`
+	// ISO8601 UTC timestamp
+	CreatedAt *time.Time `json:"createdAt"`
+	// Scopes for the user.
+	//
+	// Deprecated: Scopes is deprecated by the Enode API.
+	Scopes []string `json:"scopes"`
+}
+
+// PaginatedUsersListResponse: Paginated response of users.
+type PaginatedUsersListResponse struct {
+	// Paginated list of users.
+	Data       []UsersListEntry  `json:"data"`
+	Pagination PaginationCursors `json:"pagination"`
+}
+
+type UserResponse struct {
+	// The user's ID.
+	Id            string                     `json:"id"`
+	LinkedVendors []UserResponseLinkedVendor `json:"linkedVendors"`
+}
+
+type UserResponseLinkedVendor struct {
+	// Machine-friendly name of the vendor. Use this in API requests.
+	Vendor     Vendor     `json:"vendor"`
+	VendorType VendorType `json:"vendorType"`
+	// Whether the provided credentials are currently valid. A `false` value here indicates that a credential has become invalidated, and [Link User](/api/reference#postUsersUseridLink) should be used to get new credentials from this user.
+	IsValid bool `json:"isValid"`
+}
+
+type LinkUserPayload struct {
+	// By specifying a vendor, the [brand selection step](/docs/link-ui#step-2-brand-and-service-selection) in Link UI will be skipped. Instead, your user will go directly to the [service selection view](/docs/link-ui#step-2-brand-and-service-selection) (if applicable for the specified vendor), or to the [review data access](/docs/link-ui#step-3-review-data-access) step.
+	Vendor *Vendor `json:"vendor,omitempty"`
+	// Sets the asset type your user can link through Link UI within the linking session. The [brand selection step](/docs/link-ui#step-2-brand-and-service-selection) selection step will show vendors available for the specified asset type.
+	VendorType VendorType `json:"vendorType"`
+	// Sets the language for Link UI. We recommend matching this to the language of your application. If your language is not yet supported, you can specify `browser` which will assess your user's browser language via the `Accept-Language` header ([learn more](https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Accept-Language)), and falls back to `en-US` if no match is found.
+	Language LinkUserPayloadLanguage `json:"language"`
+	// The [scope(s)](#scopes) you wish to request access to when linking an asset ([learn more](#scopes)). The scopes will be presented for user consent in Link UI in the [review data access](/docs/link-ui#step-3-review-data-access) step. The `<vendorType>:read:data` scope is required for any asset type (e.g. if you wish to link a `battery`, you must request `battery:read:data`).
+	Scopes []Scopes `json:"scopes"`
+	// The URI your user will be redirected to after completing the linking flow (from the [last step in the Link UI flow](/docs/link-ui#step-6-success)). If your user exits the flow before completing, they will be redirected to this URI with additional `error` and `error_message` query params describing the reason for exiting.
+	RedirectUri string `json:"redirectUri"`
+	// Sets the color scheme (light or dark) of Link UI. If your UI is only available in one of the color schemes, and does not follow the operating system color scheme, we recommend setting either `light` or `dark`. Defaults to  `system`, which follows the users operating system settings.
+	ColorScheme *LinkUserPayloadColorScheme `json:"colorScheme,omitempty"`
+}
+
+type LinkUserResponse struct {
+	// Use this URL to present Link UI to your user via [mobile in-app browsers](/docs/link-ui#mobile-in-app-browsers) or [web redirects](/docs/link-ui#web-redirects).
+	LinkUrl string `json:"linkUrl"`
+	// Use this token to initialize the [Link SDKs](/docs/link-sdks) if you wish to present Link UI to your users via our [mobile SDKs](/docs/link-ui#mobile-sd-ks).
+	LinkToken string `json:"linkToken"`
+}
+
+// ConnectionsLimitReachedProblem: Webhook already exists problem error
+type ConnectionsLimitReachedProblem struct {
+	// A URI reference that identifies the problem type.
+	Type string `json:"type"`
+	// A short, human-readable summary of the problem type.
+	Title string `json:"title"`
+	// A human-readable explanation specific to this occurrence of the problem.
+	Detail string `json:"detail"`
+}
+
+// VehicleInformation: Descriptive information about the Vehicle.
+type VehicleInformation struct {
+	// Vehicle VIN
+	Vin *string `json:"vin"`
+	// Vehicle brand
+	Brand *VehicleBrand `json:"brand"`
+	// Vehicle model
+	Model *string `json:"model"`
+	// Vehicle production year
+	Year *float64 `json:"year"`
+	// User-defined vehicle nickname.
+	DisplayName *string `json:"displayName"`
+}
+
+// VehicleChargeState: Latest information about the electric or hybrid battery. `null` values indicate we are unable to determine a value for the field based on the information coming from the vendor.
+type VehicleChargeState struct {
+	// Remaining battery in percent
+	BatteryLevel *float64 `json:"batteryLevel"`
+	// Estimated remaining kilometers
+	Range *float64 `json:"range"`
+	// Indicates whether the vehicle is connected to a charging box (regardless of whether it is actually charging)
+	IsPluggedIn *bool `json:"isPluggedIn"`
+	// Indicates whether the vehicle is currently receiving power from the vehicle and actively charging its battery.
+	IsCharging *bool `json:"isCharging"`
+	// Indicates whether the vehicle is fully charged. 'Fully charged' may be a `batteryLevel` of less than 100 if a `chargeLimit` has been configured in the vehicle.
+	IsFullyCharged *bool `json:"isFullyCharged"`
+	// Vehicle's usable battery capacity in kWh.
+	BatteryCapacity *float64 `json:"batteryCapacity"`
+	// Charge limit, as a percent of `batteryCapacity`.
+	ChargeLimit *float64 `json:"chargeLimit"`
+	// The current charge rate in kW.
+	//
+	// This property is only available when the vehicle is charging, and is `null` any other time.
+	ChargeRate *float64 `json:"chargeRate"`
+	// Estimated time until the current charging intent is completed, in minutes.
+	//
+	// This property is only available when the vehicle is charging, and is `null` any other time.
+	ChargeTimeRemaining *float64 `json:"chargeTimeRemaining"`
+	// Time of last received charge state update
+	LastUpdated *time.Time `json:"lastUpdated"`
+	// The maximum current that the vehicle can draw from the charger, in amps.
+	MaxCurrent         *float64                  `json:"maxCurrent"`
+	PowerDeliveryState VehiclePowerDeliveryState `json:"powerDeliveryState"`
+}
+
+type PartialVehicleSmartChargingPolicy struct {
+	// When enabled, this vehicle's charging status may be controlled by [Smart Charging](/docs/smart-charging/introduction)
+	IsEnabled *bool `json:"isEnabled,omitempty"`
+	// The hour-minute deadline for fully charging the vehicle. Smart charging does not work without setting a deadline. If a timezone is set on the charging location at which the smart charging occurs, the deadline is interpreted in that timezone, otherwise UTC is used.
+	Deadline *string `json:"deadline,omitempty"`
+	// Proceed to charge promptly, disregarding energy prices, until the vehicle achieves this preset minimum limit. Please note that this limit cannot exceed the vehicle's maximum charge limit. The default setting is zero. The unit of measure is percentage.
+	MinimumChargeLimit *float64 `json:"minimumChargeLimit,omitempty"`
+}
+
+type VehicleSmartChargingPolicy struct {
+	// When enabled, this vehicle's charging status may be controlled by [Smart Charging](/docs/smart-charging/introduction)
+	IsEnabled bool `json:"isEnabled"`
+	// The hour-minute deadline for fully charging the vehicle. Smart charging does not work without setting a deadline. If a timezone is set on the charging location at which the smart charging occurs, the deadline is interpreted in that timezone, otherwise UTC is used.
+	Deadline string `json:"deadline"`
+	// Proceed to charge promptly, disregarding energy prices, until the vehicle achieves this preset minimum limit. Please note that this limit cannot exceed the vehicle's maximum charge limit. The default setting is zero. The unit of measure is percentage.
+	MinimumChargeLimit float64 `json:"minimumChargeLimit"`
+}
+
+// VehicleLocation: Vehicle's GPS coordinates with timestamp
+type VehicleLocation struct {
+	// Longitude in degrees
+	Longitude *float64 `json:"longitude"`
+	// Latitude in degrees
+	Latitude *float64 `json:"latitude"`
+	// Time of last received location
+	LastUpdated *time.Time `json:"lastUpdated"`
+}
+
+// VehicleOdometer: Vehicle's odometer reading in kilometers with timestamp
+type VehicleOdometer struct {
+	// Odometer in kilometers
+	Distance *float64 `json:"distance"`
+	// Time of the last odometer update, in kilometers
+	LastUpdated *time.Time `json:"lastUpdated"`
+}
+
+// VehicleCapabilities: A collection of descriptors that describe the capabilities of this specific vehicle
+type VehicleCapabilities struct {
+	// Full availability of information data.
+	Information Capability `json:"information"`
+	// Full availability of chargeState data.
+	ChargeState Capability `json:"chargeState"`
+	// Full availability of location data.
+	Location Capability `json:"location"`
+	// Full availability of odometer data.
+	Odometer Capability `json:"odometer"`
+	// Supports setting the maximum charge current.
+	SetMaxCurrent Capability `json:"setMaxCurrent"`
+	// Supports START charging command.
+	StartCharging Capability `json:"startCharging"`
+	// Supports STOP charging command.
+	StopCharging Capability `json:"stopCharging"`
+	// Support for smartCharging. This value is dynamic and may change over time.
+	SmartCharging Capability `json:"smartCharging"`
+}
+
+type Vehicle struct {
+	// Vehicle ID
+	Id string `json:"id"`
+	// The ID of the user that linked this vehicle.
+	UserId string        `json:"userId"`
+	Vendor VehicleVendor `json:"vendor"`
+	// The last time Enode received live data or when the vehicle was initially linked.
+	LastSeen time.Time `json:"lastSeen"`
+	// Whether live data from the vehicle is currently available from Enode's perspective. This 'reachability' may refer to reading from a cache operated by the vehicle's cloud service if that service has determined that its cache is valid.
+	IsReachable         *bool                      `json:"isReachable"`
+	Information         VehicleInformation         `json:"information"`
+	ChargeState         VehicleChargeState         `json:"chargeState"`
+	SmartChargingPolicy VehicleSmartChargingPolicy `json:"smartChargingPolicy"`
+	Location            VehicleLocation            `json:"location"`
+	Odometer            VehicleOdometer            `json:"odometer"`
+	Capabilities        VehicleCapabilities        `json:"capabilities"`
+	// Scopes that the user has granted for this vehicle.
+	Scopes []Scopes `json:"scopes"`
+}
+
+type PaginatedVehicleList struct {
+	Data       []VehicleWithLocation `json:"data"`
+	Pagination PaginationCursors     `json:"pagination"`
+}
+
+type VehicleWithLocation struct {
+	Vehicle
+	// ID of the location the vehicle is currently positioned at (if any).
+	LocationId *string `json:"locationId"`
+}
+
+type VehicleChargeAction struct {
+	// The ID of the action.
+	Id string `json:"id"`
+	// The ID of the user that owns the target of this action.
+	UserId string `json:"userId"`
+	// Time when this action was created
+	CreatedAt time.Time `json:"createdAt"`
+	// Time when this action was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Time when the action transitioned to a non-pending state.
+	CompletedAt *time.Time  `json:"completedAt"`
+	State       ActionState `json:"state"`
+	// ID of the target which this action is controlling.
+	TargetId string `json:"targetId"`
+	// The charging action to perform
+	Kind          ChargingAction                `json:"kind"`
+	FailureReason *ChargingActionFailureReason  `json:"failureReason"`
+	TargetType    VehicleChargeActionTargetType `json:"targetType"`
+}
+
+type VehicleMaxCurrentAction struct {
+	// The ID of the action.
+	Id string `json:"id"`
+	// The ID of the user that owns the target of this action.
+	UserId string `json:"userId"`
+	// Time when this action was created
+	CreatedAt time.Time `json:"createdAt"`
+	// Time when this action was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Time when the action transitioned to a non-pending state.
+	CompletedAt *time.Time  `json:"completedAt"`
+	State       ActionState `json:"state"`
+	// ID of the entity which this action is controlling.
+	TargetId      string                        `json:"targetId"`
+	TargetState   TargetMaxCurrent              `json:"targetState"`
+	FailureReason *ChargingActionFailureReason  `json:"failureReason"`
+	TargetType    VehicleChargeActionTargetType `json:"targetType"`
+}
+
+// CurrencyCode: Currency in which monetary amounts are denominated (ISO 4217 3-character Alpha code)
+type CurrencyCode string
+
+type MonetaryAmount float64
+
+type VehicleSmartChargingPlan struct {
+	// ID of the Smart Charging Plan
+	Id string `json:"id"`
+	// ID of the Vehicle to which the Smart Charging Plan belongs
+	VehicleId string `json:"vehicleId"`
+	// ID of the User to which the Smart Charging Plan belongs
+	UserId string `json:"userId"`
+	// ID of the charging location at which the Smart Charging Plan is being executed. Null for Smart Charging Plans started before March 2022.
+	LocationId *string `json:"locationId"`
+	// Vendor of the Vehicle to which the Smart Charging Plan belongs
+	Vendor   VehicleVendor `json:"vendor"`
+	Currency CurrencyCode  `json:"currency"`
+	// Estimated cost of charging, if smart charging were not on for the vehicle
+	NonSmartCost MonetaryAmount `json:"nonSmartCost"`
+	// Estimated cost of charging, achieved by this Smart Charging Plan
+	SmartCost *MonetaryAmount `json:"smartCost"`
+	// Time at which the current Smart Charging Plan will stop the vehicle's charging. The charging will be resumed when prices are optimal.
+	StopAt time.Time `json:"stopAt"`
+	// Time at which the current Smart Charging Plan will resume the vehicle's charging, when prices are optimal.
+	StartAt *time.Time `json:"startAt"`
+	// Time at which charging is estimated to be complete, due to the current Smart Charging Plan
+	EstimatedFinishAt time.Time `json:"estimatedFinishAt"`
+	// During a Smart Charging Plan, the first time at which the vehicle's charging was confirmed as stopped. If a vehicle stops charging outside of smart charging's control (e.g., via a 3rd party app), this will be populated with that timestamp.
+	StopConfirmedAt *time.Time `json:"stopConfirmedAt"`
+	// During a Smart Charging Plan, the first time at which the vehicle's charging was confirmed as started. If a vehicle starts charging outside of smart charging's control (e.g., via a 3rd party app), this will be populated with that timestamp.
+	StartConfirmedAt *time.Time `json:"startConfirmedAt"`
+	// The time at which the Smart Charging Plan ended
+	EndedAt *time.Time `json:"endedAt"`
+	// The final state of the Smart Charging Plan when it ended
+	FinalState *VehicleSmartChargingPlanFinalState `json:"finalState"`
+	// If the finalState was `PLAN:ENDED:FAILED`, failureCondition is populated with the condition in which the Smart Charging Plan failed. If the finalState was `PLAN:ENDED:FINISHED`, failureCondition is populated with `FINISHED_LATE` if the plan was more than 15% late.
+	FailureCondition *FailureCondition `json:"failureCondition"`
+	// Deprecated, replaced by `smartOverride` from [Get Vehicle Smart Charging Status](/api/reference#getVehiclesVehicleidSmartchargingstatus)
+	ExternalStart *SmartOverrideStatus `json:"externalStart"`
+	// Deprecated. Replaced by `locationId`. ID of the charging location at which the Smart Charging Plan is being executed. Null for Smart Charging Plans started before March 2022.
+	//
+	// Deprecated: ChargingLocationId is deprecated by the Enode API.
+	ChargingLocationId *string `json:"chargingLocationId"`
+}
+
+type Consideration struct {
+	// The vehicle is plugged in
+	IsPluggedIn bool `json:"isPluggedIn"`
+	// The vehicle is charging
+	IsCharging bool `json:"isCharging"`
+	// The vehicle is located at a configured Charging Location
+	AtChargingLocation bool `json:"atChargingLocation"`
+	// A good estimate is available of how much time it will take to charge.
+	HasTimeEstimate bool `json:"hasTimeEstimate"`
+}
+
+type UpdateFirehoseWebhookPayload struct {
+	// A cryptographically secure secret, generated and provided by your client. Refer to [Generating a secret](/docs/webhooks#generating-a-secret) for details on how to do this.
+	Secret string `json:"secret"`
+	// The HTTPS URL where webhook payloads should be sent to.
+	Url string `json:"url"`
+}
+
+type DeleteFirehoseWebhook struct {
+	// The HTTPS url of the webhook to be deleted
+	Url string `json:"url"`
+}
+
+// WebhookNotFoundProblem: Webhook not found problem error
+type WebhookNotFoundProblem struct {
+	// A URI reference that identifies the problem type.
+	Type string `json:"type"`
+	// A short, human-readable summary of the problem type.
+	Title string `json:"title"`
+	// A human-readable explanation specific to this occurrence of the problem.
+	Detail string `json:"detail"`
+}
+
+type TestFirehoseWebhook struct {
+	// Indicates if the test webhook event was delivered successfully to your configured webhook endpoint.
+	Status TestFirehoseWebhookStatus `json:"status"`
+	// Human readable description of the test outcome.
+	Description string `json:"description"`
+	// Null if status is `FAILED`.
+	Response *TestFirehoseWebhookResponse `json:"response"`
+}
+
+// TestFirehoseWebhookResponse: Null if status is `FAILED`.
+type TestFirehoseWebhookResponse struct {
+	// Status code returned by your configured webhook endpoint
+	Code float64 `json:"code"`
+	// Body returned by your configured webhook endpoint
+	Body string `json:"body"`
+	// Headers returned by your configured webhook endpoint
+	Headers []string `json:"headers"`
+}
+
+// WebhookCreatePayload: Fields to update the webhook with.
+type WebhookCreatePayload struct {
+	// The HTTPS URL that webhook payloads should be sent to.
+	Url string `json:"url"`
+	// A cryptographically secure secret, generated and provided by your client. Refer to [Generating a secret](/docs/webhooks#generating-a-secret) for details on how to do this.
+	Secret string `json:"secret"`
+	// The events which will trigger the webhook. Available events: `*`, `user:vehicle:discovered`, `user:vehicle:updated`, `user:vehicle:deleted`, `user:vehicle:smart-charging-status-updated`, `user:charge-action:updated`, `user:vendor-action:updated`, `user:schedule:execution-updated`, `user:charger:discovered`, `user:charger:updated`, `user:charger:deleted`, `user:hvac:discovered`, `user:hvac:updated`, `user:hvac:deleted`, `user:inverter:discovered`, `user:inverter:updated`, `user:inverter:deleted`, `user:credentials:invalidated`, `user:battery:discovered`, `user:battery:updated`, `user:battery:deleted`, `enode:webhook:test`, `user:meter:discovered`, `user:meter:updated`, `user:meter:deleted`
+	Events []WebhookEvent `json:"events,omitempty"`
+	// If provided, webhook payloads will be generated for this version. If not provided or `null`, the default API version of the client will be used when generating webhook payloads. Valid versions: `2024-01-01`, `2023-08-01`, `2023-05-01`, `2023-04-15`, `2023-04-01`, `2023-03-01`, `2023-02-01`
+	ApiVersion *string `json:"apiVersion,omitempty"`
+	// If provided, the authentication header will be sent with each webhook request.
+	Authentication *WebhookCreatePayloadAuthentication `json:"authentication,omitempty"`
+}
+
+// WebhookCreatePayloadAuthentication: If provided, the authentication header will be sent with each webhook request.
+type WebhookCreatePayloadAuthentication struct {
+	// Authentication header name.
+	HeaderName string `json:"headerName"`
+	// Authentication header value.
+	HeaderValue string `json:"headerValue"`
+}
+
+// WebhookResponse: Webhook entity.
+type WebhookResponse struct {
+	// Unique identifier for the webhook.
+	Id string `json:"id"`
+	// The HTTPS URL that webhook payloads should be sent to.
+	Url string `json:"url"`
+	// The events which will trigger the webhook.
+	Events []string `json:"events,omitempty"`
+	// Time when a delivery to this webhook was last successful.
+	LastSuccess string `json:"lastSuccess"`
+	// Indicates whether the webhook is active or not.
+	IsActive bool `json:"isActive"`
+	// Time when the webhook was created.
+	CreatedAt string `json:"createdAt"`
+	// API version of the payload. If null, the default API version of the client will be used when generating webhook payloads.
+	ApiVersion     *string                        `json:"apiVersion"`
+	Authentication *WebhookResponseAuthentication `json:"authentication"`
+}
+
+type WebhookResponseAuthentication struct {
+	HeaderName string `json:"headerName"`
+}
+
+// WebhookAlreadyExistsProblem: Webhook already exists problem error
+type WebhookAlreadyExistsProblem struct {
+	// A URI reference that identifies the problem type.
+	Type string `json:"type"`
+	// A short, human-readable summary of the problem type.
+	Title string `json:"title"`
+	// A human-readable explanation specific to this occurrence of the problem.
+	Detail string `json:"detail"`
+}
+
+// WebhookUpdatePayload: Fields to update the webhook with.
+type WebhookUpdatePayload struct {
+	// The HTTPS URL that webhook payloads should be sent to.
+	Url *string `json:"url,omitempty"`
+	// A cryptographically secure secret, generated and provided by your client. Refer to [Generating a secret](/docs/webhooks#generating-a-secret) for details on how to do this.
+	Secret *string `json:"secret,omitempty"`
+	// The events which will trigger the webhook. Available events: `*`, `user:vehicle:discovered`, `user:vehicle:updated`, `user:vehicle:deleted`, `user:vehicle:smart-charging-status-updated`, `user:charge-action:updated`, `user:vendor-action:updated`, `user:schedule:execution-updated`, `user:charger:discovered`, `user:charger:updated`, `user:charger:deleted`, `user:hvac:discovered`, `user:hvac:updated`, `user:hvac:deleted`, `user:inverter:discovered`, `user:inverter:updated`, `user:inverter:deleted`, `user:credentials:invalidated`, `user:battery:discovered`, `user:battery:updated`, `user:battery:deleted`, `enode:webhook:test`, `user:meter:discovered`, `user:meter:updated`, `user:meter:deleted`
+	Events []WebhookEvent `json:"events,omitempty"`
+	// If provided, webhook payloads will be generated for this version. If not provided or `null`, the default API version of the client will be used when generating webhook payloads. Valid versions: `2024-01-01`, `2023-08-01`, `2023-05-01`, `2023-04-15`, `2023-04-01`, `2023-03-01`, `2023-02-01`
+	ApiVersion *string `json:"apiVersion,omitempty"`
+	// If provided, the authentication header will be sent with each webhook request.
+	Authentication *WebhookUpdatePayloadAuthentication `json:"authentication,omitempty"`
+}
+
+// WebhookUpdatePayloadAuthentication: If provided, the authentication header will be sent with each webhook request.
+type WebhookUpdatePayloadAuthentication struct {
+	// Authentication header name.
+	HeaderName string `json:"headerName"`
+	// Authentication header value.
+	HeaderValue string `json:"headerValue"`
+}
+
+// PaginatedWebhookList: Paginated response of webhooks.
+type PaginatedWebhookList struct {
+	// Paginated list of webhooks.
+	Data       []WebhookResponse `json:"data"`
+	Pagination PaginationCursors `json:"pagination"`
+}
+
+type ChargerHealth struct {
+	Vendor      ChargerVendor `json:"vendor"`
+	DisplayName ChargerBrand  `json:"displayName"`
+	// Ready-state of the Vendor. Currently always `READY`.
+	Status VendorHealthStatus `json:"status"`
+	// The name of the first party service or app that the user normally logs into.
+	PortalName string `json:"portalName"`
+	// Ready-state for linking with the Vendor.
+	//
+	//   * `READY`: The Vendor is ready to be linked with.
+	//   * `ELEVATED_ERROR_RATE`: Multiple attempts may be needed to link successfully. We are showing a warning in Link UI.
+	//   * `OUTAGE`: The Vendor is currently not available for linking. We will show an error in Link UI and not let the user attempt to link with the Vendor.
+	LinkingStatus VendorHealthStatus `json:"linkingStatus"`
+}
+
+type VehicleHealth struct {
+	Vendor      VehicleVendor `json:"vendor"`
+	DisplayName VehicleBrand  `json:"displayName"`
+	// Ready-state of the Vendor. Currently always `READY`.
+	Status VendorHealthStatus `json:"status"`
+	// The name of the first party service or app that the user normally logs into.
+	PortalName string `json:"portalName"`
+	// Ready-state for linking with the Vendor.
+	//
+	//   * `READY`: The Vendor is ready to be linked with.
+	//   * `ELEVATED_ERROR_RATE`: Multiple attempts may be needed to link successfully. We are showing a warning in Link UI.
+	//   * `OUTAGE`: The Vendor is currently not available for linking. We will show an error in Link UI and not let the user attempt to link with the Vendor.
+	LinkingStatus VendorHealthStatus `json:"linkingStatus"`
+}
+
+type InverterHealth struct {
+	Vendor      InverterVendor `json:"vendor"`
+	DisplayName InverterBrand  `json:"displayName"`
+	// Ready-state of the Vendor. Currently always `READY`.
+	Status VendorHealthStatus `json:"status"`
+	// The name of the first party service or app that the user normally logs into.
+	PortalName string `json:"portalName"`
+	// Ready-state for linking with the Vendor.
+	//
+	//   * `READY`: The Vendor is ready to be linked with.
+	//   * `ELEVATED_ERROR_RATE`: Multiple attempts may be needed to link successfully. We are showing a warning in Link UI.
+	//   * `OUTAGE`: The Vendor is currently not available for linking. We will show an error in Link UI and not let the user attempt to link with the Vendor.
+	LinkingStatus VendorHealthStatus `json:"linkingStatus"`
+}
+
+type HvacHealth struct {
+	Vendor      HvacVendor `json:"vendor"`
+	DisplayName HvacBrand  `json:"displayName"`
+	// Ready-state of the Vendor. Currently always `READY`.
+	Status VendorHealthStatus `json:"status"`
+	// The name of the first party service or app that the user normally logs into.
+	PortalName string `json:"portalName"`
+	// Ready-state for linking with the Vendor.
+	//
+	//   * `READY`: The Vendor is ready to be linked with.
+	//   * `ELEVATED_ERROR_RATE`: Multiple attempts may be needed to link successfully. We are showing a warning in Link UI.
+	//   * `OUTAGE`: The Vendor is currently not available for linking. We will show an error in Link UI and not let the user attempt to link with the Vendor.
+	LinkingStatus VendorHealthStatus `json:"linkingStatus"`
+}
+
+type MeterHealth struct {
+	Vendor      MeterVendor `json:"vendor"`
+	DisplayName MeterBrand  `json:"displayName"`
+	// Ready-state of the Vendor. Currently always `READY`.
+	Status VendorHealthStatus `json:"status"`
+	// The name of the first party service or app that the user normally logs into.
+	PortalName string `json:"portalName"`
+	// Ready-state for linking with the Vendor.
+	//
+	//   * `READY`: The Vendor is ready to be linked with.
+	//   * `ELEVATED_ERROR_RATE`: Multiple attempts may be needed to link successfully. We are showing a warning in Link UI.
+	//   * `OUTAGE`: The Vendor is currently not available for linking. We will show an error in Link UI and not let the user attempt to link with the Vendor.
+	LinkingStatus VendorHealthStatus `json:"linkingStatus"`
+}
+
+type CreateSimulatedVehiclePayload struct {
+	// Vendor of the simulated vehicle
+	Vendor VehicleVendor `json:"vendor"`
+	// Username for linking the simulated vehicle
+	Username string `json:"username"`
+	// Password for linking the simulated vehicle
+	Password string `json:"password"`
+	// Name of the simulated vehicle
+	Name string `json:"name"`
+	// Simulated vehicle model
+	Model string `json:"model"`
+	// Simulated vehicle production year
+	Year float64 `json:"year"`
+	// Specifying a user ID automatically links a vehicle with the simulated vehicle.
+	UserId *string `json:"userId,omitempty"`
+}
+
+type SimulatedVehicleChargeState struct {
+	// Remaining battery in percent
+	BatteryLevel *float64 `json:"batteryLevel"`
+	// Estimated remaining kilometers
+	Range *float64 `json:"range"`
+	// Vehicle's maximum physical battery capacity in kWh.
+	BatteryCapacity *float64 `json:"batteryCapacity"`
+	// Charge limit, as a percent of `batteryCapacity`.
+	ChargeLimit *float64 `json:"chargeLimit"`
+	// The current charge rate in kW.
+	//
+	// This property is only available when the vehicle is charging, and is `null` any other time.
+	ChargeRate *float64 `json:"chargeRate"`
+	// Estimated time until the current charging intent is completed, in minutes.
+	//
+	// This property is only available when the vehicle is charging, and is `null` any other time.
+	ChargeTimeRemaining *float64                  `json:"chargeTimeRemaining"`
+	PowerDeliveryState  VehiclePowerDeliveryState `json:"powerDeliveryState"`
+}
+
+type SimulatedVehicle struct {
+	// Simulated vehicle ID
+	Id string `json:"id"`
+	// Name of the simulated vehicle
+	Name string `json:"name"`
+	// Username for linking the simulated vehicle
+	Username string `json:"username"`
+	// Password for linking the simulated vehicle
+	Password string `json:"password"`
+	// VIN (random value) of the simulated vehicle
+	Vin string `json:"vin"`
+	// Vendor of the simulated vehicle
+	Vendor VehicleVendor `json:"vendor"`
+	// Simulated vehicle model
+	Model string `json:"model"`
+	// Simulated vehicle production year
+	Year float64 `json:"year"`
+	// Whether live data from the simulated vehicle is currently available from Enode's perspective. This 'reachability' may refer to reading from a cache operated by the vehicle's cloud service if that service has determined that its cache is valid.
+	IsReachable bool                               `json:"isReachable"`
+	ChargeState SimulatedVehicleCurrentChargeState `json:"chargeState"`
+	Location    BatteryLocation                    `json:"location"`
+	// The ID of the user that linked this vehicle.
+	UserId *string `json:"userId"`
+	// ID of the linked vehicle.
+	LinkedVehicleId *string `json:"linkedVehicleId"`
+}
+
+type SimulatedVehicleCurrentChargeState struct {
+	SimulatedVehicleChargeState
+	// Indicates whether the simulated vehicle is connected to a charging box (regardless of whether it is actually charging)
+	IsPluggedIn *bool `json:"isPluggedIn"`
+	// Indicates whether the simulated vehicle is currently receiving power and actively charging its battery.
+	IsCharging *bool `json:"isCharging"`
+}
+
+type UpdateSimulatedVehiclePayload struct {
+	// Username for linking the simulated vehicle
+	Username *string `json:"username,omitempty"`
+	// Password for linking the simulated vehicle
+	Password *string `json:"password,omitempty"`
+	// Name of the simulated vehicle
+	Name *string `json:"name,omitempty"`
+	// Whether live data from the simulated vehicle is currently available from Enode's perspective. This 'reachability' may refer to reading from a cache operated by the vehicle's cloud service if that service has determined that its cache is valid.
+	IsReachable *bool                        `json:"isReachable,omitempty"`
+	ChargeState *SimulatedVehicleChargeState `json:"chargeState,omitempty"`
+	Location    *BatteryLocation             `json:"location,omitempty"`
+}
+
+type PaginatedVehiclesList struct {
+	Data       []SimulatedVehicle `json:"data"`
+	Pagination PaginationCursors  `json:"pagination"`
+}
+
+type SystemHeartbeat struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version       string               `json:"version"`
+	Event         SystemHeartbeatEvent `json:"event"`
+	User          SystemHeartbeatUser  `json:"user"`
+	CreatedAt     string               `json:"createdAt"`
+	PendingEvents float64              `json:"pendingEvents"`
+}
+
+type SystemHeartbeatUser struct {
+	Id string `json:"id"`
+}
+
+type UserVehicleDiscovered struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version string                     `json:"version"`
+	Event   UserVehicleDiscoveredEvent `json:"event"`
+	User    SystemHeartbeatUser        `json:"user"`
+	Vehicle VehicleWithLocation        `json:"vehicle"`
+}
+
+type UserVehicleUpdated struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version       string                  `json:"version"`
+	Event         UserVehicleUpdatedEvent `json:"event"`
+	User          SystemHeartbeatUser     `json:"user"`
+	Vehicle       VehicleWithLocation     `json:"vehicle"`
+	UpdatedFields []string                `json:"updatedFields"`
+}
+
+type UserVehicleDeleted struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version string                  `json:"version"`
+	Event   UserVehicleDeletedEvent `json:"event"`
+	User    SystemHeartbeatUser     `json:"user"`
+	Vehicle VehicleWithLocation     `json:"vehicle"`
+}
+
+type UserVehicleSmartChargingStatusUpdated struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version             string                                     `json:"version"`
+	Event               UserVehicleSmartChargingStatusUpdatedEvent `json:"event"`
+	User                SystemHeartbeatUser                        `json:"user"`
+	SmartChargingStatus VehicleSmartChargingStatus                 `json:"smartChargingStatus"`
+	UpdatedFields       []string                                   `json:"updatedFields"`
+}
+
+type VehicleSmartChargingStatus struct {
+	// Time when the Smart Charging Status was recalculated, irrespective of whether or not any values changed
+	UpdatedAt time.Time `json:"updatedAt"`
+	// ID of the vehicle to which this Smart Charging Status represents
+	VehicleId string `json:"vehicleId"`
+	// ID of the user that owns this vehicle
+	UserId string `json:"userId"`
+	// Vendor of the Vehicle
+	Vendor VehicleVendor    `json:"vendor"`
+	State  SmartChargeState `json:"state"`
+	// Time when the `state` property transitioned to its current value
+	StateChangedAt time.Time      `json:"stateChangedAt"`
+	Consideration  *Consideration `json:"consideration"`
+	// `null` by default, but during all of the `PLAN:*` states,  the value is populated with a description of the active plan
+	Plan          *VehicleSmartChargingPlan `json:"plan"`
+	SmartOverride *SmartOverrideStatus      `json:"smartOverride"`
+}
+
+type UserActionUpdated struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version       string                        `json:"version"`
+	Event         UserActionUpdatedEvent        `json:"event"`
+	User          SystemHeartbeatUser           `json:"user"`
+	VendorAction  UserActionUpdatedVendorAction `json:"vendorAction"`
+	UpdatedFields []string                      `json:"updatedFields"`
+}
+
+type UserTemperatureScheduleStatusUpdated struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version       string                                    `json:"version"`
+	Event         UserTemperatureScheduleStatusUpdatedEvent `json:"event"`
+	User          SystemHeartbeatUser                       `json:"user"`
+	Status        TemperatureScheduleStatus                 `json:"status"`
+	Schedule      TemperatureScheduleResponse               `json:"schedule"`
+	UpdatedFields []string                                  `json:"updatedFields"`
+}
+
+type UserChargeScheduleStatusUpdated struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version       string                                    `json:"version"`
+	Event         UserTemperatureScheduleStatusUpdatedEvent `json:"event"`
+	User          SystemHeartbeatUser                       `json:"user"`
+	Status        ChargeScheduleStatus                      `json:"status"`
+	Schedule      ChargeScheduleResponse                    `json:"schedule"`
+	UpdatedFields []string                                  `json:"updatedFields"`
+}
+
+type UserChargerUpdated struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version       string                  `json:"version"`
+	Event         UserChargerUpdatedEvent `json:"event"`
+	User          SystemHeartbeatUser     `json:"user"`
+	Charger       Charger                 `json:"charger"`
+	UpdatedFields []string                `json:"updatedFields"`
+}
+
+type UserChargerDeleted struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version string                  `json:"version"`
+	Event   UserChargerDeletedEvent `json:"event"`
+	User    SystemHeartbeatUser     `json:"user"`
+	Charger Charger                 `json:"charger"`
+}
+
+type UserChargerDiscovered struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version string                     `json:"version"`
+	Event   UserChargerDiscoveredEvent `json:"event"`
+	User    SystemHeartbeatUser        `json:"user"`
+	Charger Charger                    `json:"charger"`
+}
+
+type UserCredentialsInvalidated struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version string                          `json:"version"`
+	Event   UserCredentialsInvalidatedEvent `json:"event"`
+	User    SystemHeartbeatUser             `json:"user"`
+	// Machine-friendly name of the vendor. Use this in API requests.
+	Vendor Vendor `json:"vendor"`
+}
+
+type UserHvacDiscovered struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version string                  `json:"version"`
+	Event   UserHvacDiscoveredEvent `json:"event"`
+	User    SystemHeartbeatUser     `json:"user"`
+	Hvac    Hvac                    `json:"hvac"`
+}
+
+type UserHvacUpdated struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version       string               `json:"version"`
+	Event         UserHvacUpdatedEvent `json:"event"`
+	User          SystemHeartbeatUser  `json:"user"`
+	Hvac          Hvac                 `json:"hvac"`
+	UpdatedFields []string             `json:"updatedFields"`
+}
+
+type UserHvacDeleted struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version string               `json:"version"`
+	Event   UserHvacDeletedEvent `json:"event"`
+	User    SystemHeartbeatUser  `json:"user"`
+	Hvac    Hvac                 `json:"hvac"`
+}
+
+type UserInverterDiscovered struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version  string                      `json:"version"`
+	Event    UserInverterDiscoveredEvent `json:"event"`
+	User     SystemHeartbeatUser         `json:"user"`
+	Inverter Inverter                    `json:"inverter"`
+}
+
+type UserInverterUpdated struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version       string                   `json:"version"`
+	Event         UserInverterUpdatedEvent `json:"event"`
+	User          SystemHeartbeatUser      `json:"user"`
+	Inverter      Inverter                 `json:"inverter"`
+	UpdatedFields []string                 `json:"updatedFields"`
+}
+
+type UserInverterDeleted struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version  string                   `json:"version"`
+	Event    UserInverterDeletedEvent `json:"event"`
+	User     SystemHeartbeatUser      `json:"user"`
+	Inverter Inverter                 `json:"inverter"`
+}
+
+type UserBatteryDiscovered struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version string                     `json:"version"`
+	Event   UserBatteryDiscoveredEvent `json:"event"`
+	User    SystemHeartbeatUser        `json:"user"`
+	Battery Battery                    `json:"battery"`
+}
+
+type UserBatteryUpdated struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version       string                  `json:"version"`
+	Event         UserBatteryUpdatedEvent `json:"event"`
+	User          SystemHeartbeatUser     `json:"user"`
+	Battery       Battery                 `json:"battery"`
+	UpdatedFields []string                `json:"updatedFields"`
+}
+
+type UserBatteryDeleted struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version string                  `json:"version"`
+	Event   UserBatteryDeletedEvent `json:"event"`
+	User    SystemHeartbeatUser     `json:"user"`
+	Battery Battery                 `json:"battery"`
+}
+
+type UserMeterDiscovered struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version string                   `json:"version"`
+	Event   UserMeterDiscoveredEvent `json:"event"`
+	User    SystemHeartbeatUser      `json:"user"`
+	Meter   Meter                    `json:"meter"`
+}
+
+type UserMeterUpdated struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version       string                `json:"version"`
+	Event         UserMeterUpdatedEvent `json:"event"`
+	User          SystemHeartbeatUser   `json:"user"`
+	Meter         Meter                 `json:"meter"`
+	UpdatedFields []string              `json:"updatedFields"`
+}
+
+type UserMeterDeleted struct {
+	// API version of the payload. Example: `2024-01-01`
+	Version string                `json:"version"`
+	Event   UserMeterDeletedEvent `json:"event"`
+	User    SystemHeartbeatUser   `json:"user"`
+	Meter   Meter                 `json:"meter"`
+}
+
+type ControlChargingPayload struct {
+	// Charging action to perform
+	Action ChargingAction `json:"action"`
+}
+
+type ChargerSmartChargingStatus struct {
+	// Charger ID
+	ChargerId string `json:"chargerId"`
+	// User ID
+	UserId string       `json:"userId"`
+	State  ChargerState `json:"state"`
+	// Charging intervals for this cycle
+	ChargingIntervals ChargerSmartChargingIntervals `json:"chargingIntervals"`
+}
+
+// VehicleCreateSmartOverrideResponse: This field populates after using Vehicle [Create Smart Override](/api/reference#vehicleCreateSmartOverride) or Charger [Create Smart Override](/api/reference#chargerCreateSmartOverride). Once populated, the target enters an overridden state, stopping Enode from sending Smart Charging actions. The Smart Override persists until the target ceases charging for any reason or is manually ended via Vehicle [End Smart Override](/api/reference#vehicleEndSmartOverride) or Charger [End Smart Override](/api/reference#chargerEndSmartOverride).
+type VehicleCreateSmartOverrideResponse struct {
+	SmartOverrideStatus
+	// ID of the User
+	UserId string           `json:"userId"`
+	Vendor ChargeableVendor `json:"vendor"`
+}
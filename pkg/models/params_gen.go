@@ -0,0 +1,36 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package models
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// PaginationParams holds the cursor based pagination parameters of list endpoints.
+type PaginationParams struct {
+	// Opaque cursor used to fetch next page. Cannot be set together with `before`. Refer to [Pagination](/api/reference#pagination) for more information.
+	After *string
+	// Opaque cursor used to fetch previous page. Cannot be used together with `after`. Refer to [Pagination](/api/reference#pagination) for more information.
+	Before *string
+	// Number of records to return per page
+	PageSize *int
+}
+
+// Values encodes the parameters as URL query values.
+func (params *PaginationParams) Values() url.Values {
+	query := url.Values{}
+	if params == nil {
+		return query
+	}
+	if params.After != nil {
+		query.Set("after", *params.After)
+	}
+	if params.Before != nil {
+		query.Set("before", *params.Before)
+	}
+	if params.PageSize != nil {
+		query.Set("pageSize", strconv.Itoa(int(*params.PageSize)))
+	}
+	return query
+}
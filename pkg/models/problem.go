@@ -0,0 +1,12 @@
+package models
+
+import "fmt"
+
+// Error implements the error interface, so that problem details returned by
+// the API can be inspected with errors.As.
+func (p *Problem) Error() string {
+	if p.Detail == "" {
+		return fmt.Sprintf("%s (%s)", p.Title, p.Type)
+	}
+	return fmt.Sprintf("%s: %s (%s)", p.Title, p.Detail, p.Type)
+}
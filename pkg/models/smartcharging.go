@@ -0,0 +1,132 @@
+package models
+
+import "strings"
+
+// IsKnown reports whether the state is one of the states documented by the API.
+func (s SmartChargeState) IsKnown() bool {
+	_, ok := smartChargeTransitions[s]
+	return ok
+}
+
+// IsPlan reports whether a Smart Charging Plan is executing or has just ended.
+func (s SmartChargeState) IsPlan() bool {
+	return strings.HasPrefix(string(s), "PLAN:")
+}
+
+// IsExecuting reports whether a Smart Charging Plan is executing.
+func (s SmartChargeState) IsExecuting() bool {
+	return strings.HasPrefix(string(s), "PLAN:EXECUTING:")
+}
+
+// HasEnded reports whether a Smart Charging Plan has ended.
+func (s SmartChargeState) HasEnded() bool {
+	return strings.HasPrefix(string(s), "PLAN:ENDED:")
+}
+
+// IsFailure reports whether smart charging failed to control the vehicle.
+func (s SmartChargeState) IsFailure() bool {
+	switch s {
+	case SMART_CHARGE_STATE_PLAN_EXECUTING_STOP_FAILED,
+		SMART_CHARGE_STATE_PLAN_EXECUTING_START_FAILED,
+		SMART_CHARGE_STATE_PLAN_EXECUTING_CHARGE_INTERRUPTED,
+		SMART_CHARGE_STATE_PLAN_ENDED_FAILED:
+		return true
+	}
+	return false
+}
+
+// IsAwaitingCharge reports whether an executing plan holds off charging, waiting for lower prices.
+func (s SmartChargeState) IsAwaitingCharge() bool {
+	return s == SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED || s == SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED_AWAITING_PRICES
+}
+
+// CanTransitionTo reports whether the state machine of smart charging moves
+// from the state to next in one step. Staying in a state is always possible,
+// and every state may end in DISABLED or UNKNOWN.
+func (s SmartChargeState) CanTransitionTo(next SmartChargeState) bool {
+	if s == next || next == SMART_CHARGE_STATE_DISABLED || next == SMART_CHARGE_STATE_UNKNOWN {
+		return s.IsKnown() && next.IsKnown()
+	}
+	// the state is recovered from any other state once known again
+	if s == SMART_CHARGE_STATE_UNKNOWN {
+		return next.IsKnown()
+	}
+	for _, allowed := range smartChargeTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// planEnds are the transitions out of all executing states.
+var planEnds = []SmartChargeState{
+	SMART_CHARGE_STATE_PLAN_EXECUTING_OVERRIDDEN,
+	SMART_CHARGE_STATE_PLAN_ENDED_FINISHED,
+	SMART_CHARGE_STATE_PLAN_ENDED_UNPLUGGED,
+	SMART_CHARGE_STATE_PLAN_ENDED_FAILED,
+	SMART_CHARGE_STATE_PLAN_ENDED_DISABLED,
+	SMART_CHARGE_STATE_PLAN_ENDED_DEADLINE_CHANGED,
+}
+
+// afterPlan are the transitions out of all ended states.
+var afterPlan = []SmartChargeState{
+	SMART_CHARGE_STATE_CONSIDERING,
+	SMART_CHARGE_STATE_FULLY_CHARGED,
+}
+
+func executing(next ...SmartChargeState) []SmartChargeState {
+	return append(next, planEnds...)
+}
+
+// smartChargeTransitions lists the states following each state, besides
+// itself, DISABLED and UNKNOWN.
+var smartChargeTransitions = map[SmartChargeState][]SmartChargeState{
+	SMART_CHARGE_STATE_DISABLED:      {SMART_CHARGE_STATE_CONSIDERING, SMART_CHARGE_STATE_FULLY_CHARGED},
+	SMART_CHARGE_STATE_CONSIDERING:   {SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPING, SMART_CHARGE_STATE_PLAN_EXECUTING_STARTING, SMART_CHARGE_STATE_FULLY_CHARGED},
+	SMART_CHARGE_STATE_UNKNOWN:       {},
+	SMART_CHARGE_STATE_FULLY_CHARGED: {SMART_CHARGE_STATE_CONSIDERING},
+
+	SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPING: executing(
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED,
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED_AWAITING_PRICES,
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STOP_FAILED,
+	),
+	SMART_CHARGE_STATE_PLAN_EXECUTING_STOP_FAILED: executing(
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPING,
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED,
+	),
+	SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED: executing(
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED_AWAITING_PRICES,
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STARTING,
+	),
+	SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED_AWAITING_PRICES: executing(
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED,
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STARTING,
+	),
+	SMART_CHARGE_STATE_PLAN_EXECUTING_STARTING: executing(
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STARTED,
+		SMART_CHARGE_STATE_PLAN_EXECUTING_START_FAILED,
+	),
+	SMART_CHARGE_STATE_PLAN_EXECUTING_START_FAILED: executing(
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STARTING,
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STARTED,
+	),
+	SMART_CHARGE_STATE_PLAN_EXECUTING_STARTED: executing(
+		SMART_CHARGE_STATE_PLAN_EXECUTING_CHARGE_INTERRUPTED,
+	),
+	SMART_CHARGE_STATE_PLAN_EXECUTING_CHARGE_INTERRUPTED: executing(
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STARTING,
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STARTED,
+	),
+	SMART_CHARGE_STATE_PLAN_EXECUTING_OVERRIDDEN: executing(
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPING,
+		SMART_CHARGE_STATE_PLAN_EXECUTING_STARTING,
+	),
+
+	SMART_CHARGE_STATE_PLAN_ENDED_FINISHED:         afterPlan,
+	SMART_CHARGE_STATE_PLAN_ENDED_UNPLUGGED:        afterPlan,
+	SMART_CHARGE_STATE_PLAN_ENDED_FAILED:           afterPlan,
+	SMART_CHARGE_STATE_PLAN_ENDED_DISABLED:         afterPlan,
+	SMART_CHARGE_STATE_PLAN_ENDED_DEADLINE_CHANGED: afterPlan,
+}
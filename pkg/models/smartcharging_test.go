@@ -0,0 +1,41 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+)
+
+func TestSmartChargeState(t *testing.T) {
+	state := models.SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED_AWAITING_PRICES
+	if !state.IsKnown() || !state.IsPlan() || !state.IsExecuting() || state.HasEnded() || state.IsFailure() || !state.IsAwaitingCharge() {
+		t.Errorf("Unexpected predicates for %s", state)
+	}
+	ended := models.SMART_CHARGE_STATE_PLAN_ENDED_FAILED
+	if !ended.HasEnded() || ended.IsExecuting() || !ended.IsFailure() {
+		t.Errorf("Unexpected predicates for %s", ended)
+	}
+	if models.SmartChargeState("PLAN:EXECUTING:NEW").IsKnown() {
+		t.Errorf("Expected an undocumented state to be unknown")
+	}
+
+	transitions := []struct {
+		from, to models.SmartChargeState
+		valid    bool
+	}{
+		{models.SMART_CHARGE_STATE_CONSIDERING, models.SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPING, true},
+		{models.SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED, models.SMART_CHARGE_STATE_PLAN_EXECUTING_STARTING, true},
+		{models.SMART_CHARGE_STATE_PLAN_EXECUTING_STARTED, models.SMART_CHARGE_STATE_PLAN_ENDED_FINISHED, true},
+		{models.SMART_CHARGE_STATE_PLAN_ENDED_DEADLINE_CHANGED, models.SMART_CHARGE_STATE_CONSIDERING, true},
+		{models.SMART_CHARGE_STATE_PLAN_EXECUTING_STARTED, models.SMART_CHARGE_STATE_DISABLED, true},
+		{models.SMART_CHARGE_STATE_UNKNOWN, models.SMART_CHARGE_STATE_PLAN_EXECUTING_STARTED, true},
+		{models.SMART_CHARGE_STATE_DISABLED, models.SMART_CHARGE_STATE_PLAN_EXECUTING_STARTED, false},
+		{models.SMART_CHARGE_STATE_PLAN_ENDED_FINISHED, models.SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED, false},
+		{models.SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPING, models.SMART_CHARGE_STATE_PLAN_EXECUTING_STARTED, false},
+	}
+	for _, transition := range transitions {
+		if transition.from.CanTransitionTo(transition.to) != transition.valid {
+			t.Errorf("Expected transition from %s to %s to be valid: %v", transition.from, transition.to, transition.valid)
+		}
+	}
+}
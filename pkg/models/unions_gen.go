@@ -0,0 +1,574 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Exactly one of the variants is set, depending on the decoded value.
+type ChargerAction struct {
+	ChargeAction     *ChargeAction
+	MaxCurrentAction *MaxCurrentAction
+}
+
+func (u ChargerAction) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.ChargeAction != nil:
+		return json.Marshal(u.ChargeAction)
+	case u.MaxCurrentAction != nil:
+		return json.Marshal(u.MaxCurrentAction)
+	}
+	return []byte("null"), nil
+}
+
+func (u *ChargerAction) UnmarshalJSON(data []byte) error {
+	*u = ChargerAction{}
+	if isNull(data) {
+		return nil
+	}
+
+	if v := new(ChargeAction); decodeVariant(data, v, true, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "kind", "failureReason") == nil {
+		u.ChargeAction = v
+		return nil
+	}
+	if v := new(MaxCurrentAction); decodeVariant(data, v, true, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "targetState", "failureReason") == nil {
+		u.MaxCurrentAction = v
+		return nil
+	}
+
+	if v := new(ChargeAction); decodeVariant(data, v, false, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "kind", "failureReason") == nil {
+		u.ChargeAction = v
+		return nil
+	}
+	if v := new(MaxCurrentAction); decodeVariant(data, v, false, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "targetState", "failureReason") == nil {
+		u.MaxCurrentAction = v
+		return nil
+	}
+	return fmt.Errorf("models: value does not match any variant of ChargerAction")
+}
+
+// Exactly one of the variants is set, depending on the decoded value.
+type PermanentHoldTargetState struct {
+	CoolTargetState *CoolTargetState
+	HeatTargetState *HeatTargetState
+	AutoTargetState *AutoTargetState
+	OffTargetState  *OffTargetState
+}
+
+func (u PermanentHoldTargetState) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.CoolTargetState != nil:
+		return json.Marshal(u.CoolTargetState)
+	case u.HeatTargetState != nil:
+		return json.Marshal(u.HeatTargetState)
+	case u.AutoTargetState != nil:
+		return json.Marshal(u.AutoTargetState)
+	case u.OffTargetState != nil:
+		return json.Marshal(u.OffTargetState)
+	}
+	return []byte("null"), nil
+}
+
+func (u *PermanentHoldTargetState) UnmarshalJSON(data []byte) error {
+	*u = PermanentHoldTargetState{}
+	if isNull(data) {
+		return nil
+	}
+
+	var probe struct {
+		Value string `json:"mode"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+	switch probe.Value {
+	case "COOL":
+		u.CoolTargetState = new(CoolTargetState)
+		return json.Unmarshal(data, u.CoolTargetState)
+	case "HEAT":
+		u.HeatTargetState = new(HeatTargetState)
+		return json.Unmarshal(data, u.HeatTargetState)
+	case "AUTO":
+		u.AutoTargetState = new(AutoTargetState)
+		return json.Unmarshal(data, u.AutoTargetState)
+	case "OFF":
+		u.OffTargetState = new(OffTargetState)
+		return json.Unmarshal(data, u.OffTargetState)
+	}
+	return fmt.Errorf("models: unknown mode %q of PermanentHoldTargetState", probe.Value)
+}
+
+// Exactly one of the variants is set, depending on the decoded value.
+type HvacAction struct {
+	HvacActionPermanentHold  *HvacActionPermanentHold
+	HvacActionFollowSchedule *HvacActionFollowSchedule
+}
+
+func (u HvacAction) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.HvacActionPermanentHold != nil:
+		return json.Marshal(u.HvacActionPermanentHold)
+	case u.HvacActionFollowSchedule != nil:
+		return json.Marshal(u.HvacActionFollowSchedule)
+	}
+	return []byte("null"), nil
+}
+
+func (u *HvacAction) UnmarshalJSON(data []byte) error {
+	*u = HvacAction{}
+	if isNull(data) {
+		return nil
+	}
+
+	if v := new(HvacActionPermanentHold); decodeVariant(data, v, true, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "target") == nil {
+		u.HvacActionPermanentHold = v
+		return nil
+	}
+	if v := new(HvacActionFollowSchedule); decodeVariant(data, v, true, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "target") == nil {
+		u.HvacActionFollowSchedule = v
+		return nil
+	}
+
+	if v := new(HvacActionPermanentHold); decodeVariant(data, v, false, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "target") == nil {
+		u.HvacActionPermanentHold = v
+		return nil
+	}
+	if v := new(HvacActionFollowSchedule); decodeVariant(data, v, false, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "target") == nil {
+		u.HvacActionFollowSchedule = v
+		return nil
+	}
+	return fmt.Errorf("models: value does not match any variant of HvacAction")
+}
+
+// Exactly one of the variants is set, depending on the decoded value.
+type HVACSetPermanentHoldPayload struct {
+	CoolPermanentHold *CoolPermanentHold
+	HeatPermanentHold *HeatPermanentHold
+	AutoPermanentHold *AutoPermanentHold
+	OffPermanentHold  *OffPermanentHold
+}
+
+func (u HVACSetPermanentHoldPayload) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.CoolPermanentHold != nil:
+		return json.Marshal(u.CoolPermanentHold)
+	case u.HeatPermanentHold != nil:
+		return json.Marshal(u.HeatPermanentHold)
+	case u.AutoPermanentHold != nil:
+		return json.Marshal(u.AutoPermanentHold)
+	case u.OffPermanentHold != nil:
+		return json.Marshal(u.OffPermanentHold)
+	}
+	return []byte("null"), nil
+}
+
+func (u *HVACSetPermanentHoldPayload) UnmarshalJSON(data []byte) error {
+	*u = HVACSetPermanentHoldPayload{}
+	if isNull(data) {
+		return nil
+	}
+
+	var probe struct {
+		Value string `json:"mode"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+	switch probe.Value {
+	case "COOL":
+		u.CoolPermanentHold = new(CoolPermanentHold)
+		return json.Unmarshal(data, u.CoolPermanentHold)
+	case "HEAT":
+		u.HeatPermanentHold = new(HeatPermanentHold)
+		return json.Unmarshal(data, u.HeatPermanentHold)
+	case "AUTO":
+		u.AutoPermanentHold = new(AutoPermanentHold)
+		return json.Unmarshal(data, u.AutoPermanentHold)
+	case "OFF":
+		u.OffPermanentHold = new(OffPermanentHold)
+		return json.Unmarshal(data, u.OffPermanentHold)
+	}
+	return fmt.Errorf("models: unknown mode %q of HVACSetPermanentHoldPayload", probe.Value)
+}
+
+// Exactly one of the variants is set, depending on the decoded value.
+type TemperatureTargetState struct {
+	PermanentHoldTargetState *PermanentHoldTargetState
+	ScheduledTargetState     *ScheduledTargetState
+}
+
+func (u TemperatureTargetState) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.PermanentHoldTargetState != nil:
+		return json.Marshal(u.PermanentHoldTargetState)
+	case u.ScheduledTargetState != nil:
+		return json.Marshal(u.ScheduledTargetState)
+	}
+	return []byte("null"), nil
+}
+
+func (u *TemperatureTargetState) UnmarshalJSON(data []byte) error {
+	*u = TemperatureTargetState{}
+	if isNull(data) {
+		return nil
+	}
+
+	if v := new(PermanentHoldTargetState); json.Unmarshal(data, v) == nil {
+		u.PermanentHoldTargetState = v
+		return nil
+	}
+	if v := new(ScheduledTargetState); decodeVariant(data, v, true, "holdType") == nil {
+		u.ScheduledTargetState = v
+		return nil
+	}
+
+	if v := new(ScheduledTargetState); decodeVariant(data, v, false, "holdType") == nil {
+		u.ScheduledTargetState = v
+		return nil
+	}
+	return fmt.Errorf("models: value does not match any variant of TemperatureTargetState")
+}
+
+// Exactly one of the variants is set, depending on the decoded value.
+type ScheduleResponse struct {
+	ChargeScheduleResponse      *ChargeScheduleResponse
+	TemperatureScheduleResponse *TemperatureScheduleResponse
+}
+
+func (u ScheduleResponse) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.ChargeScheduleResponse != nil:
+		return json.Marshal(u.ChargeScheduleResponse)
+	case u.TemperatureScheduleResponse != nil:
+		return json.Marshal(u.TemperatureScheduleResponse)
+	}
+	return []byte("null"), nil
+}
+
+func (u *ScheduleResponse) UnmarshalJSON(data []byte) error {
+	*u = ScheduleResponse{}
+	if isNull(data) {
+		return nil
+	}
+
+	var probe struct {
+		Value string `json:"targetType"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+	switch probe.Value {
+	case "vehicle", "charger":
+		u.ChargeScheduleResponse = new(ChargeScheduleResponse)
+		return json.Unmarshal(data, u.ChargeScheduleResponse)
+	case "hvac":
+		u.TemperatureScheduleResponse = new(TemperatureScheduleResponse)
+		return json.Unmarshal(data, u.TemperatureScheduleResponse)
+	}
+	return fmt.Errorf("models: unknown targetType %q of ScheduleResponse", probe.Value)
+}
+
+// Exactly one of the variants is set, depending on the decoded value.
+type Schedule struct {
+	ChargeSchedule      *ChargeSchedule
+	TemperatureSchedule *TemperatureSchedule
+}
+
+func (u Schedule) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.ChargeSchedule != nil:
+		return json.Marshal(u.ChargeSchedule)
+	case u.TemperatureSchedule != nil:
+		return json.Marshal(u.TemperatureSchedule)
+	}
+	return []byte("null"), nil
+}
+
+func (u *Schedule) UnmarshalJSON(data []byte) error {
+	*u = Schedule{}
+	if isNull(data) {
+		return nil
+	}
+
+	var probe struct {
+		Value string `json:"targetType"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+	switch probe.Value {
+	case "vehicle", "charger":
+		u.ChargeSchedule = new(ChargeSchedule)
+		return json.Unmarshal(data, u.ChargeSchedule)
+	case "hvac":
+		u.TemperatureSchedule = new(TemperatureSchedule)
+		return json.Unmarshal(data, u.TemperatureSchedule)
+	}
+	return fmt.Errorf("models: unknown targetType %q of Schedule", probe.Value)
+}
+
+// Exactly one of the variants is set, depending on the decoded value.
+type PartialSchedule struct {
+	PartialChargeSchedule      *PartialChargeSchedule
+	PartialTemperatureSchedule *PartialTemperatureSchedule
+}
+
+func (u PartialSchedule) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.PartialChargeSchedule != nil:
+		return json.Marshal(u.PartialChargeSchedule)
+	case u.PartialTemperatureSchedule != nil:
+		return json.Marshal(u.PartialTemperatureSchedule)
+	}
+	return []byte("null"), nil
+}
+
+func (u *PartialSchedule) UnmarshalJSON(data []byte) error {
+	*u = PartialSchedule{}
+	if isNull(data) {
+		return nil
+	}
+
+	if v := new(PartialChargeSchedule); decodeVariant(data, v, true) == nil {
+		u.PartialChargeSchedule = v
+		return nil
+	}
+	if v := new(PartialTemperatureSchedule); decodeVariant(data, v, true) == nil {
+		u.PartialTemperatureSchedule = v
+		return nil
+	}
+
+	if v := new(PartialChargeSchedule); decodeVariant(data, v, false) == nil {
+		u.PartialChargeSchedule = v
+		return nil
+	}
+	if v := new(PartialTemperatureSchedule); decodeVariant(data, v, false) == nil {
+		u.PartialTemperatureSchedule = v
+		return nil
+	}
+	return fmt.Errorf("models: value does not match any variant of PartialSchedule")
+}
+
+// Exactly one of the variants is set, depending on the decoded value.
+type NullableTemperatureTargetState struct {
+	PermanentHoldTargetState *PermanentHoldTargetState
+	ScheduledTargetState     *ScheduledTargetState
+}
+
+func (u NullableTemperatureTargetState) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.PermanentHoldTargetState != nil:
+		return json.Marshal(u.PermanentHoldTargetState)
+	case u.ScheduledTargetState != nil:
+		return json.Marshal(u.ScheduledTargetState)
+	}
+	return []byte("null"), nil
+}
+
+func (u *NullableTemperatureTargetState) UnmarshalJSON(data []byte) error {
+	*u = NullableTemperatureTargetState{}
+	if isNull(data) {
+		return nil
+	}
+
+	if v := new(PermanentHoldTargetState); json.Unmarshal(data, v) == nil {
+		u.PermanentHoldTargetState = v
+		return nil
+	}
+	if v := new(ScheduledTargetState); decodeVariant(data, v, true, "holdType") == nil {
+		u.ScheduledTargetState = v
+		return nil
+	}
+
+	if v := new(ScheduledTargetState); decodeVariant(data, v, false, "holdType") == nil {
+		u.ScheduledTargetState = v
+		return nil
+	}
+	return fmt.Errorf("models: value does not match any variant of NullableTemperatureTargetState")
+}
+
+// Exactly one of the variants is set, depending on the decoded value.
+type ScheduleStatus struct {
+	ChargeScheduleStatus      *ChargeScheduleStatus
+	TemperatureScheduleStatus *TemperatureScheduleStatus
+}
+
+func (u ScheduleStatus) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.ChargeScheduleStatus != nil:
+		return json.Marshal(u.ChargeScheduleStatus)
+	case u.TemperatureScheduleStatus != nil:
+		return json.Marshal(u.TemperatureScheduleStatus)
+	}
+	return []byte("null"), nil
+}
+
+func (u *ScheduleStatus) UnmarshalJSON(data []byte) error {
+	*u = ScheduleStatus{}
+	if isNull(data) {
+		return nil
+	}
+
+	if v := new(ChargeScheduleStatus); decodeVariant(data, v, true, "scheduleId", "scheduleType", "changedAt", "state", "isCharging", "isChargingExpected", "isChargingExpectedParts", "upcomingTransitions", "smartOverride") == nil {
+		u.ChargeScheduleStatus = v
+		return nil
+	}
+	if v := new(TemperatureScheduleStatus); decodeVariant(data, v, true, "scheduleId", "scheduleType", "changedAt", "state", "current", "expected", "upcomingTransitions") == nil {
+		u.TemperatureScheduleStatus = v
+		return nil
+	}
+
+	if v := new(ChargeScheduleStatus); decodeVariant(data, v, false, "scheduleId", "scheduleType", "changedAt", "state", "isCharging", "isChargingExpected", "isChargingExpectedParts", "upcomingTransitions", "smartOverride") == nil {
+		u.ChargeScheduleStatus = v
+		return nil
+	}
+	if v := new(TemperatureScheduleStatus); decodeVariant(data, v, false, "scheduleId", "scheduleType", "changedAt", "state", "current", "expected", "upcomingTransitions") == nil {
+		u.TemperatureScheduleStatus = v
+		return nil
+	}
+	return fmt.Errorf("models: value does not match any variant of ScheduleStatus")
+}
+
+// Exactly one of the variants is set, depending on the decoded value.
+type VehicleAction struct {
+	VehicleChargeAction     *VehicleChargeAction
+	VehicleMaxCurrentAction *VehicleMaxCurrentAction
+}
+
+func (u VehicleAction) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.VehicleChargeAction != nil:
+		return json.Marshal(u.VehicleChargeAction)
+	case u.VehicleMaxCurrentAction != nil:
+		return json.Marshal(u.VehicleMaxCurrentAction)
+	}
+	return []byte("null"), nil
+}
+
+func (u *VehicleAction) UnmarshalJSON(data []byte) error {
+	*u = VehicleAction{}
+	if isNull(data) {
+		return nil
+	}
+
+	if v := new(VehicleChargeAction); decodeVariant(data, v, true, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "kind", "failureReason", "targetType") == nil {
+		u.VehicleChargeAction = v
+		return nil
+	}
+	if v := new(VehicleMaxCurrentAction); decodeVariant(data, v, true, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetState", "failureReason", "targetType") == nil {
+		u.VehicleMaxCurrentAction = v
+		return nil
+	}
+
+	if v := new(VehicleChargeAction); decodeVariant(data, v, false, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "kind", "failureReason", "targetType") == nil {
+		u.VehicleChargeAction = v
+		return nil
+	}
+	if v := new(VehicleMaxCurrentAction); decodeVariant(data, v, false, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetState", "failureReason", "targetType") == nil {
+		u.VehicleMaxCurrentAction = v
+		return nil
+	}
+	return fmt.Errorf("models: value does not match any variant of VehicleAction")
+}
+
+// Exactly one of the variants is set, depending on the decoded value.
+type UserActionUpdatedVendorAction struct {
+	HvacActionPermanentHold  *HvacActionPermanentHold
+	HvacActionFollowSchedule *HvacActionFollowSchedule
+	MaxCurrentAction         *MaxCurrentAction
+	ChargeAction             *ChargeAction
+	OperationModeAction      *OperationModeAction
+}
+
+func (u UserActionUpdatedVendorAction) MarshalJSON() ([]byte, error) {
+	switch {
+	case u.HvacActionPermanentHold != nil:
+		return json.Marshal(u.HvacActionPermanentHold)
+	case u.HvacActionFollowSchedule != nil:
+		return json.Marshal(u.HvacActionFollowSchedule)
+	case u.MaxCurrentAction != nil:
+		return json.Marshal(u.MaxCurrentAction)
+	case u.ChargeAction != nil:
+		return json.Marshal(u.ChargeAction)
+	case u.OperationModeAction != nil:
+		return json.Marshal(u.OperationModeAction)
+	}
+	return []byte("null"), nil
+}
+
+func (u *UserActionUpdatedVendorAction) UnmarshalJSON(data []byte) error {
+	*u = UserActionUpdatedVendorAction{}
+	if isNull(data) {
+		return nil
+	}
+
+	if v := new(HvacActionPermanentHold); decodeVariant(data, v, true, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "target") == nil {
+		u.HvacActionPermanentHold = v
+		return nil
+	}
+	if v := new(HvacActionFollowSchedule); decodeVariant(data, v, true, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "target") == nil {
+		u.HvacActionFollowSchedule = v
+		return nil
+	}
+	if v := new(MaxCurrentAction); decodeVariant(data, v, true, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "targetState", "failureReason") == nil {
+		u.MaxCurrentAction = v
+		return nil
+	}
+	if v := new(ChargeAction); decodeVariant(data, v, true, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "kind", "failureReason") == nil {
+		u.ChargeAction = v
+		return nil
+	}
+	if v := new(OperationModeAction); decodeVariant(data, v, true, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "targetState") == nil {
+		u.OperationModeAction = v
+		return nil
+	}
+
+	if v := new(HvacActionPermanentHold); decodeVariant(data, v, false, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "target") == nil {
+		u.HvacActionPermanentHold = v
+		return nil
+	}
+	if v := new(HvacActionFollowSchedule); decodeVariant(data, v, false, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "target") == nil {
+		u.HvacActionFollowSchedule = v
+		return nil
+	}
+	if v := new(MaxCurrentAction); decodeVariant(data, v, false, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "targetState", "failureReason") == nil {
+		u.MaxCurrentAction = v
+		return nil
+	}
+	if v := new(ChargeAction); decodeVariant(data, v, false, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "kind", "failureReason") == nil {
+		u.ChargeAction = v
+		return nil
+	}
+	if v := new(OperationModeAction); decodeVariant(data, v, false, "id", "userId", "createdAt", "updatedAt", "completedAt", "state", "targetId", "targetType", "targetState") == nil {
+		u.OperationModeAction = v
+		return nil
+	}
+	return fmt.Errorf("models: value does not match any variant of UserActionUpdatedVendorAction")
+}
+
+func isNull(data []byte) bool {
+	return string(bytes.TrimSpace(data)) == "null"
+}
+
+// decodeVariant decodes data into target if it carries all required
+// properties and, when strict, no properties unknown to the target.
+func decodeVariant(data []byte, target any, strict bool, required ...string) error {
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return err
+	}
+	for _, name := range required {
+		if _, ok := properties[name]; !ok {
+			return fmt.Errorf("models: missing property %q", name)
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if strict {
+		decoder.DisallowUnknownFields()
+	}
+	return decoder.Decode(target)
+}
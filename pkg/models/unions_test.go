@@ -0,0 +1,68 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+)
+
+func TestPermanentHoldTargetState_Discriminator(t *testing.T) {
+	var state models.PermanentHoldTargetState
+	if err := json.Unmarshal([]byte(`{"type":"PERMANENT_HOLD","mode":"HEAT","heatSetpoint":22}`), &state); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if state.HeatTargetState == nil || state.CoolTargetState != nil {
+		t.Fatalf("Expected the HEAT variant to be set, but got %+v", state)
+	}
+	if state.HeatTargetState.HeatSetpoint != 22 {
+		t.Errorf("Expected heatSetpoint 22, but got %v", state.HeatTargetState.HeatSetpoint)
+	}
+
+	if err := json.Unmarshal([]byte(`{"type":"PERMANENT_HOLD","mode":"DEFROST"}`), &state); err == nil {
+		t.Error("Expected error for unknown mode, but got nil")
+	}
+}
+
+func TestVehicleAction_AnyOf(t *testing.T) {
+	payload := `{
+		"id": "4eaeb363-296d-4ccc-a973-7805e6f400bd",
+		"userId": "d5bd4771-864e-4ae5-b913-dfb5cdcd5772",
+		"createdAt": "2020-04-07T17:04:26Z",
+		"updatedAt": "2020-04-07T17:04:26Z",
+		"completedAt": null,
+		"state": "PENDING",
+		"targetId": "bfbccded-8a1c-45a8-bbda-dcaeef29977a",
+		"targetState": {"maxCurrent": 16},
+		"failureReason": null,
+		"targetType": "vehicle"
+	}`
+
+	var action models.VehicleAction
+	if err := json.Unmarshal([]byte(payload), &action); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if action.VehicleMaxCurrentAction == nil || action.VehicleChargeAction != nil {
+		t.Fatalf("Expected the max current variant to be set, but got %+v", action)
+	}
+	if action.VehicleMaxCurrentAction.State != models.ACTION_STATE_PENDING {
+		t.Errorf("Expected state %s, but got %s", models.ACTION_STATE_PENDING, action.VehicleMaxCurrentAction.State)
+	}
+
+	data, err := json.Marshal(action)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var roundTrip models.VehicleAction
+	if err := json.Unmarshal(data, &roundTrip); err != nil || roundTrip.VehicleMaxCurrentAction == nil {
+		t.Errorf("Expected the max current variant after a round trip, but got %+v (%v)", roundTrip, err)
+	}
+}
+
+func TestProblem_Error(t *testing.T) {
+	problem := &models.Problem{Type: "https://developers.enode.com/api/problems/not-found", Title: "Not Found", Detail: "Vehicle not found"}
+	expected := "Not Found: Vehicle not found (https://developers.enode.com/api/problems/not-found)"
+	if problem.Error() != expected {
+		t.Errorf("Expected error: %v, but got: %v", expected, problem.Error())
+	}
+}
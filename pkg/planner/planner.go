@@ -0,0 +1,222 @@
+// Package planner simulates smart charging offline, to preview the outcome
+// before smart charging is enabled for a user. It places the energy a vehicle
+// needs until its deadline in the cheapest intervals and compares the cost to
+// charging right away. The simulation only depends on its input, so the same
+// input always gives the same plan:
+//
+//	plan, err := planner.Simulate(planner.Input{
+//		ChargeState: vehicle.ChargeState,
+//		Start:       time.Now(),
+//		Deadline:    deadline,
+//		Prices:      planner.ScheduledPrices{Schedule: schedule, Tariffs: tariffs},
+//	})
+package planner
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+)
+
+const (
+	PLANNER_INPUT_ERROR     string = "planner: incomplete charge state"
+	PLANNER_PRICE_ERROR     string = "planner: unable to determine the price"
+	PLANNER_SHORTFALL_ERROR string = "planner: the deadline does not leave enough time to charge"
+)
+
+// DEFAULT_RESOLUTION is the length of the intervals charging is planned in.
+const DEFAULT_RESOLUTION = 15 * time.Minute
+
+// Input describes the vehicle and prices to plan for.
+type Input struct {
+	// ChargeState provides the battery level, capacity, charge limit and charge rate.
+	ChargeState models.VehicleChargeState
+	// ChargeRate overrides the charge rate of ChargeState in kW, which is only
+	// reported while the vehicle charges.
+	ChargeRate *float64
+	// TargetLevel overrides the charge limit of ChargeState in percent, 100 if neither is set.
+	TargetLevel *float64
+	Start       time.Time
+	Deadline    time.Time
+	Prices      Prices
+	// Resolution is the length of the intervals charging is planned in, DEFAULT_RESOLUTION if zero.
+	Resolution time.Duration
+}
+
+// Window is a continuous period of charging.
+type Window struct {
+	From time.Time
+	To   time.Time
+	// Energy charged in kWh.
+	Energy float64
+	Cost   float64
+}
+
+// Plan is the outcome of a simulation.
+type Plan struct {
+	// Windows holds the cheapest periods of charging, in order.
+	Windows []Window
+	// Energy charged in kWh.
+	Energy float64
+	// Shortfall is the energy in kWh which could not be charged before the deadline.
+	Shortfall         float64
+	Cost              float64
+	EstimatedFinishAt time.Time
+	// ImmediateCost and ImmediateFinishAt describe charging right away instead.
+	ImmediateCost     float64
+	ImmediateFinishAt time.Time
+}
+
+// Savings is the cost saved over charging right away.
+func (p Plan) Savings() float64 {
+	return p.ImmediateCost - p.Cost
+}
+
+// slot is an interval of the planning period.
+type slot struct {
+	from, to time.Time
+	price    float64
+	capacity float64
+}
+
+/*
+Simulates smart charging for a vehicle.
+
+Parameters:
+  - input: The vehicle, deadline and prices to plan for.
+
+Returns:
+  - A pointer to the cheapest plan charging the vehicle to the target level.
+  - An error if the input is incomplete or a price is missing. If the deadline does
+    not leave enough time, the plan charges as much as possible and the error
+    starts with PLANNER_SHORTFALL_ERROR.
+*/
+func Simulate(input Input) (*Plan, error) {
+	energy, rate, err := demand(input)
+	if err != nil {
+		return nil, err
+	}
+	resolution := input.Resolution
+	if resolution <= 0 {
+		resolution = DEFAULT_RESOLUTION
+	}
+
+	// slots end where the price changes, so each slot has a single price
+	var slots []slot
+	for from := input.Start; from.Before(input.Deadline); {
+		interval, err := input.Prices.IntervalAt(from)
+		if err != nil {
+			return nil, err
+		}
+		to := from.Add(resolution)
+		if interval.To.After(from) && interval.To.Before(to) {
+			to = interval.To
+		}
+		if to.After(input.Deadline) {
+			to = input.Deadline
+		}
+		slots = append(slots, slot{from: from, to: to, price: interval.Price, capacity: rate * to.Sub(from).Hours()})
+		from = to
+	}
+
+	plan := &Plan{}
+	immediate := fill(slots, energy)
+	plan.ImmediateCost, plan.ImmediateFinishAt = total(immediate)
+
+	cheapest := append([]slot{}, slots...)
+	// the earlier slot wins a tie, so the plan does not depend on the sort algorithm
+	sort.SliceStable(cheapest, func(i, j int) bool { return cheapest[i].price < cheapest[j].price })
+	charged := fill(cheapest, energy)
+	sort.Slice(charged, func(i, j int) bool { return charged[i].from.Before(charged[j].from) })
+	plan.Cost, plan.EstimatedFinishAt = total(charged)
+	plan.Windows = windows(charged)
+	for _, window := range plan.Windows {
+		plan.Energy += window.Energy
+	}
+
+	if shortfall := energy - plan.Energy; shortfall > 1e-9 {
+		plan.Shortfall = shortfall
+		return plan, errors.Join(errors.New(PLANNER_SHORTFALL_ERROR), fmt.Errorf("%.2f kWh missing", shortfall))
+	}
+	return plan, nil
+}
+
+// demand returns the energy needed in kWh and the charge rate in kW.
+func demand(input Input) (float64, float64, error) {
+	state := input.ChargeState
+	if state.BatteryLevel == nil || state.BatteryCapacity == nil {
+		return 0, 0, errors.Join(errors.New(PLANNER_INPUT_ERROR), errors.New("battery level and capacity are required"))
+	}
+	rate := state.ChargeRate
+	if input.ChargeRate != nil {
+		rate = input.ChargeRate
+	}
+	if rate == nil || *rate <= 0 {
+		return 0, 0, errors.Join(errors.New(PLANNER_INPUT_ERROR), errors.New("a positive charge rate is required"))
+	}
+	if input.Prices == nil {
+		return 0, 0, errors.Join(errors.New(PLANNER_INPUT_ERROR), errors.New("prices are required"))
+	}
+	target := 100.0
+	if state.ChargeLimit != nil {
+		target = *state.ChargeLimit
+	}
+	if input.TargetLevel != nil {
+		target = *input.TargetLevel
+	}
+	energy := (target - *state.BatteryLevel) / 100 * *state.BatteryCapacity
+	if energy < 0 {
+		energy = 0
+	}
+	return energy, *rate, nil
+}
+
+// fill charges in the slots in the given order until the energy is reached,
+// returning the used slots with the charged energy as capacity.
+func fill(slots []slot, energy float64) []slot {
+	var used []slot
+	for _, s := range slots {
+		if energy <= 1e-9 {
+			break
+		}
+		if s.capacity > energy {
+			// the vehicle stops charging within the slot once the target is reached
+			s.to = s.from.Add(time.Duration(float64(s.to.Sub(s.from)) * energy / s.capacity))
+			s.capacity = energy
+		}
+		energy -= s.capacity
+		used = append(used, s)
+	}
+	return used
+}
+
+// total returns the cost of the used slots and when the last one ends.
+func total(used []slot) (float64, time.Time) {
+	var cost float64
+	var finish time.Time
+	for _, s := range used {
+		cost += s.capacity * s.price
+		if s.to.After(finish) {
+			finish = s.to
+		}
+	}
+	return cost, finish
+}
+
+// windows merges adjacent slots, ordered by time.
+func windows(used []slot) []Window {
+	var merged []Window
+	for _, s := range used {
+		if n := len(merged); n > 0 && merged[n-1].To.Equal(s.from) {
+			merged[n-1].To = s.to
+			merged[n-1].Energy += s.capacity
+			merged[n-1].Cost += s.capacity * s.price
+			continue
+		}
+		merged = append(merged, Window{From: s.from, To: s.to, Energy: s.capacity, Cost: s.capacity * s.price})
+	}
+	return merged
+}
@@ -0,0 +1,139 @@
+package planner_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/planner"
+)
+
+func float(value float64) *float64 {
+	return &value
+}
+
+func hourly(start time.Time, prices ...float64) planner.Series {
+	series := make(planner.Series, len(prices))
+	for i, price := range prices {
+		from := start.Add(time.Duration(i) * time.Hour)
+		series[i] = planner.Price{From: from, To: from.Add(time.Hour), Price: price}
+	}
+	return series
+}
+
+func TestSimulate(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	input := planner.Input{
+		// 30% of 40 kWh are missing, charged at 6 kW in 2 hours
+		ChargeState: models.VehicleChargeState{BatteryLevel: float(50), BatteryCapacity: float(40), ChargeLimit: float(80)},
+		ChargeRate:  float(6),
+		Start:       start,
+		Deadline:    start.Add(6 * time.Hour),
+		Prices:      hourly(start, 5, 4, 1, 1, 3, 2),
+	}
+
+	plan, err := planner.Simulate(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(plan.Windows) != 1 || !plan.Windows[0].From.Equal(start.Add(2*time.Hour)) || !plan.Windows[0].To.Equal(start.Add(4*time.Hour)) {
+		t.Errorf("Expected to charge from 02:00 to 04:00, got %+v", plan.Windows)
+	}
+	if math.Abs(plan.Cost-12) > 1e-9 || math.Abs(plan.ImmediateCost-54) > 1e-9 || math.Abs(plan.Savings()-42) > 1e-9 {
+		t.Errorf("Unexpected costs %v and %v", plan.Cost, plan.ImmediateCost)
+	}
+	if !plan.ImmediateFinishAt.Equal(start.Add(2 * time.Hour)) {
+		t.Errorf("Expected to finish at 02:00 right away, got %v", plan.ImmediateFinishAt)
+	}
+
+	// 9 kWh end half way through the second cheapest hour
+	input.TargetLevel = float(72.5)
+	plan, err = planner.Simulate(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !plan.EstimatedFinishAt.Equal(start.Add(3*time.Hour+30*time.Minute)) || math.Abs(plan.Energy-9) > 1e-9 {
+		t.Errorf("Expected to finish at 03:30, got %v with %v kWh", plan.EstimatedFinishAt, plan.Energy)
+	}
+
+	// the deadline leaves 1 hour only
+	input.Deadline = start.Add(time.Hour)
+	plan, err = planner.Simulate(input)
+	if err == nil || !strings.HasPrefix(err.Error(), planner.PLANNER_SHORTFALL_ERROR) || math.Abs(plan.Shortfall-3) > 1e-9 {
+		t.Errorf("Expected a shortfall of 3 kWh, got %v", err)
+	}
+
+	input.ChargeRate = nil
+	if _, err := planner.Simulate(input); err == nil || !strings.HasPrefix(err.Error(), planner.PLANNER_INPUT_ERROR) {
+		t.Errorf("Expected an input error without charge rate, got %v", err)
+	}
+}
+
+func TestScheduledPrices(t *testing.T) {
+	prices := planner.ScheduledPrices{
+		Schedule: models.LocationTariffSchedule{
+			{Weekday: 0, FromHourMinute: "00:00", ToHourMinute: "06:00", TariffId: "tariff_1", TariffName: "OFF_PEAK"},
+			{Weekday: 0, FromHourMinute: "06:00", ToHourMinute: "00:00", TariffId: "tariff_1", TariffName: "PEAK"},
+		},
+		Tariffs: map[string]models.Tariff{
+			"tariff_1": {{Name: "OFF_PEAK", Cost: "0.10"}, {Name: "PEAK", Cost: "0.30"}},
+		},
+	}
+	// 2024-01-01 is a Monday
+	monday := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if price, err := prices.PriceAt(monday.Add(5 * time.Hour)); err != nil || price != 0.10 {
+		t.Errorf("Expected the off-peak price, got %v, %v", price, err)
+	}
+	if price, err := prices.PriceAt(monday.Add(23 * time.Hour)); err != nil || price != 0.30 {
+		t.Errorf("Expected the peak price, got %v, %v", price, err)
+	}
+	if _, err := prices.PriceAt(monday.Add(24 * time.Hour)); err == nil || !strings.HasPrefix(err.Error(), planner.PLANNER_PRICE_ERROR) {
+		t.Errorf("Expected a price error on Tuesday, got %v", err)
+	}
+}
+
+func TestSimulate_UnalignedStart(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	input := planner.Input{
+		// 6 kWh are missing, charged at 6 kW in 1 hour
+		ChargeState: models.VehicleChargeState{BatteryLevel: float(50), BatteryCapacity: float(40), ChargeLimit: float(65)},
+		ChargeRate:  float(6),
+		// the hourly slots would cross the price changes at full hours
+		Start:      start.Add(30 * time.Minute),
+		Deadline:   start.Add(3 * time.Hour),
+		Prices:     hourly(start, 3, 1, 2),
+		Resolution: time.Hour,
+	}
+
+	plan, err := planner.Simulate(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(plan.Windows) != 1 || !plan.Windows[0].From.Equal(start.Add(time.Hour)) || !plan.Windows[0].To.Equal(start.Add(2*time.Hour)) {
+		t.Errorf("Expected to charge from 01:00 to 02:00, got %+v", plan.Windows)
+	}
+	// charging right away takes 3 kWh at 3 until 01:00 and 3 kWh at 1 afterwards
+	if math.Abs(plan.Cost-6) > 1e-9 || math.Abs(plan.ImmediateCost-12) > 1e-9 {
+		t.Errorf("Unexpected costs %v and %v", plan.Cost, plan.ImmediateCost)
+	}
+
+	// scheduled rates change at the end of their interval as well
+	input.Prices = planner.ScheduledPrices{
+		Schedule: models.LocationTariffSchedule{
+			{Weekday: 0, FromHourMinute: "00:00", ToHourMinute: "01:00", TariffId: "tariff_1", TariffName: "PEAK"},
+			{Weekday: 0, FromHourMinute: "01:00", ToHourMinute: "00:00", TariffId: "tariff_1", TariffName: "OFF_PEAK"},
+		},
+		Tariffs: map[string]models.Tariff{
+			"tariff_1": {{Name: "OFF_PEAK", Cost: "1"}, {Name: "PEAK", Cost: "3"}},
+		},
+	}
+	plan, err = planner.Simulate(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if math.Abs(plan.Cost-6) > 1e-9 || !plan.Windows[0].From.Equal(start.Add(time.Hour)) {
+		t.Errorf("Expected to charge from 01:00 at the off-peak rate, got %+v for %v", plan.Windows, plan.Cost)
+	}
+}
@@ -0,0 +1,144 @@
+package planner
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+)
+
+// Prices gives the price of energy per kWh at a point in time.
+type Prices interface {
+	PriceAt(at time.Time) (float64, error)
+	// IntervalAt returns the interval of constant price containing at, so
+	// plans can be split where the price changes.
+	IntervalAt(at time.Time) (Price, error)
+}
+
+// Price is the price of energy per kWh within [From, To).
+type Price struct {
+	From  time.Time
+	To    time.Time
+	Price float64
+}
+
+// Series is a list of prices, e.g. the hourly prices of a day-ahead market.
+type Series []Price
+
+// PriceAt returns the price of the interval containing at.
+func (s Series) PriceAt(at time.Time) (float64, error) {
+	interval, err := s.IntervalAt(at)
+	return interval.Price, err
+}
+
+// IntervalAt returns the interval containing at.
+func (s Series) IntervalAt(at time.Time) (Price, error) {
+	i := sort.Search(len(s), func(i int) bool { return s[i].To.After(at) })
+	if i < len(s) && !s[i].From.After(at) {
+		return s[i], nil
+	}
+	return Price{}, errors.Join(errors.New(PLANNER_PRICE_ERROR), fmt.Errorf("no price at %s", at.Format(time.RFC3339)))
+}
+
+// Sorted returns the series sorted by the start of the intervals, as required by PriceAt.
+func (s Series) Sorted() Series {
+	sorted := append(Series{}, s...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].From.Before(sorted[j].From) })
+	return sorted
+}
+
+// ScheduledPrices gives the prices of the tariffs scheduled for a location.
+type ScheduledPrices struct {
+	// Schedule selects the rate of a tariff by weekday and UTC time of day.
+	// Weekdays count from 0 for Monday.
+	Schedule models.LocationTariffSchedule
+	// Tariffs holds the rates of the tariffs by their ID.
+	Tariffs map[string]models.Tariff
+}
+
+// ScheduledRate is the rate of a tariff in effect at a time.
+type ScheduledRate struct {
+	TariffId string
+	Name     string
+	Price    float64
+	// From and Until are when the scheduled interval starts and ends.
+	From  time.Time
+	Until time.Time
+}
+
+// RateAt returns the rate scheduled at the time.
+func (p ScheduledPrices) RateAt(at time.Time) (*ScheduledRate, error) {
+	at = at.UTC()
+	weekday := (int(at.Weekday()) + 6) % 7
+	minute := at.Hour()*60 + at.Minute()
+	for _, item := range p.Schedule {
+		if item.Weekday != weekday {
+			continue
+		}
+		from, err := minuteOfDay(item.FromHourMinute)
+		if err != nil {
+			return nil, err
+		}
+		to, err := minuteOfDay(item.ToHourMinute)
+		if err != nil {
+			return nil, err
+		}
+		// an interval ending at midnight lasts until the end of the day
+		if to == 0 {
+			to = 24 * 60
+		}
+		if minute < from || minute >= to {
+			continue
+		}
+		for _, rate := range p.Tariffs[item.TariffId] {
+			if string(rate.Name) == item.TariffName {
+				price, err := strconv.ParseFloat(string(rate.Cost), 64)
+				if err != nil {
+					return nil, errors.Join(errors.New(PLANNER_PRICE_ERROR), err)
+				}
+				day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+				return &ScheduledRate{
+					TariffId: item.TariffId,
+					Name:     item.TariffName,
+					Price:    price,
+					From:     day.Add(time.Duration(from) * time.Minute),
+					Until:    day.Add(time.Duration(to) * time.Minute),
+				}, nil
+			}
+		}
+		return nil, errors.Join(errors.New(PLANNER_PRICE_ERROR), fmt.Errorf("no rate %s in tariff %s", item.TariffName, item.TariffId))
+	}
+	return nil, errors.Join(errors.New(PLANNER_PRICE_ERROR), fmt.Errorf("no tariff scheduled at %s", at.Format(time.RFC3339)))
+}
+
+// PriceAt returns the cost of the rate scheduled at the time.
+func (p ScheduledPrices) PriceAt(at time.Time) (float64, error) {
+	rate, err := p.RateAt(at)
+	if err != nil {
+		return 0, err
+	}
+	return rate.Price, nil
+}
+
+// IntervalAt returns the scheduled interval containing at.
+func (p ScheduledPrices) IntervalAt(at time.Time) (Price, error) {
+	rate, err := p.RateAt(at)
+	if err != nil {
+		return Price{}, err
+	}
+	return Price{From: rate.From, To: rate.Until, Price: rate.Price}, nil
+}
+
+func minuteOfDay(hourMinute string) (int, error) {
+	hour, minute, ok := strings.Cut(hourMinute, ":")
+	h, herr := strconv.Atoi(hour)
+	m, merr := strconv.Atoi(minute)
+	if !ok || herr != nil || merr != nil || h < 0 || h > 24 || m < 0 || m > 59 {
+		return 0, errors.Join(errors.New(PLANNER_PRICE_ERROR), fmt.Errorf("invalid time of day %q", hourMinute))
+	}
+	return (h*60 + m) % (24 * 60), nil
+}
@@ -0,0 +1,363 @@
+// Package policies builds and validates the smart charging policies of
+// vehicles and chargers, and sends only the fields which change:
+//
+//	policy, err := policies.NewVehiclePolicy().Enable().Deadline("07:00").MinimumChargeLimit(40).
+//		Apply(ctx, sess, vehicleId)
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/addihorn/enode-gosdk/pkg/chargers"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+)
+
+const (
+	POLICY_DEADLINE_ERROR     string = "policies: the deadline must be given as HH:MM"
+	POLICY_DURATION_ERROR     string = "policies: the charging duration must be given as HH:MM"
+	POLICY_CHARGE_LIMIT_ERROR string = "policies: the minimum charge limit must be between 0 and 100"
+	POLICY_CONFLICT_ERROR     string = "policies: smart charging is both enabled and disabled"
+	POLICY_INCOMPLETE_ERROR   string = "policies: smart charging cannot be enabled without a deadline"
+)
+
+var clock = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+func validateClock(value string, message string) error {
+	if !clock.MatchString(value) {
+		return errors.Join(errors.New(message), fmt.Errorf("got %q", value))
+	}
+	return nil
+}
+
+// enabled tracks the calls of Enable and Disable on a builder.
+type enabled struct {
+	value    *bool
+	conflict bool
+}
+
+func (e *enabled) set(value bool) {
+	if e.value != nil && *e.value != value {
+		e.conflict = true
+	}
+	e.value = &value
+}
+
+func (e *enabled) validate() error {
+	if e.conflict {
+		return errors.New(POLICY_CONFLICT_ERROR)
+	}
+	return nil
+}
+
+/*
+Validates a complete vehicle smart charging policy.
+
+Parameters:
+  - policy: The policy to validate.
+
+Returns:
+  - An error joining all violations, nil if the policy is valid.
+*/
+func ValidateVehiclePolicy(policy models.VehicleSmartChargingPolicy) error {
+	var errs []error
+	if policy.Deadline != "" || policy.IsEnabled {
+		errs = append(errs, validateClock(policy.Deadline, POLICY_DEADLINE_ERROR))
+	}
+	if policy.IsEnabled && policy.Deadline == "" {
+		errs = append(errs, errors.New(POLICY_INCOMPLETE_ERROR))
+	}
+	if policy.MinimumChargeLimit < 0 || policy.MinimumChargeLimit > 100 {
+		errs = append(errs, errors.Join(errors.New(POLICY_CHARGE_LIMIT_ERROR), fmt.Errorf("got %v", policy.MinimumChargeLimit)))
+	}
+	return errors.Join(errs...)
+}
+
+/*
+Validates a complete charger smart charging policy.
+
+Parameters:
+  - policy: The policy to validate.
+
+Returns:
+  - An error joining all violations, nil if the policy is valid.
+*/
+func ValidateChargerPolicy(policy models.ChargerSmartChargingPolicy) error {
+	var errs []error
+	if policy.Deadline != "" || policy.IsEnabled {
+		errs = append(errs, validateClock(policy.Deadline, POLICY_DEADLINE_ERROR))
+	}
+	if policy.IsEnabled && policy.Deadline == "" {
+		errs = append(errs, errors.New(POLICY_INCOMPLETE_ERROR))
+	}
+	if policy.ChargingDuration != "" {
+		errs = append(errs, validateClock(policy.ChargingDuration, POLICY_DURATION_ERROR))
+	}
+	return errors.Join(errs...)
+}
+
+/*
+Computes the partial update turning one vehicle policy into another.
+
+Parameters:
+  - current: The policy in effect.
+  - desired: The policy to reach.
+
+Returns:
+  - A pointer to the partial policy holding the changed fields only, nil if nothing changes.
+*/
+func DiffVehiclePolicy(current, desired models.VehicleSmartChargingPolicy) *models.PartialVehicleSmartChargingPolicy {
+	diff := models.PartialVehicleSmartChargingPolicy{}
+	changed := false
+	if current.IsEnabled != desired.IsEnabled {
+		diff.IsEnabled, changed = &desired.IsEnabled, true
+	}
+	if current.Deadline != desired.Deadline {
+		diff.Deadline, changed = &desired.Deadline, true
+	}
+	if current.MinimumChargeLimit != desired.MinimumChargeLimit {
+		diff.MinimumChargeLimit, changed = &desired.MinimumChargeLimit, true
+	}
+	if !changed {
+		return nil
+	}
+	return &diff
+}
+
+/*
+Computes the partial update turning one charger policy into another.
+
+Parameters:
+  - current: The policy in effect.
+  - desired: The policy to reach.
+
+Returns:
+  - A pointer to the partial policy holding the changed fields only, nil if nothing changes.
+*/
+func DiffChargerPolicy(current, desired models.ChargerSmartChargingPolicy) *models.PartialChargerSmartChargingPolicy {
+	diff := models.PartialChargerSmartChargingPolicy{}
+	changed := false
+	if current.IsEnabled != desired.IsEnabled {
+		diff.IsEnabled, changed = &desired.IsEnabled, true
+	}
+	if current.Deadline != desired.Deadline {
+		diff.Deadline, changed = &desired.Deadline, true
+	}
+	if current.ChargingDuration != desired.ChargingDuration {
+		diff.ChargingDuration, changed = &desired.ChargingDuration, true
+	}
+	if !changed {
+		return nil
+	}
+	return &diff
+}
+
+// VehiclePolicyBuilder collects the changes to the smart charging policy of a vehicle.
+type VehiclePolicyBuilder struct {
+	enabled            enabled
+	deadline           *string
+	minimumChargeLimit *float64
+}
+
+// NewVehiclePolicy starts a builder leaving all fields of the policy unchanged.
+func NewVehiclePolicy() *VehiclePolicyBuilder {
+	return &VehiclePolicyBuilder{}
+}
+
+// Enable enables smart charging.
+func (b *VehiclePolicyBuilder) Enable() *VehiclePolicyBuilder {
+	b.enabled.set(true)
+	return b
+}
+
+// Disable disables smart charging.
+func (b *VehiclePolicyBuilder) Disable() *VehiclePolicyBuilder {
+	b.enabled.set(false)
+	return b
+}
+
+// Deadline sets the HH:MM deadline for fully charging the vehicle.
+func (b *VehiclePolicyBuilder) Deadline(deadline string) *VehiclePolicyBuilder {
+	b.deadline = &deadline
+	return b
+}
+
+// MinimumChargeLimit sets the percentage charged promptly, disregarding energy prices.
+func (b *VehiclePolicyBuilder) MinimumChargeLimit(limit float64) *VehiclePolicyBuilder {
+	b.minimumChargeLimit = &limit
+	return b
+}
+
+/*
+Validates the changes and returns them as partial policy.
+
+Returns:
+  - A pointer to the partial policy holding the fields set on the builder.
+  - An error joining all violations of the changes.
+*/
+func (b *VehiclePolicyBuilder) Build() (*models.PartialVehicleSmartChargingPolicy, error) {
+	errs := []error{b.enabled.validate()}
+	if b.deadline != nil {
+		errs = append(errs, validateClock(*b.deadline, POLICY_DEADLINE_ERROR))
+	}
+	if b.minimumChargeLimit != nil && (*b.minimumChargeLimit < 0 || *b.minimumChargeLimit > 100) {
+		errs = append(errs, errors.Join(errors.New(POLICY_CHARGE_LIMIT_ERROR), fmt.Errorf("got %v", *b.minimumChargeLimit)))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return &models.PartialVehicleSmartChargingPolicy{IsEnabled: b.enabled.value, Deadline: b.deadline, MinimumChargeLimit: b.minimumChargeLimit}, nil
+}
+
+// merge applies the changes to a complete policy.
+func (b *VehiclePolicyBuilder) merge(policy models.VehicleSmartChargingPolicy) models.VehicleSmartChargingPolicy {
+	if b.enabled.value != nil {
+		policy.IsEnabled = *b.enabled.value
+	}
+	if b.deadline != nil {
+		policy.Deadline = *b.deadline
+	}
+	if b.minimumChargeLimit != nil {
+		policy.MinimumChargeLimit = *b.minimumChargeLimit
+	}
+	return policy
+}
+
+/*
+Applies the changes to the smart charging policy of a vehicle. The current policy
+is fetched and the resulting policy validated as a whole, then only the changed
+fields are sent.
+
+Parameters:
+  - sess: The session of the client.
+  - vehicleId: The ID of the vehicle.
+
+Returns:
+  - A pointer to the resulting policy, the current one if nothing changes.
+  - An error if the changes are invalid or any occurred during the requests.
+*/
+func (b *VehiclePolicyBuilder) Apply(ctx context.Context, sess *session.Session, vehicleId string) (*models.VehicleSmartChargingPolicy, error) {
+	if _, err := b.Build(); err != nil {
+		return nil, err
+	}
+	current, err := vehicles.GetVehicleSmartChargingPolicy(ctx, sess, vehicleId)
+	if err != nil {
+		return nil, err
+	}
+	desired := b.merge(*current)
+	if err := ValidateVehiclePolicy(desired); err != nil {
+		return nil, err
+	}
+	diff := DiffVehiclePolicy(*current, desired)
+	if diff == nil {
+		return current, nil
+	}
+	return vehicles.UpdateVehicleSmartChargingPolicy(ctx, sess, vehicleId, diff)
+}
+
+// ChargerPolicyBuilder collects the changes to the smart charging policy of a charger.
+type ChargerPolicyBuilder struct {
+	enabled          enabled
+	deadline         *string
+	chargingDuration *string
+}
+
+// NewChargerPolicy starts a builder leaving all fields of the policy unchanged.
+func NewChargerPolicy() *ChargerPolicyBuilder {
+	return &ChargerPolicyBuilder{}
+}
+
+// Enable enables smart charging.
+func (b *ChargerPolicyBuilder) Enable() *ChargerPolicyBuilder {
+	b.enabled.set(true)
+	return b
+}
+
+// Disable disables smart charging.
+func (b *ChargerPolicyBuilder) Disable() *ChargerPolicyBuilder {
+	b.enabled.set(false)
+	return b
+}
+
+// Deadline sets the HH:MM deadline of each cycle.
+func (b *ChargerPolicyBuilder) Deadline(deadline string) *ChargerPolicyBuilder {
+	b.deadline = &deadline
+	return b
+}
+
+// ChargingDuration sets the HH:MM charging duration of each cycle.
+func (b *ChargerPolicyBuilder) ChargingDuration(duration string) *ChargerPolicyBuilder {
+	b.chargingDuration = &duration
+	return b
+}
+
+/*
+Validates the changes and returns them as partial policy.
+
+Returns:
+  - A pointer to the partial policy holding the fields set on the builder.
+  - An error joining all violations of the changes.
+*/
+func (b *ChargerPolicyBuilder) Build() (*models.PartialChargerSmartChargingPolicy, error) {
+	errs := []error{b.enabled.validate()}
+	if b.deadline != nil {
+		errs = append(errs, validateClock(*b.deadline, POLICY_DEADLINE_ERROR))
+	}
+	if b.chargingDuration != nil {
+		errs = append(errs, validateClock(*b.chargingDuration, POLICY_DURATION_ERROR))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return &models.PartialChargerSmartChargingPolicy{IsEnabled: b.enabled.value, Deadline: b.deadline, ChargingDuration: b.chargingDuration}, nil
+}
+
+// merge applies the changes to a complete policy.
+func (b *ChargerPolicyBuilder) merge(policy models.ChargerSmartChargingPolicy) models.ChargerSmartChargingPolicy {
+	if b.enabled.value != nil {
+		policy.IsEnabled = *b.enabled.value
+	}
+	if b.deadline != nil {
+		policy.Deadline = *b.deadline
+	}
+	if b.chargingDuration != nil {
+		policy.ChargingDuration = *b.chargingDuration
+	}
+	return policy
+}
+
+/*
+Applies the changes to the smart charging policy of a charger. The current policy
+is fetched and the resulting policy validated as a whole, then only the changed
+fields are sent.
+
+Parameters:
+  - sess: The session of the client.
+  - chargerId: The ID of the charger.
+
+Returns:
+  - A pointer to the resulting policy, the current one if nothing changes.
+  - An error if the changes are invalid or any occurred during the requests.
+*/
+func (b *ChargerPolicyBuilder) Apply(ctx context.Context, sess *session.Session, chargerId string) (*models.ChargerSmartChargingPolicy, error) {
+	if _, err := b.Build(); err != nil {
+		return nil, err
+	}
+	current, err := chargers.GetChargerSmartPolicy(ctx, sess, chargerId)
+	if err != nil {
+		return nil, err
+	}
+	desired := b.merge(*current)
+	if err := ValidateChargerPolicy(desired); err != nil {
+		return nil, err
+	}
+	diff := DiffChargerPolicy(*current, desired)
+	if diff == nil {
+		return current, nil
+	}
+	return chargers.UpdateChargerSmartPolicy(ctx, sess, chargerId, diff)
+}
@@ -0,0 +1,120 @@
+package policies_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/policies"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+func hasError(err error, message string) bool {
+	return err != nil && strings.Contains(err.Error(), message)
+}
+
+func TestVehiclePolicyBuilder_Build(t *testing.T) {
+	if _, err := policies.NewVehiclePolicy().Deadline("7:00").Build(); !hasError(err, policies.POLICY_DEADLINE_ERROR) {
+		t.Errorf("Expected a deadline error, got %v", err)
+	}
+	if _, err := policies.NewVehiclePolicy().Deadline("24:00").Build(); !hasError(err, policies.POLICY_DEADLINE_ERROR) {
+		t.Errorf("Expected a deadline error, got %v", err)
+	}
+	if _, err := policies.NewVehiclePolicy().MinimumChargeLimit(120).Build(); !hasError(err, policies.POLICY_CHARGE_LIMIT_ERROR) {
+		t.Errorf("Expected a charge limit error, got %v", err)
+	}
+	if _, err := policies.NewVehiclePolicy().Enable().Disable().Build(); !hasError(err, policies.POLICY_CONFLICT_ERROR) {
+		t.Errorf("Expected a conflict error, got %v", err)
+	}
+
+	partial, err := policies.NewVehiclePolicy().Enable().Deadline("07:30").Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if partial.IsEnabled == nil || !*partial.IsEnabled || *partial.Deadline != "07:30" || partial.MinimumChargeLimit != nil {
+		t.Errorf("Unexpected partial policy %+v", partial)
+	}
+}
+
+func TestValidateChargerPolicy(t *testing.T) {
+	if err := policies.ValidateChargerPolicy(models.ChargerSmartChargingPolicy{IsEnabled: true}); !hasError(err, policies.POLICY_INCOMPLETE_ERROR) {
+		t.Errorf("Expected an incomplete error, got %v", err)
+	}
+	if err := policies.ValidateChargerPolicy(models.ChargerSmartChargingPolicy{Deadline: "07:00", ChargingDuration: "3h"}); !hasError(err, policies.POLICY_DURATION_ERROR) {
+		t.Errorf("Expected a duration error, got %v", err)
+	}
+	if err := policies.ValidateChargerPolicy(models.ChargerSmartChargingPolicy{IsEnabled: true, Deadline: "07:00", ChargingDuration: "03:00"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestDiffVehiclePolicy(t *testing.T) {
+	current := models.VehicleSmartChargingPolicy{IsEnabled: true, Deadline: "07:00", MinimumChargeLimit: 20}
+	if diff := policies.DiffVehiclePolicy(current, current); diff != nil {
+		t.Errorf("Expected no diff, got %+v", diff)
+	}
+	desired := current
+	desired.MinimumChargeLimit = 40
+	diff := policies.DiffVehiclePolicy(current, desired)
+	if diff == nil || diff.IsEnabled != nil || diff.Deadline != nil || *diff.MinimumChargeLimit != 40 {
+		t.Errorf("Expected the charge limit only, got %+v", diff)
+	}
+}
+
+func TestVehiclePolicyBuilder_Apply(t *testing.T) {
+	// Create a test server holding the policy of a vehicle, recording the updates
+	policy := `{"isEnabled":false,"deadline":"07:00","minimumChargeLimit":20}`
+	var updates []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/vehicles/vehicle_1/smart-charging-policy" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			updates = append(updates, string(body))
+			var partial map[string]any
+			json.Unmarshal(body, &partial)
+			var current map[string]any
+			json.Unmarshal([]byte(policy), &current)
+			for key, value := range partial {
+				current[key] = value
+			}
+			updated, _ := json.Marshal(current)
+			policy = string(updated)
+		}
+		fmt.Fprint(w, policy)
+	}))
+	defer ts.Close()
+
+	sess := session.NewSession(&auth.Authentication{Environment: ts.URL, Access_token: "test_token"})
+	ctx := context.Background()
+
+	result, err := policies.NewVehiclePolicy().Enable().Deadline("07:00").Apply(ctx, sess, "vehicle_1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.IsEnabled || len(updates) != 1 || updates[0] != `{"isEnabled":true}` {
+		t.Errorf("Expected isEnabled to be sent only, got %v", updates)
+	}
+
+	if _, err := policies.NewVehiclePolicy().Enable().Apply(ctx, sess, "vehicle_1"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(updates) != 1 {
+		t.Errorf("Expected no update without changes, got %v", updates)
+	}
+
+	if _, err := policies.NewVehiclePolicy().MinimumChargeLimit(-1).Apply(ctx, sess, "vehicle_1"); !hasError(err, policies.POLICY_CHARGE_LIMIT_ERROR) {
+		t.Errorf("Expected a charge limit error, got %v", err)
+	}
+}
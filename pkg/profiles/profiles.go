@@ -0,0 +1,382 @@
+// Package profiles loads named client configurations, so one program can hold
+// the sessions of several Enode clients, e.g. one per country, at the same time.
+//
+// Profiles are read from a JSON file:
+//
+//	{
+//	  "environments": {
+//	    "staging": {"apiUrl": "https://enode-proxy.staging.example.com", "tokenUrl": "https://auth.staging.example.com/oauth2/token"}
+//	  },
+//	  "profiles": {
+//	    "de": {"environment": "production", "clientId": "...", "clientSecret": "${ENODE_DE_CLIENT_SECRET}", "rateLimit": "300/1m,10"},
+//	    "staging": {"environment": "staging", "clientId": "...", "clientSecret": "...", "apiVersion": "2023-08-01"}
+//	  }
+//	}
+//
+// or from the environment, see FromEnv.
+package profiles
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/enums/environments"
+	"github.com/addihorn/enode-gosdk/pkg/ratelimit"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+const (
+	PROFILE_READ_ERROR    string = "profiles: unable to read profiles"
+	PROFILE_NOT_FOUND     string = "profiles: unknown profile"
+	PROFILE_INVALID_ERROR string = "profiles: invalid profile"
+	PROFILE_SESSION_ERROR string = "profiles: unable to authenticate profile"
+)
+
+const (
+	// DEFAULT_PROFILE is the name of the profile read from the ENODE_ variables.
+	DEFAULT_PROFILE = "default"
+	// DEFAULT_ENVIRONMENT is used by profiles without environment.
+	DEFAULT_ENVIRONMENT = "sandbox"
+)
+
+// Profile is the configuration of one Enode client.
+type Profile struct {
+	Name string `json:"-"`
+	// Environment is the name of a known or custom environment, or the URL of the API.
+	Environment string `json:"environment"`
+	// TokenUrl overrides the token URL of the environment.
+	TokenUrl     string `json:"tokenUrl,omitempty"`
+	ClientId     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+	// ClientSecretFile is read instead of ClientSecret, again after it changed.
+	ClientSecretFile string `json:"clientSecretFile,omitempty"`
+	// ClientSecretCommand prints the client secret, e.g. the CLI of a secret manager.
+	ClientSecretCommand []string `json:"clientSecretCommand,omitempty"`
+	// ApiVersion pins the API version of the sessions of the profile, versions.DEFAULT if empty.
+	ApiVersion string `json:"apiVersion,omitempty"`
+	// RateLimit is the quota of the client, e.g. 300/1m or 300/1m,10 with a burst, see
+	// ratelimit.ParseLimit. Requests are not throttled on the client if empty.
+	RateLimit string `json:"rateLimit,omitempty"`
+	// TokenCache shares the tokens of the profile with other processes if set.
+	TokenCache auth.TokenCache `json:"-"`
+
+	// resolved is the environment the profile connects to
+	resolved environments.Environment
+}
+
+// Env returns the environment of the profile, with its explicit token URL applied.
+func (p *Profile) Env() environments.Environment {
+	return p.resolved
+}
+
+/*
+Authenticates the client of the profile and returns a session for it. Its token
+is refreshed before it expires until sess.Authentication.Stop is called. If the
+profile has a RateLimit, the API requests of the session are throttled by a
+limiter of their own, wrapping the transport of httpClient.
+
+Parameters:
+  - httpClient: The client sending token requests and API requests, http.DefaultClient if nil.
+
+Returns:
+  - A session pinned to the API version of the profile.
+  - An error with PROFILE_SESSION_ERROR if the client could not be authenticated.
+*/
+func (p *Profile) Session(httpClient *http.Client) (*session.Session, error) {
+	if p.resolved.ApiUrl == "" {
+		if err := p.resolve(nil); err != nil {
+			return nil, err
+		}
+	}
+	var limit ratelimit.Limit
+	if p.RateLimit != "" {
+		var err error
+		if limit, err = ratelimit.ParseLimit(p.RateLimit); err != nil {
+			return nil, errors.Join(errors.New(PROFILE_INVALID_ERROR), fmt.Errorf("profile %s: rate limit", p.Name), err)
+		}
+	}
+	authentication, err := auth.New(auth.Config{
+		Credentials:           p.Credentials(),
+		Environment:           p.resolved.ApiUrl,
+		TokenUrl:              p.resolved.Token(),
+		HttpClient:            httpClient,
+		Cache:                 p.TokenCache,
+		AutomaticTokenRefresh: true,
+	})
+	if err != nil {
+		return nil, errors.Join(errors.New(PROFILE_SESSION_ERROR), fmt.Errorf("profile %s", p.Name), err)
+	}
+	sess := session.NewSession(authentication)
+	sess.HttpClient = httpClient
+	sess.ApiVersion = p.ApiVersion
+	if p.RateLimit != "" {
+		client := http.Client{}
+		if httpClient != nil {
+			client = *httpClient
+		}
+		limited, err := ratelimit.NewTransport(client.Transport, limit)
+		if err != nil {
+			return nil, errors.Join(errors.New(PROFILE_INVALID_ERROR), fmt.Errorf("profile %s: rate limit", p.Name), err)
+		}
+		client.Transport = limited
+		sess.HttpClient = &client
+	}
+	return sess, nil
+}
+
+// Credentials returns the provider of the client credentials of the profile.
+func (p *Profile) Credentials() auth.CredentialsProvider {
+	switch {
+	case p.ClientSecretFile != "":
+		return &auth.FileCredentials{ClientId: p.ClientId, ClientSecretFile: p.ClientSecretFile}
+	case len(p.ClientSecretCommand) > 0:
+		return &auth.ExecCredentials{ClientId: p.ClientId, Command: p.ClientSecretCommand}
+	}
+	return auth.StaticCredentials{ClientId: p.ClientId, ClientSecret: p.ClientSecret}
+}
+
+// resolve looks up the environment of the profile and checks its credentials.
+func (p *Profile) resolve(custom map[string]environments.Environment) error {
+	name := p.Environment
+	if name == "" {
+		name = DEFAULT_ENVIRONMENT
+	}
+	env, ok := custom[name]
+	if !ok {
+		env = environments.Lookup(name)
+	}
+	if env.Name == "" {
+		env.Name = name
+	}
+	if p.TokenUrl != "" {
+		env.TokenUrl = p.TokenUrl
+	}
+	if !strings.HasPrefix(env.ApiUrl, "http://") && !strings.HasPrefix(env.ApiUrl, "https://") {
+		return errors.Join(errors.New(PROFILE_INVALID_ERROR), fmt.Errorf("profile %s: unknown environment %q", p.Name, name))
+	}
+	if p.ClientId == "" || (p.ClientSecret == "" && p.ClientSecretFile == "" && len(p.ClientSecretCommand) == 0) {
+		return errors.Join(errors.New(PROFILE_INVALID_ERROR), fmt.Errorf("profile %s: client ID and client secret are required", p.Name))
+	}
+	if p.RateLimit != "" {
+		if _, err := ratelimit.ParseLimit(p.RateLimit); err != nil {
+			return errors.Join(errors.New(PROFILE_INVALID_ERROR), fmt.Errorf("profile %s: rate limit", p.Name), err)
+		}
+	}
+	env.ApiUrl = strings.TrimSuffix(env.ApiUrl, "/")
+	p.resolved = env
+	return nil
+}
+
+// expand applies expand to the string fields of the profile.
+func (p *Profile) expand(expand func(string) string) {
+	for _, field := range []*string{&p.Environment, &p.TokenUrl, &p.ClientId, &p.ClientSecret, &p.ClientSecretFile, &p.ApiVersion, &p.RateLimit} {
+		*field = expand(*field)
+	}
+	for i := range p.ClientSecretCommand {
+		p.ClientSecretCommand[i] = expand(p.ClientSecretCommand[i])
+	}
+}
+
+// File is the content of a profiles file.
+type File struct {
+	// Environments defines custom environments by name, in addition to the known ones.
+	Environments map[string]environments.Environment `json:"environments"`
+	Profiles     map[string]*Profile                 `json:"profiles"`
+}
+
+// variable matches the references to environment variables expanded by Load.
+var variable = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+/*
+Loads the profiles of a JSON file. References to environment variables like
+${ENODE_DE_CLIENT_SECRET} in string values are expanded, so secrets need not be
+stored in the file. Other $ characters are kept as they are.
+
+Parameters:
+  - path: The path of the file.
+
+Returns:
+  - The profiles of the file by name.
+  - An error with PROFILE_READ_ERROR or PROFILE_INVALID_ERROR.
+*/
+func Load(path string) (map[string]*Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Join(errors.New(PROFILE_READ_ERROR), err)
+	}
+	return parse(data, func(value string) string {
+		return variable.ReplaceAllStringFunc(value, func(reference string) string {
+			return os.Getenv(variable.FindStringSubmatch(reference)[1])
+		})
+	})
+}
+
+// Parse reads profiles from the content of a profiles file, without expanding variables.
+func Parse(data []byte) (map[string]*Profile, error) {
+	return parse(data, func(value string) string { return value })
+}
+
+// parse reads profiles, applying expand to the string values after decoding,
+// so expanded values cannot change the structure of the file.
+func parse(data []byte, expand func(string) string) (map[string]*Profile, error) {
+	var file File
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, errors.Join(errors.New(PROFILE_READ_ERROR), err)
+	}
+	for name, env := range file.Environments {
+		env.Name, env.ApiUrl, env.TokenUrl = expand(env.Name), expand(env.ApiUrl), expand(env.TokenUrl)
+		if env.Name == "" {
+			env.Name = name
+		}
+		file.Environments[name] = env
+	}
+	for _, profile := range file.Profiles {
+		profile.expand(expand)
+	}
+	for name, profile := range file.Profiles {
+		profile.Name = name
+		if err := profile.resolve(file.Environments); err != nil {
+			return nil, err
+		}
+	}
+	return file.Profiles, nil
+}
+
+/*
+Reads a profile from environment variables. The default profile, with an empty
+name, reads ENODE_ENVIRONMENT, ENODE_TOKEN_URL, ENODE_CLIENT_ID, ENODE_CLIENT_SECRET
+or ENODE_CLIENT_SECRET_FILE, ENODE_API_VERSION and ENODE_RATE_LIMIT. A named profile reads the same variables prefixed with
+ENODE_PROFILE_<NAME>_, e.g. ENODE_PROFILE_DE_CLIENT_ID.
+
+Parameters:
+  - name: The name of the profile, empty for the default profile.
+  - getenv: Looks up environment variables, os.Getenv if nil.
+
+Returns:
+  - The profile.
+  - An error with PROFILE_INVALID_ERROR if the variables are incomplete.
+*/
+func FromEnv(name string, getenv func(string) string) (*Profile, error) {
+	if getenv == nil {
+		getenv = os.Getenv
+	}
+	prefix := "ENODE_"
+	if name != "" {
+		prefix = "ENODE_PROFILE_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+	} else {
+		name = DEFAULT_PROFILE
+	}
+
+	profile := &Profile{
+		Name:         name,
+		Environment:  getenv(prefix + "ENVIRONMENT"),
+		TokenUrl:     getenv(prefix + "TOKEN_URL"),
+		ClientId:     getenv(prefix + "CLIENT_ID"),
+		ClientSecret: getenv(prefix + "CLIENT_SECRET"),
+		// e.g. a Kubernetes secret mounted as file
+		ClientSecretFile: getenv(prefix + "CLIENT_SECRET_FILE"),
+		ApiVersion:       getenv(prefix + "API_VERSION"),
+		RateLimit:        getenv(prefix + "RATE_LIMIT"),
+	}
+	if err := profile.resolve(nil); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// Clients holds the sessions of several profiles, authenticating each profile
+// once on first use. It is safe for concurrent use.
+type Clients struct {
+	// HttpClient is passed to Profile.Session.
+	HttpClient *http.Client
+
+	mu       sync.Mutex
+	profiles map[string]*Profile
+	sessions map[string]*pending
+}
+
+// pending is the session of a profile, available once done is closed.
+type pending struct {
+	done chan struct{}
+	sess *session.Session
+	err  error
+}
+
+func NewClients(profiles map[string]*Profile) *Clients {
+	return &Clients{profiles: profiles, sessions: make(map[string]*pending)}
+}
+
+// Names returns the sorted names of the profiles.
+func (c *Clients) Names() []string {
+	names := make([]string, 0, len(c.profiles))
+	for name := range c.profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+Returns the session of a profile, authenticating it on first use. Concurrent
+callers wait for the same authentication, while other profiles are not held up.
+A failed authentication is attempted again by the next call.
+
+Parameters:
+  - name: The name of the profile.
+
+Returns:
+  - The session of the profile.
+  - An error with PROFILE_NOT_FOUND or PROFILE_SESSION_ERROR.
+*/
+func (c *Clients) Session(name string) (*session.Session, error) {
+	profile, ok := c.profiles[name]
+	if !ok {
+		return nil, errors.Join(errors.New(PROFILE_NOT_FOUND), fmt.Errorf("profile %q", name))
+	}
+
+	c.mu.Lock()
+	p, ok := c.sessions[name]
+	if ok {
+		c.mu.Unlock()
+		<-p.done
+		return p.sess, p.err
+	}
+	p = &pending{done: make(chan struct{})}
+	c.sessions[name] = p
+	c.mu.Unlock()
+
+	p.sess, p.err = profile.Session(c.HttpClient)
+	if p.err != nil {
+		c.mu.Lock()
+		if c.sessions[name] == p {
+			delete(c.sessions, name)
+		}
+		c.mu.Unlock()
+	}
+	close(p.done)
+	return p.sess, p.err
+}
+
+// Close stops the automatic token refresh of the sessions, waiting for those
+// being authenticated. Sessions requested afterwards are authenticated anew.
+func (c *Clients) Close() {
+	c.mu.Lock()
+	sessions := c.sessions
+	c.sessions = make(map[string]*pending)
+	c.mu.Unlock()
+
+	for _, p := range sessions {
+		<-p.done
+		if p.sess != nil {
+			p.sess.Authentication.Stop()
+		}
+	}
+}
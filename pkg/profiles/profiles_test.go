@@ -0,0 +1,281 @@
+package profiles_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/enodetest"
+	"github.com/addihorn/enode-gosdk/pkg/enums/environments"
+	"github.com/addihorn/enode-gosdk/pkg/enums/versions"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/profiles"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+)
+
+func TestParse(t *testing.T) {
+	all, err := profiles.Parse([]byte(`{
+		"environments": {"staging": {"apiUrl": "https://proxy.example.com/", "tokenUrl": "https://auth.example.com/token"}},
+		"profiles": {
+			"de": {"environment": "PRODUCTION", "clientId": "id_de", "clientSecret": "secret_de", "apiVersion": "2023-08-01"},
+			"staging": {"environment": "staging", "clientId": "id_staging", "clientSecret": "secret_staging"},
+			"local": {"environment": "http://localhost:8080", "tokenUrl": "http://localhost:9090/token", "clientId": "id", "clientSecret": "secret"}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		profile  string
+		apiUrl   string
+		tokenUrl string
+	}{
+		{"de", environments.PRODUCTION, environments.Production.TokenUrl},
+		{"staging", "https://proxy.example.com", "https://auth.example.com/token"},
+		{"local", "http://localhost:8080", "http://localhost:9090/token"},
+	}
+	for _, test := range tests {
+		env := all[test.profile].Env()
+		if env.ApiUrl != test.apiUrl || env.Token() != test.tokenUrl {
+			t.Errorf("Expected profile %s to use %s and %s, got %+v", test.profile, test.apiUrl, test.tokenUrl, env)
+		}
+	}
+	if all["de"].ApiVersion != versions.V2023_08_01 {
+		t.Errorf("Expected the API version of the profile, got %s", all["de"].ApiVersion)
+	}
+
+	if _, err := profiles.Parse([]byte(`{"profiles": {"x": {"environment": "unknown", "clientId": "id", "clientSecret": "secret"}}}`)); err == nil {
+		t.Error("Expected an error for an unknown environment")
+	}
+}
+
+func TestLoad_ExpandsVariables(t *testing.T) {
+	// the value would break the JSON of the file if expanded into its text
+	t.Setenv("TEST_CLIENT_SECRET", `se"cr,et`)
+	t.Setenv("TEST_API_URL", "https://proxy.example.com")
+	t.Setenv("HOME", "/home/test")
+	path := filepath.Join(t.TempDir(), "profiles.json")
+	data := `{
+		"environments": {"staging": {"apiUrl": "${TEST_API_URL}"}},
+		"profiles": {
+			"de": {"environment": "staging", "clientId": "id_de", "clientSecret": "${TEST_CLIENT_SECRET}"},
+			"fr": {"environment": "staging", "clientId": "id_fr", "clientSecret": "pa$$word$HOME"}
+		}
+	}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	all, err := profiles.Load(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if all["de"].ClientSecret != `se"cr,et` || all["de"].Env().ApiUrl != "https://proxy.example.com" {
+		t.Errorf("Expected the variables to be expanded, got %+v", all["de"])
+	}
+	if all["fr"].ClientSecret != "pa$$word$HOME" {
+		t.Errorf("Expected a secret without ${} references to be kept, got %s", all["fr"].ClientSecret)
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	env := map[string]string{
+		"ENODE_CLIENT_ID":                      "default_id",
+		"ENODE_CLIENT_SECRET":                  "default_secret",
+		"ENODE_PROFILE_NL_SOUTH_ENVIRONMENT":   "production",
+		"ENODE_PROFILE_NL_SOUTH_CLIENT_ID":     "nl_id",
+		"ENODE_PROFILE_NL_SOUTH_CLIENT_SECRET": "nl_secret",
+	}
+	getenv := func(key string) string { return env[key] }
+
+	profile, err := profiles.FromEnv("", getenv)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if profile.Name != profiles.DEFAULT_PROFILE || profile.Env().ApiUrl != environments.SANDBOX || profile.ClientId != "default_id" {
+		t.Errorf("Unexpected default profile %+v", profile)
+	}
+
+	profile, err = profiles.FromEnv("nl-south", getenv)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if profile.Env().ApiUrl != environments.PRODUCTION || profile.ClientSecret != "nl_secret" {
+		t.Errorf("Unexpected named profile %+v", profile)
+	}
+
+	if _, err := profiles.FromEnv("missing", getenv); err == nil {
+		t.Error("Expected an error for a profile without credentials")
+	}
+}
+
+func TestClients_Concurrent(t *testing.T) {
+	all := map[string]*profiles.Profile{}
+	for _, name := range []string{"de", "nl", "no"} {
+		server := enodetest.NewServer()
+		defer server.Close()
+		server.AddVehicle("user_"+name, models.VehicleWithLocation{})
+		all[name] = &profiles.Profile{Name: name, Environment: server.URL, ClientId: enodetest.CLIENT_ID, ClientSecret: enodetest.CLIENT_SECRET}
+	}
+	clients := profiles.NewClients(all)
+
+	var wg sync.WaitGroup
+	for _, name := range clients.Names() {
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func(name string) {
+				defer wg.Done()
+				sess, err := clients.Session(name)
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+					return
+				}
+				list, err := vehicles.ListVehicles(context.Background(), sess, nil)
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+					return
+				}
+				if len(list.Data) != 1 || list.Data[0].UserId != "user_"+name {
+					t.Errorf("Expected the vehicle of profile %s, got %+v", name, list.Data)
+				}
+			}(name)
+		}
+	}
+	wg.Wait()
+
+	if _, err := clients.Session("unknown"); err == nil {
+		t.Error("Expected an error for an unknown profile")
+	}
+}
+
+func TestClients_SlowAuthentication(t *testing.T) {
+	release := make(chan struct{})
+	var tokens atomic.Int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if tokens.Add(1) == 1 {
+			<-release
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"access_token":"token","token_type":"bearer","expires_in":3600}`)
+	}))
+	defer tokenServer.Close()
+	fast := enodetest.NewServer()
+	defer fast.Close()
+
+	clients := profiles.NewClients(map[string]*profiles.Profile{
+		"slow": {Name: "slow", Environment: "https://proxy.example.com", TokenUrl: tokenServer.URL + "/token", ClientId: "id", ClientSecret: "secret"},
+		"fast": {Name: "fast", Environment: fast.URL, ClientId: enodetest.CLIENT_ID, ClientSecret: enodetest.CLIENT_SECRET},
+	})
+	defer clients.Close()
+
+	failed := make(chan error, 1)
+	go func() {
+		_, err := clients.Session("slow")
+		failed <- err
+	}()
+	for tokens.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	// the token request of one profile does not hold up the others
+	if _, err := clients.Session("fast"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	close(release)
+	if err := <-failed; err == nil {
+		t.Fatal("Expected the failed authentication to be reported")
+	}
+
+	// a failed authentication is attempted again
+	if _, err := clients.Session("slow"); err != nil || tokens.Load() != 2 {
+		t.Errorf("Expected a second token request, got %d requests and %v", tokens.Load(), err)
+	}
+}
+
+func TestClients_Close(t *testing.T) {
+	var tokens atomic.Int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens.Add(1)
+		// refreshed a second before expiry
+		fmt.Fprint(w, `{"access_token":"token","token_type":"bearer","expires_in":31}`)
+	}))
+	defer tokenServer.Close()
+
+	clients := profiles.NewClients(map[string]*profiles.Profile{
+		"de": {Name: "de", Environment: "https://proxy.example.com", TokenUrl: tokenServer.URL + "/token", ClientId: "id", ClientSecret: "secret"},
+	})
+	if _, err := clients.Session("de"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	clients.Close()
+	time.Sleep(1500 * time.Millisecond)
+	if tokens.Load() != 1 {
+		t.Errorf("Expected no token refresh after Close, got %d token requests", tokens.Load())
+	}
+}
+
+func TestProfile_TokenUrl(t *testing.T) {
+	tokens := 0
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens++
+		fmt.Fprint(w, `{"access_token":"token","token_type":"bearer","expires_in":3600}`)
+	}))
+	defer tokenServer.Close()
+
+	profile := &profiles.Profile{Name: "proxy", Environment: "https://proxy.example.com", TokenUrl: tokenServer.URL + "/token", ClientId: "id", ClientSecret: "secret", ApiVersion: versions.V2023_08_01}
+	sess, err := profile.Session(nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tokens != 1 || sess.Authentication.Environment != "https://proxy.example.com" || sess.Authentication.TokenUrl() != tokenServer.URL+"/token" {
+		t.Errorf("Expected a token from the explicit token URL, got %d tokens for %+v", tokens, sess.Authentication)
+	}
+	if sess.Version() != versions.V2023_08_01 {
+		t.Errorf("Expected the pinned version %s, got %s", versions.V2023_08_01, sess.Version())
+	}
+}
+
+func TestProfile_RateLimit(t *testing.T) {
+	server := enodetest.NewServer()
+	defer server.Close()
+	server.AddVehicle("user_1", models.VehicleWithLocation{})
+
+	all, err := profiles.Parse([]byte(fmt.Sprintf(`{"profiles": {
+		"limited": {"environment": %q, "clientId": %q, "clientSecret": %q, "rateLimit": "1/1h"}
+	}}`, server.URL, enodetest.CLIENT_ID, enodetest.CLIENT_SECRET)))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sess, err := all["limited"].Session(nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := vehicles.ListVehicles(context.Background(), sess, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// the quota of one request per hour is used up
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := vehicles.ListVehicles(ctx, sess, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the second request to wait for the quota, got %v", err)
+	}
+
+	if _, err := profiles.Parse([]byte(`{"profiles": {"x": {"clientId": "id", "clientSecret": "secret", "rateLimit": "300"}}}`)); err == nil {
+		t.Error("Expected an error for an invalid rate limit")
+	}
+	profile, err := profiles.FromEnv("", func(key string) string {
+		return map[string]string{"ENODE_CLIENT_ID": "id", "ENODE_CLIENT_SECRET": "secret", "ENODE_RATE_LIMIT": "300/1m,10"}[key]
+	})
+	if err != nil || profile.RateLimit != "300/1m,10" {
+		t.Errorf("Expected the rate limit of the environment, got %v, %v", profile, err)
+	}
+}
@@ -0,0 +1,272 @@
+// Package ratelimit provides a client side token bucket limiter, keeping the
+// requests of a client within its quota.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	RATELIMIT_INVALID_LIMIT_ERROR string = "ratelimit: a limit needs a positive number of requests per positive period"
+	RATELIMIT_PARSE_ERROR         string = "ratelimit: unable to parse limit, expected <requests>/<period>[,<burst>]"
+)
+
+// Limit describes a quota of Requests per time period, allowing bursts of Burst requests.
+// The quota of a client is agreed with Enode, there is no default.
+type Limit struct {
+	Requests int
+	Per      time.Duration
+	// Burst is the number of requests sent without waiting, 1 if not positive.
+	Burst int
+}
+
+// Validate returns an error with RATELIMIT_INVALID_LIMIT_ERROR unless the limit admits requests.
+func (limit Limit) Validate() error {
+	if limit.Requests <= 0 || limit.Per <= 0 {
+		return errors.Join(errors.New(RATELIMIT_INVALID_LIMIT_ERROR), fmt.Errorf("%d requests per %s", limit.Requests, limit.Per))
+	}
+	return nil
+}
+
+/*
+Parses a limit like 300/1m or 300/1m,10, e.g. from a flag or environment variable.
+
+Parameters:
+  - value: The number of requests and the period, optionally followed by the burst.
+
+Returns:
+  - The limit.
+  - An error with RATELIMIT_PARSE_ERROR or RATELIMIT_INVALID_LIMIT_ERROR if the value is not a valid limit.
+*/
+func ParseLimit(value string) (Limit, error) {
+	var limit Limit
+	quota, burst, hasBurst := strings.Cut(strings.TrimSpace(value), ",")
+	requests, per, ok := strings.Cut(quota, "/")
+	if !ok {
+		return limit, errors.Join(errors.New(RATELIMIT_PARSE_ERROR), fmt.Errorf("%q", value))
+	}
+	var err error
+	if limit.Requests, err = strconv.Atoi(requests); err != nil {
+		return limit, errors.Join(errors.New(RATELIMIT_PARSE_ERROR), err)
+	}
+	if limit.Per, err = time.ParseDuration(per); err != nil {
+		return limit, errors.Join(errors.New(RATELIMIT_PARSE_ERROR), err)
+	}
+	if hasBurst {
+		if limit.Burst, err = strconv.Atoi(burst); err != nil {
+			return limit, errors.Join(errors.New(RATELIMIT_PARSE_ERROR), err)
+		}
+	}
+	return limit, limit.Validate()
+}
+
+// Stats summarizes the time requests spent waiting for the limiter.
+type Stats struct {
+	Requests int64         // requests admitted by the limiter
+	Waits    int64         // requests that had to wait
+	Waited   time.Duration // total time spent waiting
+}
+
+type Limiter struct {
+	// OnWait is called with the time a request waited, e.g. to record metrics.
+	OnWait func(wait time.Duration)
+
+	mu          sync.Mutex
+	rate        float64 // tokens per second
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	stats       Stats
+}
+
+// NewLimiter returns a limiter with a full bucket, or an error with
+// RATELIMIT_INVALID_LIMIT_ERROR if the limit admits no requests.
+func NewLimiter(limit Limit) (*Limiter, error) {
+	if err := limit.Validate(); err != nil {
+		return nil, err
+	}
+	burst := float64(max(limit.Burst, 1))
+	return &Limiter{
+		rate:   float64(limit.Requests) / limit.Per.Seconds(),
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}, nil
+}
+
+// advance refills the bucket for the time passed since the last call.
+func (l *Limiter) advance(now time.Time) {
+	if elapsed := now.Sub(l.last).Seconds(); elapsed > 0 {
+		l.tokens = min(l.burst, l.tokens+elapsed*l.rate)
+	}
+	l.last = now
+}
+
+/*
+Blocks until a request may be sent.
+
+Parameters:
+  - ctx: The context of the request. Waiting stops when it is done.
+
+Returns:
+  - An error if the context was done before the request could be admitted. If the request may be sent, it returns nil.
+*/
+func (l *Limiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.advance(now)
+	l.tokens--
+
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	if paused := l.pausedUntil.Sub(now); paused > wait {
+		wait = paused
+	}
+	l.mu.Unlock()
+
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			l.mu.Lock()
+			l.tokens++
+			l.mu.Unlock()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	l.mu.Lock()
+	l.stats.Requests++
+	if wait > 0 {
+		l.stats.Waits++
+		l.stats.Waited += wait
+	}
+	l.mu.Unlock()
+
+	if wait > 0 && l.OnWait != nil {
+		l.OnWait(wait)
+	}
+	return nil
+}
+
+// Stats returns the waiting statistics of the limiter.
+func (l *Limiter) Stats() Stats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stats
+}
+
+// Update adapts the limiter to the rate limit headers of a response. The
+// remaining quota caps the available tokens, an exhausted quota or a 429
+// response pauses all requests until the quota resets.
+//
+// X-RateLimit-Reset and RateLimit-Reset hold the seconds until the quota
+// resets, as in the RateLimit header fields of the IETF httpapi working group.
+// Retry-After holds seconds or an HTTP date, see RFC 9110.
+func (l *Limiter) Update(resp *http.Response) {
+	remaining, hasRemaining := seconds(resp, "X-RateLimit-Remaining", "RateLimit-Remaining")
+	reset, hasReset := seconds(resp, "X-RateLimit-Reset", "RateLimit-Reset")
+	retryAfter, hasRetryAfter := retryAfter(resp)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	l.advance(now)
+
+	if hasRemaining {
+		l.tokens = min(l.tokens, float64(remaining))
+		if remaining == 0 && hasReset {
+			l.pause(now, time.Duration(reset)*time.Second)
+		}
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		switch {
+		case hasRetryAfter:
+			l.pause(now, retryAfter)
+		case hasReset:
+			l.pause(now, time.Duration(reset)*time.Second)
+		}
+	}
+}
+
+func (l *Limiter) pause(now time.Time, d time.Duration) {
+	if until := now.Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// seconds returns the first of the headers holding a non-negative integer.
+func seconds(resp *http.Response, names ...string) (int64, bool) {
+	for _, name := range names {
+		if value := resp.Header.Get(name); value != "" {
+			if parsed, err := strconv.ParseInt(value, 10, 64); err == nil && parsed >= 0 {
+				return parsed, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// retryAfter returns the delay of a Retry-After header in seconds or as HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if delay, ok := seconds(resp, "Retry-After"); ok {
+		return time.Duration(delay) * time.Second, true
+	}
+	if date, err := http.ParseTime(resp.Header.Get("Retry-After")); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// Transport limits the requests sent through it, to be used as transport of a session's HttpClient.
+type Transport struct {
+	// Base sends the requests, http.DefaultTransport if nil.
+	Base    http.RoundTripper
+	Limiter *Limiter
+}
+
+/*
+Creates a Transport keeping the requests within the quota of the client.
+
+Parameters:
+  - base: The transport sending the requests, http.DefaultTransport if nil.
+  - limit: The quota of the client.
+
+Returns:
+  - The transport.
+  - An error with RATELIMIT_INVALID_LIMIT_ERROR if the limit admits no requests.
+*/
+func NewTransport(base http.RoundTripper, limit Limit) (*Transport, error) {
+	limiter, err := NewLimiter(limit)
+	if err != nil {
+		return nil, err
+	}
+	return &Transport{Base: base, Limiter: limiter}, nil
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if err := t.Limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	resp, err := base.RoundTrip(req)
+	if err == nil {
+		t.Limiter.Update(resp)
+	}
+	return resp, err
+}
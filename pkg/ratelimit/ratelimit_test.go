@@ -0,0 +1,141 @@
+package ratelimit_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/ratelimit"
+)
+
+func TestWait_Burst(t *testing.T) {
+	limiter, err := ratelimit.NewLimiter(ratelimit.Limit{Requests: 20, Per: time.Second, Burst: 2})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	// two requests fit into the burst, the other two wait 50ms each
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected requests to be throttled, but took %v", elapsed)
+	}
+
+	stats := limiter.Stats()
+	if stats.Requests != 4 || stats.Waits != 2 || stats.Waited <= 0 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+}
+
+func TestWait_ContextCancelled(t *testing.T) {
+	limiter, err := ratelimit.NewLimiter(ratelimit.Limit{Requests: 1, Per: time.Hour, Burst: 1})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	limiter.Wait(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected %v, but got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestTransport_AdaptsToHeaders(t *testing.T) {
+	// Create a test server that reports an exhausted quota on the first request
+	first := true
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if first {
+			first = false
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", "1")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	transport, err := ratelimit.NewTransport(nil, ratelimit.Limit{Requests: 300, Per: time.Minute, Burst: 10})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var waited time.Duration
+	transport.Limiter.OnWait = func(wait time.Duration) { waited += wait }
+	client := &http.Client{Transport: transport}
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		if _, err := client.Get(ts.URL); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond || waited < 900*time.Millisecond {
+		t.Errorf("Expected to wait for the quota to reset, but took %v (waited %v)", elapsed, waited)
+	}
+}
+
+func TestTransport_RetryAfterDate(t *testing.T) {
+	// Create a test server that rejects the first request until a date
+	first := true
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if first {
+			first = false
+			w.Header().Set("Retry-After", time.Now().Add(2*time.Second).UTC().Format(http.TimeFormat))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	transport, err := ratelimit.NewTransport(nil, ratelimit.Limit{Requests: 300, Per: time.Minute, Burst: 10})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var waited time.Duration
+	transport.Limiter.OnWait = func(wait time.Duration) { waited += wait }
+	client := &http.Client{Transport: transport}
+	for i := 0; i < 2; i++ {
+		if _, err := client.Get(ts.URL); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	// the date has a resolution of seconds
+	if waited < 900*time.Millisecond {
+		t.Errorf("Expected to wait until the date of Retry-After, waited %v", waited)
+	}
+}
+
+func TestNewLimiter_Invalid(t *testing.T) {
+	for _, limit := range []ratelimit.Limit{{}, {Requests: 0, Per: time.Minute}, {Requests: 10}, {Requests: -1, Per: time.Second}} {
+		if _, err := ratelimit.NewLimiter(limit); err == nil || !strings.Contains(err.Error(), ratelimit.RATELIMIT_INVALID_LIMIT_ERROR) {
+			t.Errorf("Expected an invalid limit error for %+v, got %v", limit, err)
+		}
+	}
+	if _, err := ratelimit.NewTransport(nil, ratelimit.Limit{}); err == nil {
+		t.Error("Expected an error for a transport without limit")
+	}
+}
+
+func TestParseLimit(t *testing.T) {
+	cases := map[string]ratelimit.Limit{
+		"300/1m":    {Requests: 300, Per: time.Minute},
+		" 10/1s,5 ": {Requests: 10, Per: time.Second, Burst: 5},
+	}
+	for value, expected := range cases {
+		if limit, err := ratelimit.ParseLimit(value); err != nil || limit != expected {
+			t.Errorf("ParseLimit(%q): expected %+v, but got %+v, %v", value, expected, limit, err)
+		}
+	}
+	for _, value := range []string{"", "300", "x/1m", "300/minute", "300/1m,x", "0/1m"} {
+		if _, err := ratelimit.ParseLimit(value); err == nil {
+			t.Errorf("ParseLimit(%q): expected an error", value)
+		}
+	}
+}
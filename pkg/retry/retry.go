@@ -0,0 +1,174 @@
+// Package retry provides an http.RoundTripper retrying failed requests with
+// exponential backoff, to be used as transport of a session's HttpClient.
+package retry
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+const (
+	DEFAULT_MAX_ATTEMPTS = 4
+	DEFAULT_MIN_BACKOFF  = 500 * time.Millisecond
+	DEFAULT_MAX_BACKOFF  = 30 * time.Second
+)
+
+type Transport struct {
+	// Base sends the requests, http.DefaultTransport if nil.
+	Base http.RoundTripper
+	// MaxAttempts is the number of attempts per request, including the first one.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry, doubled on every further retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts, including delays requested by Retry-After.
+	MaxBackoff time.Duration
+	// RetryPost decides whether a POST request may be sent again.
+	// POST requests are never retried if nil.
+	RetryPost func(req *http.Request) bool
+	// OnRetry is called before a request is sent again, e.g. to count retries.
+	OnRetry func(req *http.Request, attempt int, resp *http.Response, err error)
+}
+
+// NewTransport returns a Transport with the default limits, wrapping base.
+func NewTransport(base http.RoundTripper) *Transport {
+	return &Transport{
+		Base:        base,
+		MaxAttempts: DEFAULT_MAX_ATTEMPTS,
+		MinBackoff:  DEFAULT_MIN_BACKOFF,
+		MaxBackoff:  DEFAULT_MAX_BACKOFF,
+	}
+}
+
+// actionPath matches the endpoints creating actions. Enode reuses a pending
+// action for the same target and kind instead of creating a second one.
+// It matches the end of the path, which follows the base path of environments
+// with a path prefix.
+var actionPath = regexp.MustCompile(`/(vehicles|chargers|hvacs|batteries)/[^/]+/(charging|max-current|permanent-hold|follow-schedule|operation-mode)$`)
+
+// ActionPosts reports whether req creates a device action, which is safe to
+// send again. It can be used as RetryPost.
+func ActionPosts(req *http.Request) bool {
+	return actionPath.MatchString(req.URL.Path)
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if !t.retryable(req) {
+		return base.RoundTrip(req)
+	}
+
+	maxAttempts := t.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DEFAULT_MAX_ATTEMPTS
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := base.RoundTrip(req)
+		if attempt >= maxAttempts || !shouldRetry(resp, err) || req.Context().Err() != nil {
+			return resp, err
+		}
+
+		delay := t.backoff(attempt)
+		if resp != nil {
+			if after, ok := retryAfter(resp); ok {
+				delay = min(after, t.maxBackoff())
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		next := req.Clone(req.Context())
+		if req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, errors.Join(errors.New("retry: unable to rewind request body"), err)
+			}
+			next.Body = body
+		}
+
+		if t.OnRetry != nil {
+			t.OnRetry(req, attempt, resp, err)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		req = next
+	}
+}
+
+// retryable reports whether the request may be sent more than once.
+func (t *Transport) retryable(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	case http.MethodPost:
+		return t.RetryPost != nil && t.RetryPost(req)
+	}
+	return false
+}
+
+// shouldRetry reports whether the failure is transient. Other server errors,
+// e.g. 501 Not Implemented, fail the same way on every attempt.
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry, with jitter over the
+// upper half of the exponential delay.
+func (t *Transport) backoff(attempt int) time.Duration {
+	delay := t.MinBackoff
+	if delay <= 0 {
+		delay = DEFAULT_MIN_BACKOFF
+	}
+	for i := 1; i < attempt && delay < t.maxBackoff(); i++ {
+		delay *= 2
+	}
+	delay = min(delay, t.maxBackoff())
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+func (t *Transport) maxBackoff() time.Duration {
+	if t.MaxBackoff <= 0 {
+		return DEFAULT_MAX_BACKOFF
+	}
+	return t.MaxBackoff
+}
+
+// retryAfter parses the Retry-After header, given in seconds or as HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,178 @@
+package retry_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/retry"
+)
+
+func newTransport() *retry.Transport {
+	transport := retry.NewTransport(nil)
+	transport.MinBackoff = time.Millisecond
+	transport.MaxBackoff = 5 * time.Millisecond
+	return transport
+}
+
+func TestRoundTrip_RetriesBadGateway(t *testing.T) {
+	// Create a test server that fails twice before succeeding
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: newTransport()}
+	resp, err := client.Get(ts.URL + "/users")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || calls.Load() != 3 {
+		t.Errorf("Expected success after 3 calls, but got %s after %d calls", resp.Status, calls.Load())
+	}
+}
+
+func TestRoundTrip_MaxAttempts(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	transport := newTransport()
+	transport.MaxAttempts = 2
+	var retries int
+	transport.OnRetry = func(req *http.Request, attempt int, resp *http.Response, err error) { retries++ }
+
+	resp, err := (&http.Client{Transport: transport}).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable || calls.Load() != 2 || retries != 1 {
+		t.Errorf("Expected 2 calls and 1 retry, but got %d calls and %d retries", calls.Load(), retries)
+	}
+}
+
+func TestRoundTrip_Post(t *testing.T) {
+	var calls atomic.Int32
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	// POST requests are not retried by default
+	client := &http.Client{Transport: newTransport()}
+	resp, _ := client.Post(ts.URL+"/users/test_user_id/link", "application/json", strings.NewReader(`{}`))
+	if resp.StatusCode != http.StatusTooManyRequests || calls.Load() != 1 {
+		t.Fatalf("Expected POST not to be retried, but got %s after %d calls", resp.Status, calls.Load())
+	}
+
+	// Actions are safe to retry with ActionPosts
+	calls.Store(0)
+	bodies = nil
+	transport := newTransport()
+	transport.RetryPost = retry.ActionPosts
+	client = &http.Client{Transport: transport}
+	resp, _ = client.Post(ts.URL+"/vehicles/test_vehicle_id/charging", "application/json", strings.NewReader(`{"action":"START"}`))
+	if resp.StatusCode != http.StatusOK || calls.Load() != 2 {
+		t.Fatalf("Expected action to be retried, but got %s after %d calls", resp.Status, calls.Load())
+	}
+	if bodies[1] != `{"action":"START"}` {
+		t.Errorf("Expected the body to be sent again, but got %q", bodies[1])
+	}
+}
+
+func TestRoundTrip_NotImplemented(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotImplemented)
+	}))
+	defer ts.Close()
+
+	resp, err := (&http.Client{Transport: newTransport()}).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusNotImplemented || calls.Load() != 1 {
+		t.Errorf("Expected 501 not to be retried, but got %s after %d calls", resp.Status, calls.Load())
+	}
+}
+
+func TestActionPosts(t *testing.T) {
+	tests := []struct {
+		url    string
+		action bool
+	}{
+		{"https://enode-api.sandbox.enode.io/vehicles/test_vehicle_id/charging", true},
+		{"https://gateway.example.com/enode/v1/chargers/test_charger_id/max-current", true},
+		{"https://gateway.example.com/enode/v1/hvacs/test_hvac_id/permanent-hold", true},
+		{"https://enode-api.sandbox.enode.io/users/test_user_id/link", false},
+		{"https://gateway.example.com/enode/v1/vehicles/test_vehicle_id/charging/extra", false},
+	}
+	for _, test := range tests {
+		req, _ := http.NewRequest(http.MethodPost, test.url, nil)
+		if got := retry.ActionPosts(req); got != test.action {
+			t.Errorf("ActionPosts(%s) = %v, expected %v", test.url, got, test.action)
+		}
+	}
+}
+
+func TestRoundTrip_RetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	transport := newTransport()
+	transport.MaxBackoff = 2 * time.Second
+	start := time.Now()
+	if _, err := (&http.Client{Transport: transport}).Get(ts.URL); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Expected to wait for Retry-After, but retried after %v", elapsed)
+	}
+}
+
+func TestRoundTrip_ContextCancelled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	transport := newTransport()
+	transport.MaxBackoff = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+	if _, err := (&http.Client{Transport: transport}).Do(req); err == nil {
+		t.Error("Expected error, but got nil")
+	}
+}
@@ -0,0 +1,36 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package schedules
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Create Schedule
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - userId: The userId of the request.
+  - payload: The request body sent to the API.
+
+Returns:
+  - A pointer to the models.ScheduleResponse object returned by the API.
+  - An error if any occurred during the request.
+*/
+func CreateSchedule(ctx context.Context, sess *session.Session, userId string, payload *models.Schedule) (*models.ScheduleResponse, error) {
+	path := fmt.Sprintf("/users/%s/schedules", url.PathEscape(userId))
+
+	var result models.ScheduleResponse
+	if err := do(ctx, sess, http.MethodPost, path, nil, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
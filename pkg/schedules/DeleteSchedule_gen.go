@@ -0,0 +1,29 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package schedules
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Delete a Schedule
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - scheduleId: The scheduleId of the request.
+
+Returns:
+  - An error if any occurred during the request. If the request is successful, it returns nil.
+*/
+func DeleteSchedule(ctx context.Context, sess *session.Session, scheduleId string) error {
+	path := fmt.Sprintf("/schedules/%s", url.PathEscape(scheduleId))
+
+	return do(ctx, sess, http.MethodDelete, path, nil, nil, nil)
+}
@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package schedules
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Get Schedule
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - scheduleId: The scheduleId of the request.
+
+Returns:
+  - A pointer to the models.ScheduleResponse object returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetSchedule(ctx context.Context, sess *session.Session, scheduleId string) (*models.ScheduleResponse, error) {
+	path := fmt.Sprintf("/schedules/%s", url.PathEscape(scheduleId))
+
+	var result models.ScheduleResponse
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,82 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+// Package schedules wraps the endpoints of the Enode API for the charge and temperature schedules.
+package schedules
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/internal/rest"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+const (
+	REST_SCHEDULE_TRANSFER_ERROR      string = "schedules: could not transfer request"
+	REST_SCHEDULE_READ_ERROR          string = "schedules: could not read response body"
+	REST_SCHEDULE_PARSE_ERROR         string = "schedules: unable to parse response data"
+	REST_SCHEDULE_PAYLOAD_ERROR       string = "schedules: unable to create request payload"
+	REST_SCHEDULE_UNAUTHORIZED_ERROR  string = "schedules: unauthorized access"
+	REST_SCHEDULE_FORBIDDEN_ERROR     string = "schedules: access to the resource is forbidden"
+	REST_SCHEDULE_NOT_FOUND_ERROR     string = "schedules: requested resource not found"
+	REST_SCHEDULE_VALIDATION_ERROR    string = "schedules: invalid request payload input"
+	REST_SCHEDULE_CONFLICT_ERROR      string = "schedules: request conflicts with the current state of the resource"
+	REST_SCHEDULE_UNPROCESSABLE_ERROR string = "schedules: request could not be processed for the target"
+	REST_SCHEDULE_RATE_LIMIT_ERROR    string = "schedules: too many requests"
+	REST_SCHEDULE_GENERAL_ERROR       string = "schedules: some kind of error occurred"
+)
+
+// do executes a request and decodes a successful response into result, if set.
+func do(ctx context.Context, sess *session.Session, method, path string, query url.Values, payload, result any) error {
+	req, err := rest.NewRequest(ctx, sess, method, path, query, payload)
+	if err != nil {
+		return errors.Join(errors.New(REST_SCHEDULE_PAYLOAD_ERROR), err)
+	}
+
+	resp, err := sess.Do(req)
+	if err != nil {
+		return errors.Join(errors.New(REST_SCHEDULE_TRANSFER_ERROR), err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Join(errors.New(REST_SCHEDULE_READ_ERROR), err)
+	}
+
+	switch resp.StatusCode {
+	default:
+		return errors.Join(errors.New(REST_SCHEDULE_GENERAL_ERROR), rest.Problem(resp, body))
+	case http.StatusBadRequest:
+		return errors.Join(errors.New(REST_SCHEDULE_VALIDATION_ERROR), rest.Problem(resp, body))
+	case http.StatusUnauthorized:
+		return errors.Join(errors.New(REST_SCHEDULE_UNAUTHORIZED_ERROR), rest.Problem(resp, body))
+	case http.StatusForbidden:
+		return errors.Join(errors.New(REST_SCHEDULE_FORBIDDEN_ERROR), rest.Problem(resp, body))
+	case http.StatusNotFound:
+		return errors.Join(errors.New(REST_SCHEDULE_NOT_FOUND_ERROR), rest.Problem(resp, body))
+	case http.StatusConflict:
+		return errors.Join(errors.New(REST_SCHEDULE_CONFLICT_ERROR), rest.Problem(resp, body))
+	case http.StatusUnprocessableEntity:
+		return errors.Join(errors.New(REST_SCHEDULE_UNPROCESSABLE_ERROR), rest.Problem(resp, body))
+	case http.StatusTooManyRequests:
+		return errors.Join(errors.New(REST_SCHEDULE_RATE_LIMIT_ERROR), rest.Problem(resp, body))
+	case http.StatusBadGateway:
+		return errors.Join(errors.New(REST_SCHEDULE_TRANSFER_ERROR), rest.Problem(resp, body))
+	case http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent:
+		if result == nil {
+			return nil
+		}
+		if len(body) == 0 {
+			return errors.Join(errors.New(REST_SCHEDULE_READ_ERROR), io.EOF)
+		}
+		if err := json.Unmarshal(body, result); err != nil {
+			return errors.Join(errors.New(REST_SCHEDULE_PARSE_ERROR), err)
+		}
+		return nil
+	}
+}
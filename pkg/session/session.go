@@ -1,11 +1,136 @@
 package session
 
-import "github.com/addihorn/enode-gosdk/pkg/auth"
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/enums/versions"
+)
+
+// VERSION_HEADER pins the API version of a request and reports the version of a response.
+const VERSION_HEADER = "Enode-Version"
 
 type Session struct {
 	Authentication *auth.Authentication
+	// HttpClient sends the requests of the session, http.DefaultClient if nil.
+	HttpClient *http.Client
+	// ApiVersion is sent as Enode-Version with every request, versions.DEFAULT if empty.
+	ApiVersion string
+	// OnVersionMismatch is called when the API responds with a version other
+	// than the pinned one. A warning is logged once per version if nil.
+	OnVersionMismatch func(pinned, received string)
+
+	mu              sync.Mutex
+	responseVersion string
+	warned          map[string]bool
 }
 
 func NewSession(authSession *auth.Authentication) *Session {
 	return &Session{Authentication: authSession}
 }
+
+// Version returns the API version pinned by the session.
+func (sess *Session) Version() string {
+	if sess.ApiVersion == "" {
+		return versions.DEFAULT
+	}
+	return sess.ApiVersion
+}
+
+// ResponseVersion returns the API version of the last response that reported
+// one, or an empty string if no such response was received yet.
+func (sess *Session) ResponseVersion() string {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	return sess.responseVersion
+}
+
+/*
+Sends an HTTP request on behalf of the session, authorizing it with the current access token
+and pinning the API version of the session. A request rejected with 401 is sent once more
+with a new token, if the authentication can request one.
+
+Parameters:
+  - req: The request to send. Its Authorization and Enode-Version headers are set by the session.
+
+Returns:
+  - The response of the API.
+  - An error if the request could not be sent.
+*/
+func (sess *Session) Do(req *http.Request) (*http.Response, error) {
+	token := sess.Authentication.Token()
+	resp, err := sess.send(req, token)
+	if err != nil {
+		return nil, err
+	}
+
+	replayable := req.Body == nil || req.GetBody != nil
+	if resp.StatusCode == http.StatusUnauthorized && sess.Authentication.Refreshable() && replayable {
+		// the context of the request sent by the transport may carry its trace span
+		ctx := req.Context()
+		if resp.Request != nil {
+			ctx = resp.Request.Context()
+		}
+		if err := sess.Authentication.Refresh(ctx, token); err != nil {
+			// the rejected response explains the failure better than the token error
+			return resp, nil
+		}
+		retry := req.Clone(req.Context())
+		if req.GetBody != nil {
+			if retry.Body, err = req.GetBody(); err != nil {
+				return resp, nil
+			}
+		}
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		return sess.send(retry, sess.Authentication.Token())
+	}
+	return resp, nil
+}
+
+func (sess *Session) send(req *http.Request, token string) (*http.Response, error) {
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set(VERSION_HEADER, sess.Version())
+
+	client := sess.HttpClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if received := resp.Header.Get(VERSION_HEADER); received != "" {
+		sess.recordVersion(received)
+	}
+	return resp, nil
+}
+
+func (sess *Session) recordVersion(received string) {
+	pinned := sess.Version()
+
+	sess.mu.Lock()
+	sess.responseVersion = received
+	notify := received != pinned && (sess.OnVersionMismatch != nil || !sess.warned[received])
+	if notify && sess.OnVersionMismatch == nil {
+		if sess.warned == nil {
+			sess.warned = make(map[string]bool)
+		}
+		sess.warned[received] = true
+	}
+	sess.mu.Unlock()
+
+	if !notify {
+		return
+	}
+	if sess.OnVersionMismatch != nil {
+		sess.OnVersionMismatch(pinned, received)
+		return
+	}
+	log.Printf("session: API responded with version %s, but version %s is pinned", received, pinned)
+}
@@ -0,0 +1,64 @@
+package session_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/enums/versions"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+func TestDo_PinsVersion(t *testing.T) {
+	// Create a test server that echoes the requested version
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(session.VERSION_HEADER, r.Header.Get(session.VERSION_HEADER))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	sess := session.NewSession(&auth.Authentication{Environment: ts.URL, Access_token: "test_token"})
+	sess.OnVersionMismatch = func(pinned, received string) {
+		t.Errorf("Unexpected version mismatch: pinned %s, received %s", pinned, received)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, ts.URL, nil)
+	if _, err := sess.Do(req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if req.Header.Get(session.VERSION_HEADER) != versions.DEFAULT {
+		t.Errorf("Expected version header %s, but got %s", versions.DEFAULT, req.Header.Get(session.VERSION_HEADER))
+	}
+	if sess.ResponseVersion() != versions.DEFAULT {
+		t.Errorf("Expected response version %s, but got %s", versions.DEFAULT, sess.ResponseVersion())
+	}
+}
+
+func TestDo_VersionMismatch(t *testing.T) {
+	// Create a test server that always responds with an older version
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(session.VERSION_HEADER, versions.V2023_08_01)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	sess := session.NewSession(&auth.Authentication{Environment: ts.URL, Access_token: "test_token"})
+	sess.ApiVersion = versions.V2024_01_01
+
+	var mismatches []string
+	sess.OnVersionMismatch = func(pinned, received string) {
+		mismatches = append(mismatches, pinned+"->"+received)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, ts.URL, nil)
+	if _, err := sess.Do(req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mismatches) != 1 || mismatches[0] != "2024-01-01->2023-08-01" {
+		t.Errorf("Expected one mismatch, but got %v", mismatches)
+	}
+	if sess.ResponseVersion() != versions.V2023_08_01 {
+		t.Errorf("Expected response version %s, but got %s", versions.V2023_08_01, sess.ResponseVersion())
+	}
+}
@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package simulated
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Create Simulated Vehicle
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - payload: The request body sent to the API.
+
+Returns:
+  - A pointer to the models.SimulatedVehicle object returned by the API.
+  - An error if any occurred during the request.
+
+Deprecated: CreateSimulatedVehicle is deprecated by the Enode API.
+*/
+func CreateSimulatedVehicle(ctx context.Context, sess *session.Session, payload *models.CreateSimulatedVehiclePayload) (*models.SimulatedVehicle, error) {
+	path := "/simulated/vehicles"
+
+	var result models.SimulatedVehicle
+	if err := do(ctx, sess, http.MethodPost, path, nil, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,31 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package simulated
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Delete Simulated Vehicle
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - simulatedVehicleId: The simulatedVehicleId of the request.
+
+Returns:
+  - An error if any occurred during the request. If the request is successful, it returns nil.
+
+Deprecated: DeleteSimulatedVehicle is deprecated by the Enode API.
+*/
+func DeleteSimulatedVehicle(ctx context.Context, sess *session.Session, simulatedVehicleId string) error {
+	path := fmt.Sprintf("/simulated/vehicles/%s", url.PathEscape(simulatedVehicleId))
+
+	return do(ctx, sess, http.MethodDelete, path, nil, nil, nil)
+}
@@ -0,0 +1,37 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package simulated
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Get Simulated Vehicle
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - simulatedVehicleId: The simulatedVehicleId of the request.
+
+Returns:
+  - A pointer to the models.SimulatedVehicle object returned by the API.
+  - An error if any occurred during the request.
+
+Deprecated: GetSimulatedVehicle is deprecated by the Enode API.
+*/
+func GetSimulatedVehicle(ctx context.Context, sess *session.Session, simulatedVehicleId string) (*models.SimulatedVehicle, error) {
+	path := fmt.Sprintf("/simulated/vehicles/%s", url.PathEscape(simulatedVehicleId))
+
+	var result models.SimulatedVehicle
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
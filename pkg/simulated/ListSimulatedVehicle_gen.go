@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package simulated
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+List Simulated Vehicle
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - params: The optional query parameters of the request, may be nil.
+
+Returns:
+  - A pointer to the models.PaginatedVehiclesList object returned by the API.
+  - An error if any occurred during the request.
+
+Deprecated: ListSimulatedVehicle is deprecated by the Enode API.
+*/
+func ListSimulatedVehicle(ctx context.Context, sess *session.Session, params *models.PaginationParams) (*models.PaginatedVehiclesList, error) {
+	path := "/simulated/vehicles"
+
+	var result models.PaginatedVehiclesList
+	if err := do(ctx, sess, http.MethodGet, path, params.Values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
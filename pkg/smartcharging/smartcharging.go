@@ -0,0 +1,200 @@
+// Package smartcharging explains the smart charging state of a vehicle to its
+// user, e.g. why the vehicle is not charging right now:
+//
+//	status, err := vehicles.GetVehicleSmartChargingStatus(ctx, sess, vehicleId)
+//	fmt.Println(smartcharging.Explain(*status, location))
+package smartcharging
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+)
+
+const (
+	SMART_CHARGE_STATE_ERROR      string = "smartcharging: unknown smart charge state"
+	SMART_CHARGE_TRANSITION_ERROR string = "smartcharging: invalid smart charge state transition"
+)
+
+// TIME_LAYOUT formats the times of an explanation.
+const TIME_LAYOUT = "15:04"
+
+/*
+Validates a transition of the smart charge state, e.g. between two status updates.
+
+Parameters:
+  - from: The previous state.
+  - to: The new state.
+
+Returns:
+  - An error if a state is unknown or the state machine does not move from one to the other.
+*/
+func ValidateTransition(from, to models.SmartChargeState) error {
+	for _, state := range []models.SmartChargeState{from, to} {
+		if !state.IsKnown() {
+			return errors.Join(errors.New(SMART_CHARGE_STATE_ERROR), fmt.Errorf("got %q", state))
+		}
+	}
+	if !from.CanTransitionTo(to) {
+		return errors.Join(errors.New(SMART_CHARGE_TRANSITION_ERROR), fmt.Errorf("%s to %s", from, to))
+	}
+	return nil
+}
+
+// Explanation describes a smart charge state in plain words.
+type Explanation struct {
+	State models.SmartChargeState
+	// Summary is one sentence describing the state.
+	Summary string
+	// Reasons list why the vehicle is not charging smart, if known.
+	Reasons []string
+	// Currency, EstimatedCost and EstimatedSavings are set for plans only.
+	Currency         models.CurrencyCode
+	EstimatedCost    *models.MonetaryAmount
+	EstimatedSavings *models.MonetaryAmount
+}
+
+// String joins the summary, reasons and costs to a paragraph.
+func (e Explanation) String() string {
+	parts := []string{e.Summary}
+	parts = append(parts, e.Reasons...)
+	if e.EstimatedCost != nil {
+		cost := fmt.Sprintf("Estimated cost: %.2f %s.", float64(*e.EstimatedCost), e.Currency)
+		if e.EstimatedSavings != nil && *e.EstimatedSavings > 0 {
+			cost = fmt.Sprintf("Estimated cost: %.2f %s, saving %.2f %s.", float64(*e.EstimatedCost), e.Currency, float64(*e.EstimatedSavings), e.Currency)
+		}
+		parts = append(parts, cost)
+	}
+	return strings.Join(parts, " ")
+}
+
+// summaries describe the states not depending on a plan.
+var summaries = map[models.SmartChargeState]string{
+	models.SMART_CHARGE_STATE_DISABLED:      "Smart charging is disabled, the vehicle charges whenever it is plugged in.",
+	models.SMART_CHARGE_STATE_CONSIDERING:   "Smart charging is waiting for the conditions to plan charging.",
+	models.SMART_CHARGE_STATE_UNKNOWN:       "The smart charging state is unknown. The vehicle may have lost a capability, or prices are missing for its location.",
+	models.SMART_CHARGE_STATE_FULLY_CHARGED: "The vehicle is fully charged.",
+
+	models.SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPING:                "Charging is being paused to wait for lower prices.",
+	models.SMART_CHARGE_STATE_PLAN_EXECUTING_STOP_FAILED:             "Charging could not be paused, the vehicle did not respond.",
+	models.SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED:                 "Charging is paused to wait for lower prices.",
+	models.SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED_AWAITING_PRICES: "Charging is paused until the prices of the coming hours are published.",
+	models.SMART_CHARGE_STATE_PLAN_EXECUTING_STARTING:                "Charging is being started.",
+	models.SMART_CHARGE_STATE_PLAN_EXECUTING_START_FAILED:            "Charging could not be started, the vehicle did not respond.",
+	models.SMART_CHARGE_STATE_PLAN_EXECUTING_STARTED:                 "The vehicle is charging at the planned time.",
+	models.SMART_CHARGE_STATE_PLAN_EXECUTING_CHARGE_INTERRUPTED:      "Charging was interrupted by the vehicle or the charger.",
+	models.SMART_CHARGE_STATE_PLAN_EXECUTING_OVERRIDDEN:              "Smart charging is overridden, the vehicle charges right away.",
+	models.SMART_CHARGE_STATE_PLAN_ENDED_FINISHED:                    "The plan finished charging the vehicle.",
+	models.SMART_CHARGE_STATE_PLAN_ENDED_UNPLUGGED:                   "The plan ended as the vehicle was unplugged.",
+	models.SMART_CHARGE_STATE_PLAN_ENDED_FAILED:                      "The plan failed to control charging.",
+	models.SMART_CHARGE_STATE_PLAN_ENDED_DISABLED:                    "The plan ended as smart charging was disabled.",
+	models.SMART_CHARGE_STATE_PLAN_ENDED_DEADLINE_CHANGED:            "The plan ended as the deadline was changed, a new plan will follow.",
+}
+
+// failures describe the conditions a plan failed in.
+var failures = map[models.FailureCondition]string{
+	models.FAILURE_CONDITION_STOP_FAILED:        "The vehicle did not pause charging.",
+	models.FAILURE_CONDITION_START_FAILED:       "The vehicle did not start charging.",
+	models.FAILURE_CONDITION_FINISHED_LATE:      "Charging finished considerably later than estimated.",
+	models.FAILURE_CONDITION_CHARGE_INTERRUPTED: "Charging was interrupted.",
+	models.FAILURE_CONDITION_UNKNOWN:            "The reason is unknown.",
+}
+
+/*
+Explains the smart charging status of a vehicle.
+
+Parameters:
+  - status: The status of the vehicle.
+  - location: The location the times are given in, UTC if nil.
+
+Returns:
+  - The explanation of the state, with the costs of the active plan if any.
+*/
+func Explain(status models.VehicleSmartChargingStatus, location *time.Location) Explanation {
+	var explanation Explanation
+	if status.Plan != nil && status.State.IsPlan() {
+		explanation = ExplainPlan(*status.Plan, location)
+	}
+	explanation.State = status.State
+	explanation.Summary = summary(status.State, status.Plan, location)
+	if status.State == models.SMART_CHARGE_STATE_CONSIDERING && status.Consideration != nil {
+		explanation.Reasons = considerations(*status.Consideration)
+	}
+	return explanation
+}
+
+/*
+Explains a Smart Charging Plan, executing or ended.
+
+Parameters:
+  - plan: The plan to explain.
+  - location: The location the times are given in, UTC if nil.
+
+Returns:
+  - The explanation of the plan, with its estimated cost and the savings over charging right away.
+*/
+func ExplainPlan(plan models.VehicleSmartChargingPlan, location *time.Location) Explanation {
+	explanation := Explanation{Currency: plan.Currency}
+	// an executing plan has charged once charging was confirmed as started
+	if plan.StartConfirmedAt != nil {
+		explanation.State = models.SMART_CHARGE_STATE_PLAN_EXECUTING_STARTED
+	} else {
+		explanation.State = models.SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED
+	}
+	if plan.FinalState != nil {
+		explanation.State = models.SmartChargeState(*plan.FinalState)
+		if plan.FailureCondition != nil {
+			if reason, ok := failures[*plan.FailureCondition]; ok {
+				explanation.Reasons = []string{reason}
+			}
+		}
+	}
+	explanation.Summary = summary(explanation.State, &plan, location)
+	if plan.SmartCost != nil {
+		cost := *plan.SmartCost
+		savings := plan.NonSmartCost - cost
+		explanation.EstimatedCost = &cost
+		explanation.EstimatedSavings = &savings
+	}
+	return explanation
+}
+
+func summary(state models.SmartChargeState, plan *models.VehicleSmartChargingPlan, location *time.Location) string {
+	if location == nil {
+		location = time.UTC
+	}
+	if plan != nil && plan.FinalState == nil {
+		if state.IsAwaitingCharge() && plan.StartAt != nil {
+			return fmt.Sprintf("Charging is paused to wait for lower prices, it starts at %s and finishes around %s.",
+				plan.StartAt.In(location).Format(TIME_LAYOUT), plan.EstimatedFinishAt.In(location).Format(TIME_LAYOUT))
+		}
+		if state == models.SMART_CHARGE_STATE_PLAN_EXECUTING_STARTED {
+			return fmt.Sprintf("The vehicle charges at the planned time and finishes around %s.", plan.EstimatedFinishAt.In(location).Format(TIME_LAYOUT))
+		}
+	}
+	if text, ok := summaries[state]; ok {
+		return text
+	}
+	return fmt.Sprintf("The smart charging state %s is not known.", state)
+}
+
+// considerations list the conditions missing before a plan is made.
+func considerations(consideration models.Consideration) []string {
+	var reasons []string
+	if !consideration.IsPluggedIn {
+		reasons = append(reasons, "The vehicle is not plugged in.")
+	}
+	if !consideration.AtChargingLocation {
+		reasons = append(reasons, "The vehicle is not at a charging location with smart charging.")
+	}
+	if !consideration.HasTimeEstimate {
+		reasons = append(reasons, "The time needed to charge cannot be estimated yet.")
+	}
+	if consideration.IsPluggedIn && !consideration.IsCharging {
+		reasons = append(reasons, "The vehicle is not charging. Smart charging needs the vehicle to start charging when plugged in.")
+	}
+	return reasons
+}
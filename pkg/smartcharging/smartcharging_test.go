@@ -0,0 +1,64 @@
+package smartcharging_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/smartcharging"
+)
+
+func TestValidateTransition(t *testing.T) {
+	if err := smartcharging.ValidateTransition(models.SMART_CHARGE_STATE_PLAN_EXECUTING_STARTING, models.SMART_CHARGE_STATE_PLAN_EXECUTING_STARTED); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err := smartcharging.ValidateTransition(models.SMART_CHARGE_STATE_DISABLED, models.SMART_CHARGE_STATE_PLAN_ENDED_FINISHED)
+	if err == nil || !strings.HasPrefix(err.Error(), smartcharging.SMART_CHARGE_TRANSITION_ERROR) {
+		t.Errorf("Expected a transition error, got %v", err)
+	}
+	err = smartcharging.ValidateTransition(models.SMART_CHARGE_STATE_DISABLED, "CHARGING")
+	if err == nil || !strings.HasPrefix(err.Error(), smartcharging.SMART_CHARGE_STATE_ERROR) {
+		t.Errorf("Expected a state error, got %v", err)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	startAt := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	smartCost := models.MonetaryAmount(2.5)
+	status := models.VehicleSmartChargingStatus{
+		State: models.SMART_CHARGE_STATE_PLAN_EXECUTING_STOPPED,
+		Plan: &models.VehicleSmartChargingPlan{
+			Currency:          "EUR",
+			NonSmartCost:      4,
+			SmartCost:         &smartCost,
+			StartAt:           &startAt,
+			EstimatedFinishAt: startAt.Add(3 * time.Hour),
+		},
+	}
+	oslo, err := time.LoadLocation("Europe/Oslo")
+	if err != nil {
+		t.Skipf("No time zone data: %v", err)
+	}
+
+	explanation := smartcharging.Explain(status, oslo)
+	expected := "Charging is paused to wait for lower prices, it starts at 02:00 and finishes around 05:00. Estimated cost: 2.50 EUR, saving 1.50 EUR."
+	if explanation.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, explanation.String())
+	}
+
+	considering := smartcharging.Explain(models.VehicleSmartChargingStatus{
+		State:         models.SMART_CHARGE_STATE_CONSIDERING,
+		Consideration: &models.Consideration{IsPluggedIn: true, IsCharging: true, AtChargingLocation: false, HasTimeEstimate: true},
+	}, nil)
+	if len(considering.Reasons) != 1 || !strings.Contains(considering.Reasons[0], "charging location") || considering.EstimatedCost != nil {
+		t.Errorf("Unexpected explanation %+v", considering)
+	}
+
+	failed := models.VEHICLE_SMART_CHARGING_PLAN_FINAL_STATE_PLAN_ENDED_FAILED
+	condition := models.FAILURE_CONDITION_START_FAILED
+	ended := smartcharging.ExplainPlan(models.VehicleSmartChargingPlan{FinalState: &failed, FailureCondition: &condition}, nil)
+	if ended.State != models.SMART_CHARGE_STATE_PLAN_ENDED_FAILED || ended.String() != "The plan failed to control charging. The vehicle did not start charging." {
+		t.Errorf("Unexpected explanation %q", ended.String())
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+// GetChargingSessionsStatisticsParams holds the query parameters of GetChargingSessionsStatistics.
+type GetChargingSessionsStatisticsParams struct {
+	// Earliest date to include in the response. Example: `2021-03-21T00:15:00.000Z`
+	StartDate time.Time
+	// Latest date to include in the response. Example: `2021-03-21T00:30:00.000Z`
+	EndDate *time.Time
+	// The ID of the location for which to fetch statistics
+	LocationId *string
+	// The ID of the device for which to fetch statistics. The type of the device must match the `type` parameter.
+	Id *string
+	// The device types for which statistics can be obtained.
+	Type Type
+}
+
+// Values encodes the parameters as URL query values.
+func (params *GetChargingSessionsStatisticsParams) Values() url.Values {
+	query := url.Values{}
+	if params == nil {
+		return query
+	}
+	query.Set("startDate", params.StartDate.Format(time.RFC3339))
+	if params.EndDate != nil {
+		query.Set("endDate", (*params.EndDate).Format(time.RFC3339))
+	}
+	if params.LocationId != nil {
+		query.Set("locationId", *params.LocationId)
+	}
+	if params.Id != nil {
+		query.Set("id", *params.Id)
+	}
+	query.Set("type", string(params.Type))
+	return query
+}
+
+/*
+Returns statistics about power consumption and price, binned by sessions for a single user and device, at a location. A session is defined by consecutive readings from the device showing consumption. It can typically take up to 15 minutes between a session ending in reality, and being reflected by this endpoint.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - userId: The ID of the user
+  - params: The query parameters of the request.
+
+Returns:
+  - The models.SessionsStatisticsTimeseries returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetChargingSessionsStatistics(ctx context.Context, sess *session.Session, userId string, params *GetChargingSessionsStatisticsParams) (models.SessionsStatisticsTimeseries, error) {
+	path := fmt.Sprintf("/users/%s/statistics/charging/sessions", url.PathEscape(userId))
+
+	var result models.SessionsStatisticsTimeseries
+	if err := do(ctx, sess, http.MethodGet, path, params.Values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,85 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+// GetChargingStatisticsParams holds the query parameters of GetChargingStatistics.
+type GetChargingStatisticsParams struct {
+	// Earliest date to include in the response. Example: `2021-03-21T00:15:00.000Z`
+	StartDate time.Time
+	// Latest date to include in the response. Example: `2021-03-21T00:30:00.000Z`
+	EndDate *time.Time
+	// The ID of the location for which to fetch statistics
+	LocationId *string
+	// The ID of the device for which to fetch statistics. The type of the device must match the `type` parameter.
+	Id *string
+	// The device types for which statistics can be obtained.
+	Type Type
+	// Offset (in hours) from UTC to adjust the timezone for viewing statistics. By default, all timestamps are in UTC, and period boundaries (day, week, month, year) are calculated in UTC. Providing `utcOffset` aligns these to the viewer's timezone. Accepts positive, negative, and fractional values. Note: `utcOffset` has no effect with resolutions `QUARTER_HOUR`, `HALF_HOUR`, or `HOUR`.
+	//
+	// Deprecated: The use of `utcOffset` is discouraged as it doesn't handle time zones adequately. All data will be bucketed in UTC if utcOffset is not provided.
+	UtcOffset *string
+	// The unit of time the data will be bucketed into. Each entry in the response array corresponds to aggregated data of the time range specified.
+	Resolution *Resolution
+}
+
+// Values encodes the parameters as URL query values.
+func (params *GetChargingStatisticsParams) Values() url.Values {
+	query := url.Values{}
+	if params == nil {
+		return query
+	}
+	query.Set("startDate", params.StartDate.Format(time.RFC3339))
+	if params.EndDate != nil {
+		query.Set("endDate", (*params.EndDate).Format(time.RFC3339))
+	}
+	if params.LocationId != nil {
+		query.Set("locationId", *params.LocationId)
+	}
+	if params.Id != nil {
+		query.Set("id", *params.Id)
+	}
+	query.Set("type", string(params.Type))
+	if params.UtcOffset != nil {
+		query.Set("utcOffset", *params.UtcOffset)
+	}
+	if params.Resolution != nil {
+		query.Set("resolution", string(*params.Resolution))
+	}
+	return query
+}
+
+/*
+Get statistics about power consumption and price in the form of a time series for a single device.
+
+If Smart Charging has shifted the consumption, the `nonSmartPrice` fields will show what the consumption would have cost if it had happened at the default time. If Smart Charging has resulted in savings, the value is reported in the `estimatedSavings` field.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - userId: The ID of the User
+  - params: The query parameters of the request.
+
+Returns:
+  - The models.ChargingStatisticsTimeseries returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetChargingStatistics(ctx context.Context, sess *session.Session, userId string, params *GetChargingStatisticsParams) (models.ChargingStatisticsTimeseries, error) {
+	path := fmt.Sprintf("/users/%s/statistics/charging", url.PathEscape(userId))
+
+	var result models.ChargingStatisticsTimeseries
+	if err := do(ctx, sess, http.MethodGet, path, params.Values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
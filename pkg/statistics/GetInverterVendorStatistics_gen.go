@@ -0,0 +1,90 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+// GetInverterVendorStatisticsParams holds the query parameters of GetInverterVendorStatistics.
+type GetInverterVendorStatisticsParams struct {
+	// The duration of each time bucket the returned time series is divided into. Each entry in the response array corresponds to aggregated data of the specified time range.
+	Resolution GetInverterVendorStatisticsResolution
+	// The earliest date (UTC) to include in the response. Cannot be greater than endDate. Example: `2021-03-21T02:00:00Z`
+	StartDate time.Time
+	// The latest date (UTC) to include in the response. Defaults to the current date/time. Example: `2021-03-21T05:00:00Z`
+	EndDate *time.Time
+	// ID of the Inverter.
+	InverterId string
+}
+
+// Values encodes the parameters as URL query values.
+func (params *GetInverterVendorStatisticsParams) Values() url.Values {
+	query := url.Values{}
+	if params == nil {
+		return query
+	}
+	query.Set("resolution", string(params.Resolution))
+	query.Set("startDate", params.StartDate.Format(time.RFC3339))
+	if params.EndDate != nil {
+		query.Set("endDate", (*params.EndDate).Format(time.RFC3339))
+	}
+	query.Set("inverterId", params.InverterId)
+	return query
+}
+
+/*
+Returns statistics about power production for the given inverter in the form of a time series, collected from vendor APIs.
+
+This endpoint is currently in beta.
+Please be aware that errors, inaccuracies, and breaking changes may occur without prior notice.
+
+Currently, we only support querying for a maximum of 1 month of data at once.
+
+It is not possible to select a timezone for the aggregated daily stats;
+the timezone reported by the inverter is used.
+To aggregate data in other timezones,
+we recommend fetching hourly data.
+Inverters in non-integer timezone offsets have not been tested,
+and may not return correct data.
+
+Please note that currently,
+requests may take a long time to complete.
+Fetching shorter intervals,
+such as a week or a few days at a time,
+is the recommended workaround if you experience timeouts.
+
+Data for the current day/hour (not available for all vendors) is cached for up to 15 minutes.
+Older data is cached indefinitely,
+so retroactive updates to existing data by the vendor are not reflected in the API.
+This could happen,
+for instance,
+if the inverter is actually an array of inverters,
+and an inverter is added or removed.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - userId: The ID of the User
+  - params: The query parameters of the request.
+
+Returns:
+  - A pointer to the models.InverterStatistics object returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetInverterVendorStatistics(ctx context.Context, sess *session.Session, userId string, params *GetInverterVendorStatisticsParams) (*models.InverterStatistics, error) {
+	path := fmt.Sprintf("/users/%s/vendor-statistics", url.PathEscape(userId))
+
+	var result models.InverterStatistics
+	if err := do(ctx, sess, http.MethodGet, path, params.Values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
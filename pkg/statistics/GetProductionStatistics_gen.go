@@ -0,0 +1,85 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+// GetProductionStatisticsParams holds the query parameters of GetProductionStatistics.
+type GetProductionStatisticsParams struct {
+	// Earliest date to include in the response. Example: `2021-03-21T00:15:00.000Z`
+	StartDate time.Time
+	// Latest date to include in the response. Example: `2021-03-21T00:30:00.000Z`
+	EndDate *time.Time
+	// The ID of the location for which to fetch statistics
+	LocationId *string
+	// The ID of the device for which to fetch statistics. The type of the device must match the `type` parameter.
+	Id *string
+	// Get statistics for this hardware type.
+	Type GetProductionStatisticsType
+	// The unit of time the data will be cut into before aggregate statistics are applied. Each entry in the response array corresponds to aggregated data of the time range specified.
+	Resolution *Resolution
+	// Offset (in hours) from UTC to adjust the timezone for viewing statistics. By default, all timestamps are in UTC, and period boundaries (day, week, month, year) are calculated in UTC. Providing `utcOffset` aligns these to the viewer's timezone. Accepts positive, negative, and fractional values. Note: `utcOffset` has no effect with resolutions `QUARTER_HOUR`, `HALF_HOUR`, or `HOUR`.
+	//
+	// Deprecated: The use of `utcOffset` is discouraged as it doesn't handle time zones adequately. All data will be bucketed in UTC if utcOffset is not provided.
+	UtcOffset *string
+}
+
+// Values encodes the parameters as URL query values.
+func (params *GetProductionStatisticsParams) Values() url.Values {
+	query := url.Values{}
+	if params == nil {
+		return query
+	}
+	query.Set("startDate", params.StartDate.Format(time.RFC3339))
+	if params.EndDate != nil {
+		query.Set("endDate", (*params.EndDate).Format(time.RFC3339))
+	}
+	if params.LocationId != nil {
+		query.Set("locationId", *params.LocationId)
+	}
+	if params.Id != nil {
+		query.Set("id", *params.Id)
+	}
+	query.Set("type", string(params.Type))
+	if params.Resolution != nil {
+		query.Set("resolution", string(*params.Resolution))
+	}
+	if params.UtcOffset != nil {
+		query.Set("utcOffset", *params.UtcOffset)
+	}
+	return query
+}
+
+/*
+Returns statistics about power production and price for a single device and location, in the form of a time series.
+
+Data is only available from after the asset was linked. To view inverter production data from before an asset was linked, consider using our [Vendor Statistics for Inverters](/api/reference#getInverterVendorStatistics) endpoint.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - userId: The ID of the User
+  - params: The query parameters of the request.
+
+Returns:
+  - The models.ProductionStatisticsTimeseries returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetProductionStatistics(ctx context.Context, sess *session.Session, userId string, params *GetProductionStatisticsParams) (models.ProductionStatisticsTimeseries, error) {
+	path := fmt.Sprintf("/users/%s/statistics/production", url.PathEscape(userId))
+
+	var result models.ProductionStatisticsTimeseries
+	if err := do(ctx, sess, http.MethodGet, path, params.Values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
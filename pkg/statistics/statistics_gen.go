@@ -0,0 +1,119 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+// Package statistics wraps the endpoints of the Enode API for the consumption and production statistics.
+package statistics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/internal/rest"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+const (
+	REST_STATISTICS_TRANSFER_ERROR      string = "statistics: could not transfer request"
+	REST_STATISTICS_READ_ERROR          string = "statistics: could not read response body"
+	REST_STATISTICS_PARSE_ERROR         string = "statistics: unable to parse response data"
+	REST_STATISTICS_PAYLOAD_ERROR       string = "statistics: unable to create request payload"
+	REST_STATISTICS_UNAUTHORIZED_ERROR  string = "statistics: unauthorized access"
+	REST_STATISTICS_FORBIDDEN_ERROR     string = "statistics: access to the resource is forbidden"
+	REST_STATISTICS_NOT_FOUND_ERROR     string = "statistics: requested resource not found"
+	REST_STATISTICS_VALIDATION_ERROR    string = "statistics: invalid request payload input"
+	REST_STATISTICS_CONFLICT_ERROR      string = "statistics: request conflicts with the current state of the resource"
+	REST_STATISTICS_UNPROCESSABLE_ERROR string = "statistics: request could not be processed for the target"
+	REST_STATISTICS_RATE_LIMIT_ERROR    string = "statistics: too many requests"
+	REST_STATISTICS_GENERAL_ERROR       string = "statistics: some kind of error occurred"
+)
+
+// Type: The device types for which statistics can be obtained.
+type Type string
+
+const (
+	TYPE_CHARGER Type = "charger"
+	TYPE_VEHICLE Type = "vehicle"
+	TYPE_HVAC    Type = "hvac"
+)
+
+// Resolution: The unit of time the data will be bucketed into. Each entry in the response array corresponds to aggregated data of the time range specified.
+type Resolution string
+
+const (
+	RESOLUTION_QUARTER_HOUR Resolution = "QUARTER_HOUR"
+	RESOLUTION_HALF_HOUR    Resolution = "HALF_HOUR"
+	RESOLUTION_HOUR         Resolution = "HOUR"
+	RESOLUTION_DAY          Resolution = "DAY"
+	RESOLUTION_WEEK         Resolution = "WEEK"
+	RESOLUTION_MONTH        Resolution = "MONTH"
+	RESOLUTION_YEAR         Resolution = "YEAR"
+)
+
+// GetProductionStatisticsType: Get statistics for this hardware type.
+type GetProductionStatisticsType string
+
+const (
+	GET_PRODUCTION_STATISTICS_TYPE_INVERTER GetProductionStatisticsType = "inverter"
+)
+
+// GetInverterVendorStatisticsResolution: The duration of each time bucket the returned time series is divided into. Each entry in the response array corresponds to aggregated data of the specified time range.
+type GetInverterVendorStatisticsResolution string
+
+const (
+	GET_INVERTER_VENDOR_STATISTICS_RESOLUTION_HOUR GetInverterVendorStatisticsResolution = "HOUR"
+	GET_INVERTER_VENDOR_STATISTICS_RESOLUTION_DAY  GetInverterVendorStatisticsResolution = "DAY"
+)
+
+// do executes a request and decodes a successful response into result, if set.
+func do(ctx context.Context, sess *session.Session, method, path string, query url.Values, payload, result any) error {
+	req, err := rest.NewRequest(ctx, sess, method, path, query, payload)
+	if err != nil {
+		return errors.Join(errors.New(REST_STATISTICS_PAYLOAD_ERROR), err)
+	}
+
+	resp, err := sess.Do(req)
+	if err != nil {
+		return errors.Join(errors.New(REST_STATISTICS_TRANSFER_ERROR), err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Join(errors.New(REST_STATISTICS_READ_ERROR), err)
+	}
+
+	switch resp.StatusCode {
+	default:
+		return errors.Join(errors.New(REST_STATISTICS_GENERAL_ERROR), rest.Problem(resp, body))
+	case http.StatusBadRequest:
+		return errors.Join(errors.New(REST_STATISTICS_VALIDATION_ERROR), rest.Problem(resp, body))
+	case http.StatusUnauthorized:
+		return errors.Join(errors.New(REST_STATISTICS_UNAUTHORIZED_ERROR), rest.Problem(resp, body))
+	case http.StatusForbidden:
+		return errors.Join(errors.New(REST_STATISTICS_FORBIDDEN_ERROR), rest.Problem(resp, body))
+	case http.StatusNotFound:
+		return errors.Join(errors.New(REST_STATISTICS_NOT_FOUND_ERROR), rest.Problem(resp, body))
+	case http.StatusConflict:
+		return errors.Join(errors.New(REST_STATISTICS_CONFLICT_ERROR), rest.Problem(resp, body))
+	case http.StatusUnprocessableEntity:
+		return errors.Join(errors.New(REST_STATISTICS_UNPROCESSABLE_ERROR), rest.Problem(resp, body))
+	case http.StatusTooManyRequests:
+		return errors.Join(errors.New(REST_STATISTICS_RATE_LIMIT_ERROR), rest.Problem(resp, body))
+	case http.StatusBadGateway:
+		return errors.Join(errors.New(REST_STATISTICS_TRANSFER_ERROR), rest.Problem(resp, body))
+	case http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent:
+		if result == nil {
+			return nil
+		}
+		if len(body) == 0 {
+			return errors.Join(errors.New(REST_STATISTICS_READ_ERROR), io.EOF)
+		}
+		if err := json.Unmarshal(body, result); err != nil {
+			return errors.Join(errors.New(REST_STATISTICS_PARSE_ERROR), err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,118 @@
+package tariffimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+)
+
+// layouts are the accepted timestamp formats, in the order they are tried.
+var layouts = []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02 15:04:05", "2006-01-02 15:04"}
+
+// CSVOptions configures ReadCSV.
+type CSVOptions struct {
+	// Location interprets timestamps without offset, UTC if nil.
+	Location *time.Location
+	// Resolution is the length of each interval. If zero, the shortest
+	// distance between two timestamps is used.
+	Resolution time.Duration
+	// Comma separates the fields, ',' if zero.
+	Comma rune
+}
+
+/*
+Reads prices from CSV with the columns timestamp, price and currency. A header
+row is skipped. Each price applies from its timestamp for the resolution.
+
+Parameters:
+  - r: The CSV to read.
+  - options: The time zone, resolution and separator of the CSV.
+
+Returns:
+  - A pointer to the validated prices.
+  - An error if a row cannot be parsed, currencies differ, or the prices have gaps or overlaps.
+*/
+func ReadCSV(r io.Reader, options CSVOptions) (*Prices, error) {
+	location := options.Location
+	if location == nil {
+		location = time.UTC
+	}
+	reader := csv.NewReader(r)
+	if options.Comma != 0 {
+		reader.Comma = options.Comma
+	}
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, errors.Join(errors.New(IMPORT_PARSE_ERROR), err)
+	}
+
+	var starts []time.Time
+	var costs []models.TariffIntervalCost
+	currency := ""
+	for i, record := range records {
+		from, err := parseTimestamp(strings.TrimSpace(record[0]), location)
+		if err != nil {
+			if i == 0 {
+				// the header
+				continue
+			}
+			return nil, errors.Join(errors.New(IMPORT_PARSE_ERROR), fmt.Errorf("line %d: %w", i+1, err))
+		}
+		if currency == "" {
+			currency = strings.TrimSpace(record[2])
+		}
+		if !strings.EqualFold(currency, strings.TrimSpace(record[2])) {
+			return nil, errors.Join(errors.New(IMPORT_CURRENCY_ERROR), fmt.Errorf("line %d: %s instead of %s", i+1, record[2], currency))
+		}
+		starts = append(starts, from)
+		costs = append(costs, models.TariffIntervalCost(strings.TrimSpace(record[1])))
+	}
+	if len(starts) == 0 {
+		return nil, errors.New(IMPORT_EMPTY_ERROR)
+	}
+
+	resolution := options.Resolution
+	if resolution <= 0 {
+		resolution = shortest(starts)
+	}
+	intervals := make([]Interval, len(starts))
+	for i, from := range starts {
+		intervals[i] = Interval{From: from, To: from.Add(resolution), Cost: costs[i]}
+	}
+	return NewPrices(strings.ToUpper(currency), intervals)
+}
+
+func parseTimestamp(value string, location *time.Location) (time.Time, error) {
+	var err error
+	for _, layout := range layouts {
+		var at time.Time
+		if at, err = time.ParseInLocation(layout, value, location); err == nil {
+			return at, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// shortest returns the shortest positive distance between the timestamps, an hour for a single one.
+func shortest(starts []time.Time) time.Duration {
+	sorted := append([]time.Time{}, starts...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Before(sorted[j]) })
+	var resolution time.Duration
+	for i := 1; i < len(sorted); i++ {
+		if distance := sorted[i].Sub(sorted[i-1]); distance > 0 && (resolution == 0 || distance < resolution) {
+			resolution = distance
+		}
+	}
+	if resolution == 0 {
+		return time.Hour
+	}
+	return resolution
+}
@@ -0,0 +1,134 @@
+package tariffimport
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+)
+
+// CURVE_VARIABLE_SIZED_BLOCK is the ENTSO-E curve type omitting points which
+// repeat the price of the previous point.
+const CURVE_VARIABLE_SIZED_BLOCK = "A03"
+
+type publicationDocument struct {
+	TimeSeries []struct {
+		Currency  string `xml:"currency_Unit.name"`
+		Unit      string `xml:"price_Measure_Unit.name"`
+		CurveType string `xml:"curveType"`
+		Periods   []struct {
+			Start      string `xml:"timeInterval>start"`
+			End        string `xml:"timeInterval>end"`
+			Resolution string `xml:"resolution"`
+			Points     []struct {
+				Position int    `xml:"position"`
+				Amount   string `xml:"price.amount"`
+			} `xml:"Point"`
+		} `xml:"Period"`
+	} `xml:"TimeSeries"`
+}
+
+var resolution = regexp.MustCompile(`^PT(?:(\d+)H)?(?:(\d+)M)?$`)
+
+func parseResolution(value string) (time.Duration, error) {
+	match := resolution.FindStringSubmatch(value)
+	if match == nil || (match[1] == "" && match[2] == "") {
+		return 0, fmt.Errorf("unsupported resolution %q", value)
+	}
+	hours, _ := strconv.Atoi(match[1])
+	minutes, _ := strconv.Atoi(match[2])
+	duration := time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute
+	if duration <= 0 {
+		return 0, fmt.Errorf("unsupported resolution %q", value)
+	}
+	return duration, nil
+}
+
+func parseInterval(value string) (time.Time, error) {
+	// ENTSO-E omits the seconds of interval times
+	for _, layout := range []string{"2006-01-02T15:04Z07:00", time.RFC3339} {
+		if at, err := time.Parse(layout, value); err == nil {
+			return at, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q", value)
+}
+
+/*
+Reads prices from an ENTSO-E publication market document, e.g. the day-ahead
+prices of a bidding zone. Prices per MWh are converted to prices per kWh.
+
+Parameters:
+  - r: The XML document to read.
+
+Returns:
+  - A pointer to the validated prices.
+  - An error if the document cannot be parsed, currencies differ, or the prices have gaps or overlaps.
+*/
+func ReadENTSOE(r io.Reader) (*Prices, error) {
+	var document publicationDocument
+	if err := xml.NewDecoder(r).Decode(&document); err != nil {
+		return nil, errors.Join(errors.New(IMPORT_PARSE_ERROR), err)
+	}
+
+	var intervals []Interval
+	currency := ""
+	for _, series := range document.TimeSeries {
+		if currency == "" {
+			currency = series.Currency
+		}
+		if series.Currency != currency {
+			return nil, errors.Join(errors.New(IMPORT_CURRENCY_ERROR), fmt.Errorf("%s instead of %s", series.Currency, currency))
+		}
+		places := 0
+		switch strings.ToUpper(series.Unit) {
+		case "MWH":
+			places = 3
+		case "KWH", "":
+		default:
+			return nil, errors.Join(errors.New(IMPORT_PARSE_ERROR), fmt.Errorf("unsupported unit %q", series.Unit))
+		}
+
+		for _, period := range series.Periods {
+			start, err := parseInterval(period.Start)
+			if err != nil {
+				return nil, errors.Join(errors.New(IMPORT_PARSE_ERROR), err)
+			}
+			end, err := parseInterval(period.End)
+			if err != nil {
+				return nil, errors.Join(errors.New(IMPORT_PARSE_ERROR), err)
+			}
+			step, err := parseResolution(period.Resolution)
+			if err != nil {
+				return nil, errors.Join(errors.New(IMPORT_PARSE_ERROR), err)
+			}
+
+			amounts := map[int]string{}
+			for _, point := range period.Points {
+				amounts[point.Position] = shift(strings.TrimSpace(point.Amount), places)
+			}
+			count := int(end.Sub(start) / step)
+			previous, ok := "", false
+			for position := 1; position <= count; position++ {
+				amount, found := amounts[position]
+				if !found && series.CurveType == CURVE_VARIABLE_SIZED_BLOCK && ok {
+					amount, found = previous, true
+				}
+				if !found {
+					// left as a gap, reported by the validation
+					continue
+				}
+				previous, ok = amount, true
+				from := start.Add(time.Duration(position-1) * step)
+				intervals = append(intervals, Interval{From: from, To: from.Add(step), Cost: models.TariffIntervalCost(amount)})
+			}
+		}
+	}
+	return NewPrices(currency, intervals)
+}
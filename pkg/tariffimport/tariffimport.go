@@ -0,0 +1,241 @@
+// Package tariffimport converts day-ahead prices from common formats into
+// tariffs and schedules of the Enode API:
+//
+//	prices, err := tariffimport.ReadCSV(file, tariffimport.CSVOptions{Location: oslo})
+//	err = prices.Upload(ctx, sess, "day-ahead", locationId, oslo)
+//
+// Prices are validated for gaps and overlaps when imported. As tariff
+// schedules repeat weekly, at most one week of prices can be converted.
+package tariffimport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/planner"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/tariffs"
+)
+
+const (
+	IMPORT_PARSE_ERROR    string = "tariffimport: unable to parse prices"
+	IMPORT_EMPTY_ERROR    string = "tariffimport: no prices found"
+	IMPORT_GAP_ERROR      string = "tariffimport: prices have a gap"
+	IMPORT_OVERLAP_ERROR  string = "tariffimport: prices overlap"
+	IMPORT_CURRENCY_ERROR string = "tariffimport: prices have different currencies"
+	IMPORT_COST_ERROR     string = "tariffimport: invalid cost"
+	IMPORT_SPAN_ERROR     string = "tariffimport: prices span more than a week"
+)
+
+// cost matches the decimal strings accepted as TariffIntervalCost.
+var cost = regexp.MustCompile(`^[+-]?(\d{1,9}([.]\d{0,9})?|[.]\d{1,9})$`)
+
+// Interval is the price of energy per kWh within [From, To).
+type Interval struct {
+	From time.Time
+	To   time.Time
+	Cost models.TariffIntervalCost
+}
+
+// Prices is a validated list of intervals in one currency, ordered by time.
+type Prices struct {
+	Currency  string
+	Intervals []Interval
+}
+
+/*
+Creates validated prices from intervals.
+
+Parameters:
+  - currency: The currency of all intervals.
+  - intervals: The intervals, in any order.
+
+Returns:
+  - A pointer to the prices ordered by time.
+  - An error joining all gaps, overlaps and invalid costs.
+*/
+func NewPrices(currency string, intervals []Interval) (*Prices, error) {
+	if len(intervals) == 0 {
+		return nil, errors.New(IMPORT_EMPTY_ERROR)
+	}
+	sorted := append([]Interval{}, intervals...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].From.Before(sorted[j].From) })
+
+	var errs []error
+	for i, interval := range sorted {
+		if !cost.MatchString(string(interval.Cost)) {
+			errs = append(errs, errors.Join(errors.New(IMPORT_COST_ERROR), fmt.Errorf("%q at %s", interval.Cost, interval.From.Format(time.RFC3339))))
+		}
+		if !interval.To.After(interval.From) {
+			errs = append(errs, errors.Join(errors.New(IMPORT_PARSE_ERROR), fmt.Errorf("empty interval at %s", interval.From.Format(time.RFC3339))))
+		}
+		if i == 0 {
+			continue
+		}
+		previous := sorted[i-1]
+		if interval.From.Before(previous.To) {
+			errs = append(errs, errors.Join(errors.New(IMPORT_OVERLAP_ERROR), fmt.Errorf("%s to %s", interval.From.Format(time.RFC3339), previous.To.Format(time.RFC3339))))
+		}
+		if interval.From.After(previous.To) {
+			errs = append(errs, errors.Join(errors.New(IMPORT_GAP_ERROR), fmt.Errorf("%s to %s", previous.To.Format(time.RFC3339), interval.From.Format(time.RFC3339))))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return &Prices{Currency: currency, Intervals: sorted}, nil
+}
+
+// names assigns a rate name to each distinct cost, in the order the costs first occur.
+func (p *Prices) names() ([]models.TariffIntervalCost, map[models.TariffIntervalCost]models.TariffRateName) {
+	var costs []models.TariffIntervalCost
+	names := map[models.TariffIntervalCost]models.TariffRateName{}
+	for _, interval := range p.Intervals {
+		if _, ok := names[interval.Cost]; !ok {
+			costs = append(costs, interval.Cost)
+			names[interval.Cost] = models.TariffRateName(fmt.Sprintf("RATE-%d", len(costs)))
+		}
+	}
+	return costs, names
+}
+
+// Tariff returns the rates of the prices, one per distinct cost, for SendTariffInformation.
+func (p *Prices) Tariff() models.Tariff {
+	costs, names := p.names()
+	tariff := make(models.Tariff, len(costs))
+	for i, cost := range costs {
+		tariff[i] = models.TariffRate{Name: names[cost], Cost: cost}
+	}
+	return tariff
+}
+
+// days splits the intervals at midnight in a location, calling fn with the
+// weekday counted from 0 for Monday and the times of day of each part.
+func (p *Prices) days(location *time.Location, fn func(weekday int, from, to string, cost models.TariffIntervalCost)) error {
+	last := p.Intervals[len(p.Intervals)-1].To
+	if last.Sub(p.Intervals[0].From) > 7*24*time.Hour {
+		return errors.Join(errors.New(IMPORT_SPAN_ERROR), fmt.Errorf("%s to %s", p.Intervals[0].From.Format(time.RFC3339), last.Format(time.RFC3339)))
+	}
+	for _, interval := range p.Intervals {
+		for from := interval.From.In(location); from.Before(interval.To); {
+			midnight := time.Date(from.Year(), from.Month(), from.Day()+1, 0, 0, 0, 0, location)
+			to := interval.To.In(location)
+			end := to.Format("15:04")
+			if !to.Before(midnight) {
+				to, end = midnight, "24:00"
+			}
+			fn((int(from.Weekday())+6)%7, from.Format("15:04"), end, interval.Cost)
+			from = to
+		}
+	}
+	return nil
+}
+
+/*
+Creates the intervals linking the tariff to a location.
+
+Parameters:
+  - tariffId: The ID the tariff is sent with.
+  - location: The time zone of the location.
+
+Returns:
+  - A pointer to the intervals for AssociateUserLocationWithTariff, in the time zone of the location.
+  - An error if the prices span more than a week.
+*/
+func (p *Prices) LocationIntervals(tariffId string, location *time.Location) (*models.LocationTariffInterval, error) {
+	_, names := p.names()
+	result := &models.LocationTariffInterval{TariffId: tariffId, TariffIntervals: []models.TariffRateInterval{}}
+	err := p.days(location, func(weekday int, from, to string, cost models.TariffIntervalCost) {
+		result.TariffIntervals = append(result.TariffIntervals, models.TariffRateInterval{Name: string(names[cost]), Weekdays: []int{weekday}, From: from, To: to})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+/*
+Creates the schedule of the tariff in UTC.
+
+Parameters:
+  - tariffId: The ID the tariff is sent with.
+
+Returns:
+  - The schedule with one item per interval and day.
+  - An error if the prices span more than a week.
+*/
+func (p *Prices) Schedule(tariffId string) (models.LocationTariffSchedule, error) {
+	_, names := p.names()
+	schedule := models.LocationTariffSchedule{}
+	err := p.days(time.UTC, func(weekday int, from, to string, cost models.TariffIntervalCost) {
+		schedule = append(schedule, models.LocationTariffScheduleItem{Weekday: weekday, FromHourMinute: from, ToHourMinute: to, TariffId: tariffId, TariffName: string(names[cost])})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// Series returns the prices for the planner.
+func (p *Prices) Series() planner.Series {
+	series := make(planner.Series, len(p.Intervals))
+	for i, interval := range p.Intervals {
+		// costs are validated on import
+		price, _ := strconv.ParseFloat(string(interval.Cost), 64)
+		series[i] = planner.Price{From: interval.From, To: interval.To, Price: price}
+	}
+	return series
+}
+
+/*
+Sends the tariff and links it to a location.
+
+Parameters:
+  - sess: The session of the client.
+  - tariffId: The ID to send the tariff with.
+  - locationId: The ID of the location.
+  - location: The time zone of the location.
+
+Returns:
+  - An error if the prices span more than a week or any occurred during the requests.
+*/
+func (p *Prices) Upload(ctx context.Context, sess *session.Session, tariffId string, locationId string, location *time.Location) error {
+	intervals, err := p.LocationIntervals(tariffId, location)
+	if err != nil {
+		return err
+	}
+	if err := tariffs.SendTariffInformation(ctx, sess, tariffId, p.Tariff()); err != nil {
+		return err
+	}
+	return tariffs.AssociateUserLocationWithTariff(ctx, sess, locationId, intervals)
+}
+
+// shift moves the decimal point of a decimal string by places to the left,
+// e.g. to convert a price per MWh into a price per kWh without rounding.
+func shift(amount string, places int) string {
+	sign := ""
+	if strings.HasPrefix(amount, "-") || strings.HasPrefix(amount, "+") {
+		sign, amount = amount[:1], amount[1:]
+	}
+	whole, fraction, _ := strings.Cut(amount, ".")
+	for len(whole) <= places {
+		whole = "0" + whole
+	}
+	fraction = whole[len(whole)-places:] + fraction
+	whole = strings.TrimLeft(whole[:len(whole)-places], "0")
+	if whole == "" {
+		whole = "0"
+	}
+	fraction = strings.TrimRight(fraction, "0")
+	if fraction == "" {
+		return sign + whole
+	}
+	return sign + whole + "." + fraction
+}
@@ -0,0 +1,115 @@
+package tariffimport_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/tariffimport"
+)
+
+func TestReadCSV(t *testing.T) {
+	oslo, err := time.LoadLocation("Europe/Oslo")
+	if err != nil {
+		t.Skipf("No time zone data: %v", err)
+	}
+	csv := `timestamp,price,currency
+2024-01-01 23:00,0.20,NOK
+2024-01-02 00:00,0.10,NOK
+2024-01-02 01:00,0.20,NOK
+`
+	prices, err := tariffimport.ReadCSV(strings.NewReader(csv), tariffimport.CSVOptions{Location: oslo})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if prices.Currency != "NOK" || len(prices.Intervals) != 3 || prices.Intervals[2].To.Sub(prices.Intervals[2].From) != time.Hour {
+		t.Errorf("Unexpected prices %+v", prices)
+	}
+
+	tariff := prices.Tariff()
+	expected := models.Tariff{{Name: "RATE-1", Cost: "0.20"}, {Name: "RATE-2", Cost: "0.10"}}
+	if len(tariff) != 2 || tariff[0] != expected[0] || tariff[1] != expected[1] {
+		t.Errorf("Expected %+v, got %+v", expected, tariff)
+	}
+
+	intervals, err := prices.LocationIntervals("day-ahead", oslo)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	first := intervals.TariffIntervals[0]
+	if len(intervals.TariffIntervals) != 3 || first.Name != "RATE-1" || first.From != "23:00" || first.To != "24:00" || first.Weekdays[0] != 0 {
+		t.Errorf("Unexpected intervals %+v", intervals.TariffIntervals)
+	}
+	if second := intervals.TariffIntervals[1]; second.From != "00:00" || second.Weekdays[0] != 1 {
+		t.Errorf("Expected the second interval on Tuesday, got %+v", second)
+	}
+
+	// Oslo is an hour ahead of UTC in winter
+	schedule, err := prices.Schedule("day-ahead")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(schedule) != 3 || schedule[0].FromHourMinute != "22:00" || schedule[0].ToHourMinute != "23:00" || schedule[0].Weekday != 0 {
+		t.Errorf("Unexpected schedule %+v", schedule)
+	}
+	if price, err := prices.Series().PriceAt(time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC)); err != nil || price != 0.10 {
+		t.Errorf("Expected 0.10 at 00:30 in Oslo, got %v, %v", price, err)
+	}
+}
+
+func TestReadCSV_Validation(t *testing.T) {
+	gap := "2024-01-01T00:00:00Z,0.20,EUR\n2024-01-01T01:00:00Z,0.10,EUR\n2024-01-01T03:00:00Z,0.10,EUR\n"
+	if _, err := tariffimport.ReadCSV(strings.NewReader(gap), tariffimport.CSVOptions{}); err == nil || !strings.Contains(err.Error(), tariffimport.IMPORT_GAP_ERROR) {
+		t.Errorf("Expected a gap error, got %v", err)
+	}
+	overlap := "2024-01-01T00:00:00Z,0.20,EUR\n2024-01-01T00:30:00Z,0.10,EUR\n"
+	if _, err := tariffimport.ReadCSV(strings.NewReader(overlap), tariffimport.CSVOptions{Resolution: time.Hour}); err == nil || !strings.Contains(err.Error(), tariffimport.IMPORT_OVERLAP_ERROR) {
+		t.Errorf("Expected an overlap error, got %v", err)
+	}
+	currencies := "2024-01-01T00:00:00Z,0.20,EUR\n2024-01-01T01:00:00Z,0.10,SEK\n"
+	if _, err := tariffimport.ReadCSV(strings.NewReader(currencies), tariffimport.CSVOptions{}); err == nil || !strings.HasPrefix(err.Error(), tariffimport.IMPORT_CURRENCY_ERROR) {
+		t.Errorf("Expected a currency error, got %v", err)
+	}
+	cost := "2024-01-01T00:00:00Z,abc,EUR\n"
+	if _, err := tariffimport.ReadCSV(strings.NewReader(cost), tariffimport.CSVOptions{}); err == nil || !strings.HasPrefix(err.Error(), tariffimport.IMPORT_COST_ERROR) {
+		t.Errorf("Expected a cost error, got %v", err)
+	}
+}
+
+func TestReadENTSOE(t *testing.T) {
+	document := `<?xml version="1.0" encoding="UTF-8"?>
+<Publication_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-3:publicationdocument:7:3">
+  <TimeSeries>
+    <currency_Unit.name>EUR</currency_Unit.name>
+    <price_Measure_Unit.name>MWH</price_Measure_Unit.name>
+    <curveType>A03</curveType>
+    <Period>
+      <timeInterval>
+        <start>2024-01-01T23:00Z</start>
+        <end>2024-01-02T03:00Z</end>
+      </timeInterval>
+      <resolution>PT60M</resolution>
+      <Point><position>1</position><price.amount>85.5</price.amount></Point>
+      <Point><position>3</position><price.amount>-1.25</price.amount></Point>
+    </Period>
+  </TimeSeries>
+</Publication_MarketDocument>`
+
+	prices, err := tariffimport.ReadENTSOE(strings.NewReader(document))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	costs := []models.TariffIntervalCost{"0.0855", "0.0855", "-0.00125", "-0.00125"}
+	if prices.Currency != "EUR" || len(prices.Intervals) != len(costs) {
+		t.Fatalf("Unexpected prices %+v", prices)
+	}
+	for i, cost := range costs {
+		if prices.Intervals[i].Cost != cost {
+			t.Errorf("Expected %s at position %d, got %s", cost, i+1, prices.Intervals[i].Cost)
+		}
+	}
+	if !prices.Intervals[3].To.Equal(time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the prices to end at 03:00, got %v", prices.Intervals[3].To)
+	}
+}
@@ -0,0 +1,31 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package tariffs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Link a tariff to a [Location](/api/reference#locations) using a daily schedule. Each new schedule overwrites any previous requests. Devices positioned at this location will use the schedule to calculate electricity costs.The schedule must ensure that there are no overlapping intervals, although gaps are permissible. In case of gaps, the system will default to market prices.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - locationId: The locationId of the request.
+  - payload: The request body sent to the API.
+
+Returns:
+  - An error if any occurred during the request. If the request is successful, it returns nil.
+*/
+func AssociateUserLocationWithTariff(ctx context.Context, sess *session.Session, locationId string, payload *models.LocationTariffInterval) error {
+	path := fmt.Sprintf("/locations/%s/tariff", url.PathEscape(locationId))
+
+	return do(ctx, sess, http.MethodPut, path, nil, payload, nil)
+}
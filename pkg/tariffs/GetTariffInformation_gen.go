@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package tariffs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Get a tariff using its ID.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - tariffId: The tariffId of the request.
+
+Returns:
+  - The models.Tariff returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetTariffInformation(ctx context.Context, sess *session.Session, tariffId string) (models.Tariff, error) {
+	path := fmt.Sprintf("/tariffs/%s", url.PathEscape(tariffId))
+
+	var result models.Tariff
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,31 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package tariffs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Create a tariff using a list of names and prices. Before the tariff can be used in Enode products, it must be [linked to a location](https://developers.enode.com/api/reference#associateUserLocationWithTariff).
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - tariffId: The tariffId of the request.
+  - payload: The request body sent to the API.
+
+Returns:
+  - An error if any occurred during the request. If the request is successful, it returns nil.
+*/
+func SendTariffInformation(ctx context.Context, sess *session.Session, tariffId string, payload models.Tariff) error {
+	path := fmt.Sprintf("/tariffs/%s", url.PathEscape(tariffId))
+
+	return do(ctx, sess, http.MethodPut, path, nil, payload, nil)
+}
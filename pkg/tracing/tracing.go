@@ -0,0 +1,146 @@
+// Package tracing provides an http.RoundTripper creating an OpenTelemetry span
+// for every request of a session, to be used as transport of its HttpClient:
+//
+//	otel.SetTextMapPropagator(propagation.TraceContext{})
+//	sess.HttpClient = &http.Client{Transport: tracing.NewTransport(nil)}
+//
+// Spans are named after the operation ID of the specification, e.g. getUser,
+// and end when the body of the response is closed.
+package tracing
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/addihorn/enode-gosdk/internal/rest"
+	"github.com/addihorn/enode-gosdk/pkg/endpoints"
+	"github.com/addihorn/enode-gosdk/pkg/enums/environments"
+)
+
+const (
+	// INSTRUMENTATION_NAME is the name of the tracer creating the spans.
+	INSTRUMENTATION_NAME = "github.com/addihorn/enode-gosdk/pkg/tracing"
+	// TOKEN_SPAN names the spans of requests for an access token.
+	TOKEN_SPAN = "requestToken"
+)
+
+// Attributes set in addition to the HTTP attributes of the semantic conventions.
+const (
+	OPERATION_ID_KEY = attribute.Key("enode.operation_id")
+	PROBLEM_TYPE_KEY = attribute.Key("enode.problem_type")
+)
+
+type Transport struct {
+	// Base sends the requests, http.DefaultTransport if nil.
+	Base http.RoundTripper
+	// TracerProvider creates the spans, the global provider if nil.
+	TracerProvider trace.TracerProvider
+	// Propagator injects the trace context into the requests, the global propagator if nil.
+	Propagator propagation.TextMapPropagator
+}
+
+// NewTransport returns a Transport using the global tracer provider and propagator, wrapping base.
+func NewTransport(base http.RoundTripper) *Transport {
+	return &Transport{Base: base}
+}
+
+// spanName returns the name of the span of a request and the operation it belongs to, if any.
+func spanName(req *http.Request) (string, *endpoints.Endpoint) {
+	if endpoint, ok := endpoints.ForRequest(req); ok {
+		return endpoint.OperationId, &endpoint
+	}
+	if req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, environments.TOKEN_PATH) {
+		return TOKEN_SPAN, nil
+	}
+	return "HTTP " + req.Method, nil
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	provider := t.TracerProvider
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	propagator := t.Propagator
+	if propagator == nil {
+		propagator = otel.GetTextMapPropagator()
+	}
+
+	name, endpoint := spanName(req)
+	attributes := []attribute.KeyValue{
+		attribute.String("http.request.method", req.Method),
+		attribute.String("server.address", req.URL.Hostname()),
+		attribute.String("url.full", req.URL.Redacted()),
+	}
+	if endpoint != nil {
+		attributes = append(attributes, OPERATION_ID_KEY.String(endpoint.OperationId), attribute.String("url.template", endpoint.Template))
+	}
+	ctx, span := provider.Tracer(INSTRUMENTATION_NAME).Start(req.Context(), name,
+		trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+
+	// the request of the caller must not be modified
+	req = req.Clone(ctx)
+	propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
+		return resp, err
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		problemType := rest.ProblemType(resp)
+		span.SetAttributes(attribute.String("error.type", strconv.Itoa(resp.StatusCode)))
+		if problemType != "" {
+			span.SetAttributes(PROBLEM_TYPE_KEY.String(problemType))
+		}
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	if resp.Body == nil || resp.Body == http.NoBody {
+		span.End()
+		return resp, nil
+	}
+	// spans started while the body is read, e.g. to refresh a rejected token, are its children
+	resp.Body = &body{ReadCloser: resp.Body, span: span}
+	return resp, nil
+}
+
+// body ends the span of a request when it is read to the end or closed.
+type body struct {
+	io.ReadCloser
+	span trace.Span
+	once sync.Once
+}
+
+func (b *body) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err == io.EOF {
+		b.end()
+	}
+	return n, err
+}
+
+func (b *body) Close() error {
+	err := b.ReadCloser.Close()
+	b.end()
+	return err
+}
+
+func (b *body) end() {
+	b.once.Do(func() { b.span.End() })
+}
@@ -0,0 +1,147 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/enodetest"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/tracing"
+	"github.com/addihorn/enode-gosdk/pkg/users"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+)
+
+func attribute(span sdktrace.ReadOnlySpan, key string) string {
+	for _, kv := range span.Attributes() {
+		if string(kv.Key) == key {
+			return kv.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestTransport(t *testing.T) {
+	server := enodetest.NewServer()
+	defer server.Close()
+	vehicle := server.AddVehicle("user_1", models.VehicleWithLocation{})
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	transport := &tracing.Transport{TracerProvider: provider, Propagator: propagation.TraceContext{}}
+	client := &http.Client{Transport: transport}
+
+	authentication, err := auth.New(auth.Config{
+		ClientId:     enodetest.CLIENT_ID,
+		ClientSecret: enodetest.CLIENT_SECRET,
+		Environment:  server.URL,
+		HttpClient:   client,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sess := session.NewSession(authentication)
+	sess.HttpClient = client
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	// the first request is rejected, so the token is refreshed and the request sent again
+	server.Inject(enodetest.Fault{Path: "/vehicles/", Status: http.StatusUnauthorized, Times: 1})
+	if _, err := vehicles.GetVehicle(ctx, sess, vehicle.Id); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := users.GetUserContext(ctx, sess, "missing"); err == nil {
+		t.Fatal("Expected an error for an unknown user")
+	}
+	if _, err := users.ListUsersContext(ctx, sess); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	link := &users.LinkData{LinkUserPayload: models.LinkUserPayload{
+		VendorType:  models.VENDOR_TYPE_VEHICLE,
+		Scopes:      []models.Scopes{models.SCOPES_VEHICLE_READ_DATA},
+		Language:    models.LINK_USER_PAYLOAD_LANGUAGE_EN_GB,
+		RedirectUri: "http://127.0.0.1:3000",
+	}}
+	if err := (&users.User{Id: "user_1"}).LinkContext(ctx, sess, link); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	parent.End()
+
+	spans := recorder.Ended()
+	byName := map[string][]sdktrace.ReadOnlySpan{}
+	for _, span := range spans {
+		byName[span.Name()] = append(byName[span.Name()], span)
+	}
+	if len(byName["requestToken"]) != 2 || len(byName["getVehicle"]) != 2 || len(byName["getUser"]) != 1 ||
+		len(byName["listUsers"]) != 1 || len(byName["postUsersUseridLink"]) != 1 {
+		t.Fatalf("Unexpected spans %v", byName)
+	}
+	// the hand-written users operations join the trace of the caller as well
+	for _, name := range []string{"getUser", "listUsers", "postUsersUseridLink"} {
+		if span := byName[name][0]; span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("Expected %s to be a child of the span of the caller, got parent %s", name, span.Parent().SpanID())
+		}
+	}
+
+	rejected, retried := byName["getVehicle"][0], byName["getVehicle"][1]
+	if attribute(retried, "http.response.status_code") == "401" {
+		rejected, retried = retried, rejected
+	}
+	if rejected.Parent().SpanID() != parent.SpanContext().SpanID() || retried.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Error("Expected the requests to be children of the span of the caller")
+	}
+	if attribute(retried, "url.template") != "/vehicles/{vehicleId}" || attribute(retried, "enode.operation_id") != "getVehicle" || retried.Status().Code == codes.Error {
+		t.Errorf("Unexpected attributes %v", retried.Attributes())
+	}
+	// the initial token request has no parent, the refresh is a child of the rejected request
+	refresh := byName["requestToken"][1]
+	if refresh.Parent().SpanID() != rejected.SpanContext().SpanID() {
+		t.Errorf("Expected the token refresh to be a child of the rejected request, got parent %s", refresh.Parent().SpanID())
+	}
+
+	notFound := byName["getUser"][0]
+	if notFound.Status().Code != codes.Error || attribute(notFound, "enode.problem_type") != "https://developers.enode.com/api/problems/404" {
+		t.Errorf("Expected an error status with problem type, got %v %v", notFound.Status(), notFound.Attributes())
+	}
+}
+
+func TestTransport_Propagates(t *testing.T) {
+	server := enodetest.NewServer()
+	defer server.Close()
+
+	provider := sdktrace.NewTracerProvider()
+	transport := &tracing.Transport{TracerProvider: provider, Propagator: propagation.TraceContext{}}
+	var traceparent string
+	transport.Base = roundTripper(func(req *http.Request) (*http.Response, error) {
+		traceparent = req.Header.Get("traceparent")
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	defer parent.End()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/health/ready", nil)
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if traceparent == "" || traceparent[3:35] != parent.SpanContext().TraceID().String() {
+		t.Errorf("Expected the trace ID of the caller in traceparent, got %q", traceparent)
+	}
+	if req.Header.Get("traceparent") != "" {
+		t.Error("Expected the request of the caller to be left unchanged")
+	}
+}
+
+type roundTripper func(req *http.Request) (*http.Response, error)
+
+func (f roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,366 @@
+// Package userclient scopes API calls to a single user, for request handlers
+// serving one tenant at a time:
+//
+//	client := userclient.ForUser(sess, userId)
+//	vehicles, err := client.ListVehicles(ctx, nil)
+//
+// Lists and statistics are requested for the user only. Calls addressing a
+// resource by ID first check that the resource belongs to the user and fail
+// with USER_SCOPE_ERROR otherwise, before anything is changed.
+package userclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/addihorn/enode-gosdk/pkg/batteries"
+	"github.com/addihorn/enode-gosdk/pkg/chargers"
+	"github.com/addihorn/enode-gosdk/pkg/devices"
+	"github.com/addihorn/enode-gosdk/pkg/hvacs"
+	"github.com/addihorn/enode-gosdk/pkg/inverters"
+	"github.com/addihorn/enode-gosdk/pkg/locations"
+	"github.com/addihorn/enode-gosdk/pkg/meters"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/schedules"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/statistics"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+)
+
+const (
+	USER_SCOPE_ERROR string = "userclient: the resource belongs to another user"
+)
+
+// UserClient is a view of a session restricted to the resources of one user.
+type UserClient struct {
+	sess   *session.Session
+	userId string
+}
+
+// ForUser returns a view of sess restricted to the resources of the user.
+func ForUser(sess *session.Session, userId string) *UserClient {
+	return &UserClient{sess: sess, userId: userId}
+}
+
+// UserId returns the ID of the user the client is scoped to.
+func (c *UserClient) UserId() string {
+	return c.userId
+}
+
+// check fails with USER_SCOPE_ERROR if owner is not the user of the client.
+func (c *UserClient) check(kind, id, owner string) error {
+	if owner != c.userId {
+		return errors.Join(errors.New(USER_SCOPE_ERROR), fmt.Errorf("%s %s", kind, id))
+	}
+	return nil
+}
+
+// owns checks that the device of the given type belongs to the user.
+func (c *UserClient) owns(ctx context.Context, kind, id string) error {
+	var owner string
+	switch kind {
+	case "vehicle":
+		vehicle, err := vehicles.GetVehicle(ctx, c.sess, id)
+		if err != nil {
+			return err
+		}
+		owner = vehicle.UserId
+	case "charger":
+		charger, err := chargers.GetCharger(ctx, c.sess, id)
+		if err != nil {
+			return err
+		}
+		owner = charger.UserId
+	case "hvac":
+		hvac, err := hvacs.GetHVAC(ctx, c.sess, id)
+		if err != nil {
+			return err
+		}
+		owner = hvac.UserId
+	case "battery":
+		battery, err := batteries.GetBattery(ctx, c.sess, id)
+		if err != nil {
+			return err
+		}
+		owner = battery.UserId
+	case "inverter":
+		inverter, err := inverters.GetInverter(ctx, c.sess, id)
+		if err != nil {
+			return err
+		}
+		owner = inverter.UserId
+	case "meter":
+		meter, err := meters.GetMeter(ctx, c.sess, id)
+		if err != nil {
+			return err
+		}
+		owner = meter.UserId
+	default:
+		return errors.Join(errors.New(USER_SCOPE_ERROR), fmt.Errorf("unknown device type %s", kind))
+	}
+	return c.check(kind, id, owner)
+}
+
+// ListDevices lists the devices of all types of the user, see devices.ListUserDevices.
+func (c *UserClient) ListDevices(ctx context.Context) ([]models.Device, error) {
+	return devices.ListUserDevices(ctx, c.sess, c.userId)
+}
+
+// Vehicles
+
+func (c *UserClient) ListVehicles(ctx context.Context, params *models.PaginationParams) (*models.PaginatedVehicleList, error) {
+	return vehicles.ListUserVehicles(ctx, c.sess, c.userId, params)
+}
+
+func (c *UserClient) GetVehicle(ctx context.Context, vehicleId string) (*models.VehicleWithLocation, error) {
+	vehicle, err := vehicles.GetVehicle(ctx, c.sess, vehicleId)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.check("vehicle", vehicleId, vehicle.UserId); err != nil {
+		return nil, err
+	}
+	return vehicle, nil
+}
+
+func (c *UserClient) ControlVehicleCharging(ctx context.Context, vehicleId string, payload *models.ControlChargingPayload) (*models.ChargeAction, error) {
+	if err := c.owns(ctx, "vehicle", vehicleId); err != nil {
+		return nil, err
+	}
+	return vehicles.ControlVehicleCharging(ctx, c.sess, vehicleId, payload)
+}
+
+func (c *UserClient) SetVehicleMaxCurrent(ctx context.Context, vehicleId string, payload *models.TargetMaxCurrent) (*models.MaxCurrentAction, error) {
+	if err := c.owns(ctx, "vehicle", vehicleId); err != nil {
+		return nil, err
+	}
+	return vehicles.SetVehicleMaxCurrent(ctx, c.sess, vehicleId, payload)
+}
+
+func (c *UserClient) UpdateVehicleSmartChargingPolicy(ctx context.Context, vehicleId string, payload *models.PartialVehicleSmartChargingPolicy) (*models.VehicleSmartChargingPolicy, error) {
+	if err := c.owns(ctx, "vehicle", vehicleId); err != nil {
+		return nil, err
+	}
+	return vehicles.UpdateVehicleSmartChargingPolicy(ctx, c.sess, vehicleId, payload)
+}
+
+// Chargers
+
+func (c *UserClient) ListChargers(ctx context.Context, params *models.PaginationParams) (*models.PaginatedChargerList, error) {
+	return chargers.ListUserChargers(ctx, c.sess, c.userId, params)
+}
+
+func (c *UserClient) GetCharger(ctx context.Context, chargerId string) (*models.Charger, error) {
+	charger, err := chargers.GetCharger(ctx, c.sess, chargerId)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.check("charger", chargerId, charger.UserId); err != nil {
+		return nil, err
+	}
+	return charger, nil
+}
+
+func (c *UserClient) ControlChargerCharging(ctx context.Context, chargerId string, payload *models.ControlChargingPayload) (*models.ChargeAction, error) {
+	if err := c.owns(ctx, "charger", chargerId); err != nil {
+		return nil, err
+	}
+	return chargers.ControlChargerCharging(ctx, c.sess, chargerId, payload)
+}
+
+func (c *UserClient) SetChargerMaxCurrent(ctx context.Context, chargerId string, payload *models.TargetMaxCurrent) (*models.MaxCurrentAction, error) {
+	if err := c.owns(ctx, "charger", chargerId); err != nil {
+		return nil, err
+	}
+	return chargers.SetChargerMaxCurrent(ctx, c.sess, chargerId, payload)
+}
+
+func (c *UserClient) UpdateChargerSmartPolicy(ctx context.Context, chargerId string, payload *models.PartialChargerSmartChargingPolicy) (*models.ChargerSmartChargingPolicy, error) {
+	if err := c.owns(ctx, "charger", chargerId); err != nil {
+		return nil, err
+	}
+	return chargers.UpdateChargerSmartPolicy(ctx, c.sess, chargerId, payload)
+}
+
+// HVACs
+
+func (c *UserClient) ListHVACs(ctx context.Context, params *models.PaginationParams) (*models.PaginatedHVACList, error) {
+	return hvacs.ListUserHVACs(ctx, c.sess, c.userId, params)
+}
+
+func (c *UserClient) GetHVAC(ctx context.Context, hvacId string) (*models.Hvac, error) {
+	hvac, err := hvacs.GetHVAC(ctx, c.sess, hvacId)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.check("hvac", hvacId, hvac.UserId); err != nil {
+		return nil, err
+	}
+	return hvac, nil
+}
+
+// Locations
+
+func (c *UserClient) ListLocations(ctx context.Context, params *models.PaginationParams) (*models.PaginatedLocationList, error) {
+	return locations.ListUserLocations(ctx, c.sess, c.userId, params)
+}
+
+func (c *UserClient) CreateLocation(ctx context.Context, payload *models.LocationPayload) (*models.LocationResponse, error) {
+	return locations.CreateLocation(ctx, c.sess, c.userId, payload)
+}
+
+func (c *UserClient) GetLocation(ctx context.Context, locationId string) (*models.LocationResponse, error) {
+	location, err := locations.GetLocation(ctx, c.sess, locationId)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.check("location", locationId, location.UserId); err != nil {
+		return nil, err
+	}
+	return location, nil
+}
+
+func (c *UserClient) UpdateLocation(ctx context.Context, locationId string, payload *models.LocationUpdatePayload) (*models.LocationResponse, error) {
+	if _, err := c.GetLocation(ctx, locationId); err != nil {
+		return nil, err
+	}
+	return locations.UpdateLocation(ctx, c.sess, locationId, payload)
+}
+
+func (c *UserClient) DeleteLocation(ctx context.Context, locationId string) (*models.LocationResponse, error) {
+	if _, err := c.GetLocation(ctx, locationId); err != nil {
+		return nil, err
+	}
+	return locations.DeleteLocation(ctx, c.sess, locationId)
+}
+
+// Schedules belong to the user owning their target device.
+
+// ownsLocation checks that the location of a schedule belongs to the user, if one is given.
+func (c *UserClient) ownsLocation(ctx context.Context, locationId *string) error {
+	if locationId == nil {
+		return nil
+	}
+	_, err := c.GetLocation(ctx, *locationId)
+	return err
+}
+
+func (c *UserClient) ListSchedules(ctx context.Context, params *models.PaginationParams) (*models.PaginatedScheduleList, error) {
+	return schedules.ListUserSchedules(ctx, c.sess, c.userId, params)
+}
+
+func (c *UserClient) CreateSchedule(ctx context.Context, payload *models.Schedule) (*models.ScheduleResponse, error) {
+	switch {
+	case payload.ChargeSchedule != nil:
+		if err := c.owns(ctx, string(payload.ChargeSchedule.TargetType), payload.ChargeSchedule.TargetId); err != nil {
+			return nil, err
+		}
+		if err := c.ownsLocation(ctx, payload.ChargeSchedule.LocationId); err != nil {
+			return nil, err
+		}
+	case payload.TemperatureSchedule != nil:
+		if err := c.owns(ctx, string(payload.TemperatureSchedule.TargetType), payload.TemperatureSchedule.TargetId); err != nil {
+			return nil, err
+		}
+	}
+	return schedules.CreateSchedule(ctx, c.sess, c.userId, payload)
+}
+
+func (c *UserClient) GetSchedule(ctx context.Context, scheduleId string) (*models.ScheduleResponse, error) {
+	schedule, err := schedules.GetSchedule(ctx, c.sess, scheduleId)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case schedule.ChargeScheduleResponse != nil:
+		err = c.owns(ctx, string(schedule.ChargeScheduleResponse.TargetType), schedule.ChargeScheduleResponse.TargetId)
+	case schedule.TemperatureScheduleResponse != nil:
+		err = c.owns(ctx, string(schedule.TemperatureScheduleResponse.TargetType), schedule.TemperatureScheduleResponse.TargetId)
+	default:
+		err = c.check("schedule", scheduleId, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+func (c *UserClient) GetScheduleStatus(ctx context.Context, scheduleId string) (*models.ScheduleStatus, error) {
+	if _, err := c.GetSchedule(ctx, scheduleId); err != nil {
+		return nil, err
+	}
+	return schedules.GetScheduleStatus(ctx, c.sess, scheduleId)
+}
+
+func (c *UserClient) UpdateSchedule(ctx context.Context, scheduleId string, payload *models.PartialSchedule) (*models.ScheduleResponse, error) {
+	schedule, err := c.GetSchedule(ctx, scheduleId)
+	if err != nil {
+		return nil, err
+	}
+	// the schedule must not be moved to a device or location of another user
+	switch {
+	case payload.PartialChargeSchedule != nil:
+		patch := payload.PartialChargeSchedule
+		if patch.TargetId != nil || patch.TargetType != nil {
+			// a patch may change the target ID or type alone, the other one is kept
+			var targetId, targetType string
+			if schedule.ChargeScheduleResponse != nil {
+				targetId, targetType = schedule.ChargeScheduleResponse.TargetId, string(schedule.ChargeScheduleResponse.TargetType)
+			}
+			if patch.TargetId != nil {
+				targetId = *patch.TargetId
+			}
+			if patch.TargetType != nil {
+				targetType = string(*patch.TargetType)
+			}
+			if err := c.owns(ctx, targetType, targetId); err != nil {
+				return nil, err
+			}
+		}
+		if err := c.ownsLocation(ctx, patch.LocationId); err != nil {
+			return nil, err
+		}
+	case payload.PartialTemperatureSchedule != nil && payload.PartialTemperatureSchedule.TargetId != nil:
+		if err := c.owns(ctx, "hvac", *payload.PartialTemperatureSchedule.TargetId); err != nil {
+			return nil, err
+		}
+	}
+	return schedules.UpdateSchedule(ctx, c.sess, scheduleId, payload)
+}
+
+func (c *UserClient) DeleteSchedule(ctx context.Context, scheduleId string) error {
+	if _, err := c.GetSchedule(ctx, scheduleId); err != nil {
+		return err
+	}
+	return schedules.DeleteSchedule(ctx, c.sess, scheduleId)
+}
+
+// Statistics are always requested for the user. A device given by ID must belong to it.
+
+func (c *UserClient) GetChargingStatistics(ctx context.Context, params *statistics.GetChargingStatisticsParams) (models.ChargingStatisticsTimeseries, error) {
+	if params != nil && params.Id != nil {
+		if err := c.owns(ctx, string(params.Type), *params.Id); err != nil {
+			return nil, err
+		}
+	}
+	return statistics.GetChargingStatistics(ctx, c.sess, c.userId, params)
+}
+
+func (c *UserClient) GetChargingSessionsStatistics(ctx context.Context, params *statistics.GetChargingSessionsStatisticsParams) (models.SessionsStatisticsTimeseries, error) {
+	if params != nil && params.Id != nil {
+		if err := c.owns(ctx, string(params.Type), *params.Id); err != nil {
+			return nil, err
+		}
+	}
+	return statistics.GetChargingSessionsStatistics(ctx, c.sess, c.userId, params)
+}
+
+func (c *UserClient) GetProductionStatistics(ctx context.Context, params *statistics.GetProductionStatisticsParams) (models.ProductionStatisticsTimeseries, error) {
+	if params != nil && params.Id != nil {
+		if err := c.owns(ctx, string(params.Type), *params.Id); err != nil {
+			return nil, err
+		}
+	}
+	return statistics.GetProductionStatistics(ctx, c.sess, c.userId, params)
+}
@@ -0,0 +1,124 @@
+package userclient_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/enodetest"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/userclient"
+)
+
+func isScopeError(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), userclient.USER_SCOPE_ERROR)
+}
+
+func TestUserClient(t *testing.T) {
+	server := enodetest.NewServer()
+	defer server.Close()
+	own := server.AddVehicle("user_1", models.VehicleWithLocation{})
+	server.AddCharger("user_1", models.Charger{})
+	other := server.AddVehicle("user_2", models.VehicleWithLocation{})
+	otherCharger := server.AddCharger("user_2", models.Charger{})
+
+	authentication, err := auth.NewAuthentication(enodetest.CLIENT_ID, enodetest.CLIENT_SECRET, server.URL, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	client := userclient.ForUser(session.NewSession(authentication), "user_1")
+	ctx := context.Background()
+
+	list, err := client.ListVehicles(ctx, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(list.Data) != 1 || list.Data[0].Id != own.Id {
+		t.Errorf("Expected the vehicle of the user only, got %+v", list.Data)
+	}
+	if _, err := client.GetVehicle(ctx, own.Id); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	start := &models.ControlChargingPayload{Action: models.CHARGING_ACTION_START}
+	if _, err := client.GetVehicle(ctx, other.Id); !isScopeError(err) {
+		t.Errorf("Expected a scope error for the vehicle of another user, got %v", err)
+	}
+	if _, err := client.ControlVehicleCharging(ctx, other.Id, start); !isScopeError(err) {
+		t.Errorf("Expected a scope error for the vehicle of another user, got %v", err)
+	}
+	if _, err := client.ControlChargerCharging(ctx, otherCharger.Id, start); !isScopeError(err) {
+		t.Errorf("Expected a scope error for the charger of another user, got %v", err)
+	}
+	schedule := &models.Schedule{ChargeSchedule: &models.ChargeSchedule{TargetId: other.Id, TargetType: models.CHARGEABLE_VENDOR_TYPE_VEHICLE}}
+	if _, err := client.CreateSchedule(ctx, schedule); !isScopeError(err) {
+		t.Errorf("Expected a scope error for a schedule of another user's vehicle, got %v", err)
+	}
+	for _, request := range server.Requests() {
+		if request.Method != http.MethodGet && request.Path != "/oauth2/token" {
+			t.Errorf("Expected no changes to other users, got %s %s", request.Method, request.Path)
+		}
+	}
+
+	if _, err := client.ControlVehicleCharging(ctx, own.Id, start); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestUserClient_UpdateSchedule(t *testing.T) {
+	owners := map[string]string{
+		"/vehicles/vehicle_1":   "user_1",
+		"/vehicles/vehicle_2":   "user_2",
+		"/locations/location_1": "user_1",
+		"/locations/location_2": "user_2",
+	}
+	patched := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/schedules/schedule_1" && r.Method == http.MethodPut:
+			patched++
+			fallthrough
+		case r.URL.Path == "/schedules/schedule_1":
+			fmt.Fprint(w, `{"id":"schedule_1","targetId":"vehicle_1","targetType":"vehicle","isEnabled":true,"rules":[]}`)
+		case owners[r.URL.Path] != "":
+			fmt.Fprintf(w, `{"id":"%s","userId":"%s"}`, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:], owners[r.URL.Path])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"type":"https://developers.enode.com/api/problems/not-found","title":"Not Found"}`)
+		}
+	}))
+	defer server.Close()
+
+	sess := session.NewSession(&auth.Authentication{Environment: server.URL, Access_token: "test_token"})
+	client := userclient.ForUser(sess, "user_1")
+	ctx := context.Background()
+	id := func(value string) *string { return &value }
+
+	// the patch keeps the target type of the schedule and moves it to another user's vehicle
+	_, err := client.UpdateSchedule(ctx, "schedule_1", &models.PartialSchedule{PartialChargeSchedule: &models.PartialChargeSchedule{TargetId: id("vehicle_2")}})
+	if !isScopeError(err) {
+		t.Errorf("Expected a scope error for a schedule moved to another user's vehicle, got %v", err)
+	}
+	_, err = client.UpdateSchedule(ctx, "schedule_1", &models.PartialSchedule{PartialChargeSchedule: &models.PartialChargeSchedule{LocationId: id("location_2")}})
+	if !isScopeError(err) {
+		t.Errorf("Expected a scope error for a schedule moved to another user's location, got %v", err)
+	}
+	schedule := &models.Schedule{ChargeSchedule: &models.ChargeSchedule{TargetId: "vehicle_1", TargetType: models.CHARGEABLE_VENDOR_TYPE_VEHICLE, LocationId: id("location_2")}}
+	if _, err := client.CreateSchedule(ctx, schedule); !isScopeError(err) {
+		t.Errorf("Expected a scope error for a schedule at another user's location, got %v", err)
+	}
+	if patched != 0 {
+		t.Errorf("Expected no schedule to be changed, got %d updates", patched)
+	}
+
+	_, err = client.UpdateSchedule(ctx, "schedule_1", &models.PartialSchedule{PartialChargeSchedule: &models.PartialChargeSchedule{TargetId: id("vehicle_1"), LocationId: id("location_1")}})
+	if err != nil || patched != 1 {
+		t.Errorf("Expected the schedule to be updated, got %d updates and %v", patched, err)
+	}
+}
@@ -1,10 +1,13 @@
 package users
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 
+	"github.com/addihorn/enode-gosdk/internal/rest"
 	"github.com/addihorn/enode-gosdk/pkg/session"
 )
 
@@ -23,17 +26,23 @@ Returns:
     If the request is successful, it returns nil.
 */
 func (user *User) Deauthorize(sess *session.Session) error {
-	url := fmt.Sprintf("%s/users/%s/authorization", sess.Authentication.Environment, user.Id)
+	return user.DeauthorizeContext(context.Background(), sess)
+}
 
-	req, _ := http.NewRequest("DELETE", url, nil)
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", sess.Authentication.Access_token))
+// DeauthorizeContext is Deauthorize with a context for cancellation, deadlines and tracing.
+func (user *User) DeauthorizeContext(ctx context.Context, sess *session.Session) error {
+	req, err := rest.NewRequest(ctx, sess, http.MethodDelete, fmt.Sprintf("/users/%s/authorization", url.PathEscape(user.Id)), nil, nil)
+	if err != nil {
+		return errors.Join(errors.New(REST_USER_TRANSFER_ERROR), err)
+	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := sess.Do(req)
 
 	if err != nil {
 		fmt.Println(REST_USER_TRANSFER_ERROR)
 		return errors.Join(errors.New(REST_USER_TRANSFER_ERROR), err)
 	}
+	defer resp.Body.Close()
 
 	switch resp.StatusCode {
 	default:
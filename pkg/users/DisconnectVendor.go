@@ -1,10 +1,13 @@
 package users
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 
+	"github.com/addihorn/enode-gosdk/internal/rest"
 	"github.com/addihorn/enode-gosdk/pkg/session"
 	"github.com/addihorn/enode-gosdk/pkg/vendors"
 )
@@ -23,17 +26,23 @@ Returns:
     If the request is successful, the function returns nil.
 */
 func (user *User) DisconnectVendor(sess *session.Session, vendor string) error {
-	url := fmt.Sprintf("%s/users/%s/vendors/%s", sess.Authentication.Environment, user.Id, vendor)
+	return user.DisconnectVendorContext(context.Background(), sess, vendor)
+}
 
-	req, _ := http.NewRequest("DELETE", url, nil)
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", sess.Authentication.Access_token))
+// DisconnectVendorContext is DisconnectVendor with a context for cancellation, deadlines and tracing.
+func (user *User) DisconnectVendorContext(ctx context.Context, sess *session.Session, vendor string) error {
+	req, err := rest.NewRequest(ctx, sess, http.MethodDelete, fmt.Sprintf("/users/%s/vendors/%s", url.PathEscape(user.Id), url.PathEscape(vendor)), nil, nil)
+	if err != nil {
+		return errors.Join(errors.New(REST_USER_TRANSFER_ERROR), err)
+	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := sess.Do(req)
 
 	if err != nil {
 		fmt.Println(REST_USER_TRANSFER_ERROR)
 		return errors.Join(errors.New(REST_USER_TRANSFER_ERROR), err)
 	}
+	defer resp.Body.Close()
 
 	switch resp.StatusCode {
 	default:
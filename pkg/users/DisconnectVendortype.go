@@ -1,11 +1,13 @@
 package users
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
-	"reflect"
+	"net/url"
 
+	"github.com/addihorn/enode-gosdk/internal/rest"
 	"github.com/addihorn/enode-gosdk/pkg/session"
 	"github.com/addihorn/enode-gosdk/pkg/vendors"
 )
@@ -23,20 +25,24 @@ Returns:
     If the request is successful, the function returns nil.
 */
 func (user *User) DisconnectVendortype(sess *session.Session, vendor string, venType vendors.VendorType) error {
+	return user.DisconnectVendortypeContext(context.Background(), sess, vendor, venType)
+}
 
-	fmt.Printf("type of venType: %+v\n", reflect.TypeOf(venType))
-
-	url := fmt.Sprintf("%s/users/%s/vendors/%s/%s", sess.Authentication.Environment, user.Id, vendor, venType)
-
-	req, _ := http.NewRequest("DELETE", url, nil)
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", sess.Authentication.Access_token))
+// DisconnectVendortypeContext is DisconnectVendortype with a context for cancellation, deadlines and tracing.
+func (user *User) DisconnectVendortypeContext(ctx context.Context, sess *session.Session, vendor string, venType vendors.VendorType) error {
+	path := fmt.Sprintf("/users/%s/vendors/%s/%s", url.PathEscape(user.Id), url.PathEscape(vendor), url.PathEscape(string(venType)))
+	req, err := rest.NewRequest(ctx, sess, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return errors.Join(errors.New(REST_USER_TRANSFER_ERROR), err)
+	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := sess.Do(req)
 
 	if err != nil {
 		fmt.Println(REST_USER_TRANSFER_ERROR)
 		return errors.Join(errors.New(REST_USER_TRANSFER_ERROR), err)
 	}
+	defer resp.Body.Close()
 
 	switch resp.StatusCode {
 	default:
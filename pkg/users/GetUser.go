@@ -1,12 +1,15 @@
 package users
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/addihorn/enode-gosdk/internal/rest"
 	"github.com/addihorn/enode-gosdk/pkg/session"
 )
 
@@ -23,8 +26,6 @@ func readUserByIdPayload(resp *http.Response) (*User, error) {
 		bodyPayload = resBody
 	}
 
-	fmt.Printf("%s\n", bodyPayload)
-
 	var userData *User
 	if err := json.Unmarshal(bodyPayload, &userData); err != nil {
 		return nil, errors.Join(errors.New(REST_USER_PARSE_ERROR), err)
@@ -45,27 +46,30 @@ Returns:
   - An error if any occurred during the retrieval process.
 */
 func GetUser(sess *session.Session, userId string) (*User, error) {
+	return GetUserContext(context.Background(), sess, userId)
+}
 
-	url := fmt.Sprintf("%s/users/%s", sess.Authentication.Environment, userId)
-	req, err := http.NewRequest("GET", url, nil)
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", sess.Authentication.Access_token))
+// GetUserContext is GetUser with a context for cancellation, deadlines and tracing.
+func GetUserContext(ctx context.Context, sess *session.Session, userId string) (*User, error) {
+	req, err := rest.NewRequest(ctx, sess, http.MethodGet, "/users/"+url.PathEscape(userId), nil, nil)
 
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := sess.Do(req)
 
 	if err != nil {
 		fmt.Println(REST_USER_TRANSFER_ERROR)
 		return nil, errors.Join(errors.New(REST_USER_TRANSFER_ERROR), err)
 	}
+	defer resp.Body.Close()
 
 	switch resp.StatusCode {
 	default:
 		return nil, errors.Join(fmt.Errorf(REST_USER_GENERAL_ERROR+"\n %+v", resp))
 	case http.StatusBadGateway:
-		return nil, errors.Join(errors.New(REST_USER_TRANSFER_ERROR), fmt.Errorf("Get %s: Bad Gateway", url))
+		return nil, errors.Join(errors.New(REST_USER_TRANSFER_ERROR), fmt.Errorf("Get %s: Bad Gateway", req.URL))
 	case http.StatusUnauthorized:
 		return nil, errors.Join(errors.New(REST_USER_UNAUTHORIZED_ERROR), fmt.Errorf("%+v", resp.Status))
 	case http.StatusInternalServerError:
@@ -1,6 +1,7 @@
 package users_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -232,3 +233,17 @@ func TestGetUser_UserIdNotFound(t *testing.T) {
 	}
 
 }
+
+func TestGetUserContext_Canceled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request with a canceled context")
+	}))
+	defer ts.Close()
+
+	sess := session.NewSession(&auth.Authentication{Environment: ts.URL, Access_token: "test_token"})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := users.GetUserContext(ctx, sess, "test-user-id"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the context error, got %v", err)
+	}
+}
@@ -1,13 +1,15 @@
 package users
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/addihorn/enode-gosdk/internal/rest"
 	"github.com/addihorn/enode-gosdk/pkg/session"
 )
 
@@ -27,24 +29,23 @@ Returns:
 [Link SDKs]: https://developers.enode.com/docs/link-ui#mobile-sd-ks
 */
 func (user *User) Link(sess *session.Session, data *LinkData) error {
-	url := fmt.Sprintf("%s/users/%s/link", sess.Authentication.Environment, user.Id)
-
-	requestBody, err := json.Marshal(data)
+	return user.LinkContext(context.Background(), sess, data)
+}
 
+// LinkContext is Link with a context for cancellation, deadlines and tracing.
+func (user *User) LinkContext(ctx context.Context, sess *session.Session, data *LinkData) error {
+	req, err := rest.NewRequest(ctx, sess, http.MethodPost, fmt.Sprintf("/users/%s/link", url.PathEscape(user.Id)), nil, data)
 	if err != nil {
 		return errors.Join(errors.New("users: unable to create payload for link user service"), err)
 	}
-	fmt.Printf("%s\n", requestBody)
-
-	req, _ := http.NewRequest("POST", url, bytes.NewReader(requestBody))
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", sess.Authentication.Access_token))
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := sess.Do(req)
 
 	if err != nil {
 		fmt.Println(REST_USER_TRANSFER_ERROR)
 		return errors.Join(errors.New(REST_USER_TRANSFER_ERROR), err)
 	}
+	defer resp.Body.Close()
 
 	resBody, err := io.ReadAll(resp.Body)
 	if err != nil {
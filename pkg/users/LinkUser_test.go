@@ -1,6 +1,8 @@
 package users_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -9,8 +11,10 @@ import (
 	"time"
 
 	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/models"
 	"github.com/addihorn/enode-gosdk/pkg/session"
 	"github.com/addihorn/enode-gosdk/pkg/users"
+	"github.com/addihorn/enode-gosdk/pkg/vendors"
 )
 
 func TestLinkUser_StatusOK(t *testing.T) {
@@ -186,3 +190,39 @@ func TestLinkUser_StatusForbidden(t *testing.T) {
 		t.Errorf("expected error\n%v, \ngot\n%v", expectedError, err)
 	}
 }
+
+func TestLinkUserContext_Payload(t *testing.T) {
+	var payload map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/user-1/link" || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Unexpected request %s %s", r.URL.Path, r.Header.Get("Content-Type"))
+		}
+		json.NewDecoder(r.Body).Decode(&payload)
+		fmt.Fprint(w, `{"linkUrl": "https://localhost/link-token", "linkToken": "abc"}`)
+	}))
+	defer server.Close()
+
+	sess := session.NewSession(&auth.Authentication{Environment: server.URL, Access_token: "test_token"})
+	vendor := models.VENDOR_TESLA
+	data := &users.LinkData{LinkUserPayload: models.LinkUserPayload{
+		Vendor:      &vendor,
+		VendorType:  vendors.VEHICLE,
+		Language:    models.LINK_USER_PAYLOAD_LANGUAGE_EN_GB,
+		Scopes:      []models.Scopes{models.SCOPES_VEHICLE_READ_DATA},
+		RedirectUri: "http://localhost:3000",
+	}}
+	user := &users.User{Id: "user-1"}
+	if err := user.LinkContext(context.Background(), sess, data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if payload["vendor"] != "TESLA" || payload["vendorType"] != "vehicle" || payload["language"] != "en-GB" || payload["redirectUri"] != "http://localhost:3000" {
+		t.Errorf("Unexpected payload %v", payload)
+	}
+	if _, ok := payload["colorScheme"]; ok {
+		t.Errorf("Expected no color scheme in %v", payload)
+	}
+	if data.LinkAccessData.LinkToken != "abc" {
+		t.Errorf("Expected LinkAccessData to be set, got %+v", data.LinkAccessData)
+	}
+}
@@ -0,0 +1,67 @@
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/addihorn/enode-gosdk/internal/rest"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Pages through all users, following the pagination cursors.
+
+Parameters:
+  - ctx: The context of the requests, used for cancellation and deadlines.
+  - sess: A pointer to a session.Session object containing the authentication details and environment URL.
+  - pageSize: The number of users per page, the API default if 0.
+  - fn: Called with each page of users. Paging stops at the first error returned.
+
+Returns:
+  - An error if any occurred during the requests, or the error returned by fn.
+*/
+func ListUserPages(ctx context.Context, sess *session.Session, pageSize int, fn func(page []models.UsersListEntry) error) error {
+	params := &models.PaginationParams{}
+	if pageSize > 0 {
+		params.PageSize = &pageSize
+	}
+	for {
+		req, err := rest.NewRequest(ctx, sess, http.MethodGet, "/users", params.Values(), nil)
+		if err != nil {
+			return errors.Join(errors.New(REST_USER_TRANSFER_ERROR), err)
+		}
+		resp, err := sess.Do(req)
+		if err != nil {
+			return errors.Join(errors.New(REST_USER_TRANSFER_ERROR), err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return errors.Join(errors.New(REST_USER_READ_ERROR), err)
+		}
+
+		switch resp.StatusCode {
+		case http.StatusOK:
+		case http.StatusUnauthorized:
+			return errors.Join(errors.New(REST_USER_UNAUTHORIZED_ERROR), rest.Problem(resp, body))
+		default:
+			return errors.Join(errors.New(REST_USER_GENERAL_ERROR), rest.Problem(resp, body))
+		}
+
+		var page models.PaginatedUsersListResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return errors.Join(errors.New(REST_USER_PARSE_ERROR), err)
+		}
+		if err := fn(page.Data); err != nil {
+			return err
+		}
+		if page.Pagination.After == nil || *page.Pagination.After == "" {
+			return nil
+		}
+		params.After = page.Pagination.After
+	}
+}
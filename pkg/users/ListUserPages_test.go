@@ -0,0 +1,40 @@
+package users_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/enodetest"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/users"
+)
+
+func TestListUserPages(t *testing.T) {
+	server := enodetest.NewServer()
+	defer server.Close()
+	for _, id := range []string{"user_1", "user_2", "user_3"} {
+		server.AddUser(id)
+	}
+
+	authentication, err := auth.NewAuthentication(enodetest.CLIENT_ID, enodetest.CLIENT_SECRET, server.URL, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var pages [][]string
+	err = users.ListUserPages(context.Background(), session.NewSession(authentication), 2, func(page []models.UsersListEntry) error {
+		ids := []string{}
+		for _, user := range page {
+			ids = append(ids, user.Id)
+		}
+		pages = append(pages, ids)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(pages) != 2 || len(pages[0]) != 2 || len(pages[1]) != 1 || pages[1][0] != "user_3" {
+		t.Errorf("Expected two pages of all users, got %v", pages)
+	}
+}
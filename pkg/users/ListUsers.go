@@ -1,12 +1,14 @@
 package users
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 
+	"github.com/addihorn/enode-gosdk/internal/rest"
 	"github.com/addihorn/enode-gosdk/pkg/session"
 )
 
@@ -25,8 +27,6 @@ func readUsersPayload(resp *http.Response) (map[string]*User, error) {
 		bodyPayload = resBody
 	}
 
-	fmt.Printf("%s\n", bodyPayload)
-
 	var userData Data
 	if err := json.Unmarshal(bodyPayload, &userData); err != nil {
 		return nil, errors.Join(errors.New(REST_USER_PARSE_ERROR), err)
@@ -51,21 +51,24 @@ Returns:
   - An error, or nil if the operation is successful.
 */
 func ListUsers(sess *session.Session) (map[string]*User, error) {
+	return ListUsersContext(context.Background(), sess)
+}
 
-	url := fmt.Sprintf("%s/users", sess.Authentication.Environment)
-	req, err := http.NewRequest("GET", url, nil)
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", sess.Authentication.Access_token))
+// ListUsersContext is ListUsers with a context for cancellation, deadlines and tracing.
+func ListUsersContext(ctx context.Context, sess *session.Session) (map[string]*User, error) {
+	req, err := rest.NewRequest(ctx, sess, http.MethodGet, "/users", nil, nil)
 
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := sess.Do(req)
 
 	if err != nil {
 		fmt.Println(REST_USER_TRANSFER_ERROR)
 		return nil, errors.Join(errors.New(REST_USER_TRANSFER_ERROR), err)
 	}
+	defer resp.Body.Close()
 
 	switch resp.StatusCode {
 	default:
@@ -73,7 +76,7 @@ func ListUsers(sess *session.Session) (map[string]*User, error) {
 	case http.StatusUnauthorized:
 		return nil, errors.Join(errors.New(REST_USER_UNAUTHORIZED_ERROR), fmt.Errorf("%+v", resp.Status))
 	case http.StatusBadGateway:
-		return nil, errors.Join(errors.New(REST_USER_TRANSFER_ERROR), fmt.Errorf("Get %s: Bad Gateway", url))
+		return nil, errors.Join(errors.New(REST_USER_TRANSFER_ERROR), fmt.Errorf("Get %s: Bad Gateway", req.URL))
 	case http.StatusInternalServerError:
 		return nil, errors.Join(errors.New(REST_USER_GENERAL_ERROR), fmt.Errorf("%+v", resp.Status))
 	case http.StatusOK:
@@ -1,10 +1,13 @@
 package users
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 
+	"github.com/addihorn/enode-gosdk/internal/rest"
 	"github.com/addihorn/enode-gosdk/pkg/session"
 )
 
@@ -19,17 +22,23 @@ Returns:
     If the request is successful, it returns nil.
 */
 func (user *User) Unlink(sess *session.Session) error {
-	url := fmt.Sprintf("%s/users/%s", sess.Authentication.Environment, user.Id)
+	return user.UnlinkContext(context.Background(), sess)
+}
 
-	req, _ := http.NewRequest("DELETE", url, nil)
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", sess.Authentication.Access_token))
+// UnlinkContext is Unlink with a context for cancellation, deadlines and tracing.
+func (user *User) UnlinkContext(ctx context.Context, sess *session.Session) error {
+	req, err := rest.NewRequest(ctx, sess, http.MethodDelete, "/users/"+url.PathEscape(user.Id), nil, nil)
+	if err != nil {
+		return errors.Join(errors.New(REST_USER_TRANSFER_ERROR), err)
+	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := sess.Do(req)
 
 	if err != nil {
 		fmt.Println(REST_USER_TRANSFER_ERROR)
 		return errors.Join(errors.New(REST_USER_TRANSFER_ERROR), err)
 	}
+	defer resp.Body.Close()
 
 	switch resp.StatusCode {
 	default:
@@ -3,7 +3,7 @@ package users
 import (
 	"time"
 
-	"github.com/addihorn/enode-gosdk/pkg/enums/languages"
+	"github.com/addihorn/enode-gosdk/pkg/models"
 	"github.com/addihorn/enode-gosdk/pkg/vendors"
 )
 
@@ -11,25 +11,22 @@ type Data struct {
 	Data []*User `json:"data"`
 }
 
+// User mirrors models.UserResponse, extended by the creation time of the
+// user reported by ListUsers.
 type User struct {
 	Id            string           `json:"id"`
 	CreatedAt     time.Time        `json:"createdAt"`
 	LinkedVendors []vendors.Vendor `json:"linkedVendors,omitempty"`
 }
 
-type LinkAccess struct {
-	LinkUrl   string `json:"linkUrl"`
-	LinkToken string `json:"linkToken"`
-}
+// LinkAccess holds the link URL and token of a linking session.
+type LinkAccess = models.LinkUserResponse
 
+// LinkData is the payload of Link. LinkAccessData receives the link URL and
+// token of the created linking session.
 type LinkData struct {
-	Vendor         string             `json:"vendor,omitempty"`
-	Type           vendors.VendorType `json:"vendorType"`
-	Language       languages.Language `json:"language"`
-	Scopes         []string           `json:"scopes"`
-	RedirectUri    string             `json:"redirectUri"`
-	ColorScheme    string             `json:"colorScheme,omitempty"`
-	LinkAccessData LinkAccess         `json:"-"`
+	models.LinkUserPayload
+	LinkAccessData LinkAccess `json:"-"`
 }
 
 const (
@@ -0,0 +1,37 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package vehicles
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Cancels a pending vehicle action, halting any further attempts by Enode to execute it.
+
+Note: This only updates the action's status to `CANCELLED` within Enode and does not reflect a change in the vendor's cloud. Thus any pending action in the vendor's cloud might still be executed.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - actionId: The actionId of the request.
+
+Returns:
+  - A pointer to the models.ChargeAction object returned by the API.
+  - An error if any occurred during the request.
+*/
+func CancelVehicleAction(ctx context.Context, sess *session.Session, actionId string) (*models.ChargeAction, error) {
+	path := fmt.Sprintf("/vehicles/actions/%s/cancel", url.PathEscape(actionId))
+
+	var result models.ChargeAction
+	if err := do(ctx, sess, http.MethodPost, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,38 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package vehicles
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Request for a vehicle to start or stop charging. This request creates an Action that will retry until the vehicle's `powerDeliveryState` matches the expected value. The Action must complete before any further commands are sent to the vehicle. If a `PENDING` action for the same target and type already exists, it will be reused and a new action will not be created. If the new Action has a different target or type, the existing Action will be automatically transitioned to the `CANCELLED` state and a new Action will be created. Transitions can be tracked via the `user:vendor-action:updated` webhook event or [Get Vehicle Action](/api/reference#getVehiclesAction).
+
+This endpoint returns an error with status code 422 if the vehicle is controlled by a schedule or has an active smart charging plan. To restore user control, either disable the schedule, disable smart charging, or use [Create Smart Override](/api/reference#vehicleCreateSmartOverride) to temporarily enable charging.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - vehicleId: The vehicleId of the request.
+  - payload: The request body sent to the API.
+
+Returns:
+  - A pointer to the models.ChargeAction object returned by the API.
+  - An error if any occurred during the request.
+*/
+func ControlVehicleCharging(ctx context.Context, sess *session.Session, vehicleId string, payload *models.ControlChargingPayload) (*models.ChargeAction, error) {
+	path := fmt.Sprintf("/vehicles/%s/charging", url.PathEscape(vehicleId))
+
+	var result models.ChargeAction
+	if err := do(ctx, sess, http.MethodPost, path, nil, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,52 @@
+package vehicles_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+)
+
+func TestControlVehicleCharging_Payload(t *testing.T) {
+	var bodies []string
+	var contentTypes []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		contentTypes = append(contentTypes, r.Header.Get("Content-Type"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"id":"action_1","targetId":"test_vehicle_id","targetType":"vehicle","kind":"START","state":"PENDING"}`)
+	}))
+	defer ts.Close()
+
+	sess := &session.Session{
+		Authentication: &auth.Authentication{
+			Environment:  ts.URL,
+			Access_token: "test_token",
+		},
+	}
+
+	if _, err := vehicles.ControlVehicleCharging(context.Background(), sess, "test_vehicle_id", &models.ControlChargingPayload{Action: models.CHARGING_ACTION_START}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// a nil payload is sent without body instead of null
+	if _, err := vehicles.ControlVehicleCharging(context.Background(), sess, "test_vehicle_id", nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(bodies[0]), &payload); err != nil || payload["action"] != "START" || contentTypes[0] != "application/json" {
+		t.Errorf("Unexpected payload %q with content type %q", bodies[0], contentTypes[0])
+	}
+	if bodies[1] != "" || contentTypes[1] != "" {
+		t.Errorf("Expected no body for a nil payload, got %q with content type %q", bodies[1], contentTypes[1])
+	}
+}
@@ -0,0 +1,38 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package vehicles
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Check status of current or historical Smart Charging Plans for a Vehicle. Returns a [Smart Charging](/docs/smart-charging/introduction) Plan for this vehicle.
+
+To fetch the most recently created Plan, call the endpoint with `smartChargingPlanId` set to `latest`.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - vehicleId: The vehicleId of the request.
+  - smartChargingPlanId: The smartChargingPlanId of the request.
+
+Returns:
+  - A pointer to the models.VehicleSmartChargingPlan object returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetVehicleSmartChargingPlan(ctx context.Context, sess *session.Session, vehicleId string, smartChargingPlanId string) (*models.VehicleSmartChargingPlan, error) {
+	path := fmt.Sprintf("/vehicles/%s/smart-charging-plans/%s", url.PathEscape(vehicleId), url.PathEscape(smartChargingPlanId))
+
+	var result models.VehicleSmartChargingPlan
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package vehicles
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Get a vehicle's [Smart Charging](/docs/smart-charging/introduction) policy
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - vehicleId: The vehicleId of the request.
+
+Returns:
+  - A pointer to the models.VehicleSmartChargingPolicy object returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetVehicleSmartChargingPolicy(ctx context.Context, sess *session.Session, vehicleId string) (*models.VehicleSmartChargingPolicy, error) {
+	path := fmt.Sprintf("/vehicles/%s/smart-charging-policy", url.PathEscape(vehicleId))
+
+	var result models.VehicleSmartChargingPolicy
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package vehicles
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Every vehicle in Enode has a [Smart Charging Status](/docs/smart-charging/introduction) that describes the vehicle in terms of smart charging.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - vehicleId: The vehicleId of the request.
+
+Returns:
+  - A pointer to the models.VehicleSmartChargingStatus object returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetVehicleSmartChargingStatus(ctx context.Context, sess *session.Session, vehicleId string) (*models.VehicleSmartChargingStatus, error) {
+	path := fmt.Sprintf("/vehicles/%s/smart-charging-status", url.PathEscape(vehicleId))
+
+	var result models.VehicleSmartChargingStatus
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,76 @@
+package vehicles_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+)
+
+func TestGetVehicle_Request(t *testing.T) {
+	// Create a test server that checks the request and returns a minimal vehicle
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/vehicles/test_vehicle_id" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test_token" {
+			t.Errorf("Unexpected authorization header: %s", r.Header.Get("Authorization"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"id":"test_vehicle_id","userId":"test_user_id","vendor":"TESLA","isReachable":true,"lastSeen":"2020-04-07T17:04:26Z"}`)
+	}))
+	defer ts.Close()
+
+	sess := &session.Session{
+		Authentication: &auth.Authentication{
+			Environment:  ts.URL,
+			Access_token: "test_token",
+		},
+	}
+
+	vehicle, err := vehicles.GetVehicle(context.Background(), sess, "test_vehicle_id")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if vehicle.Id != "test_vehicle_id" || vehicle.Vendor != models.VEHICLE_VENDOR_TESLA {
+		t.Errorf("Unexpected vehicle: %+v", vehicle)
+	}
+}
+
+func TestGetVehicle_NotFound(t *testing.T) {
+	// Create a test server that responds with problem details
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"type":"https://developers.enode.com/api/problems/not-found","title":"Not Found","detail":"Vehicle not found"}`)
+	}))
+	defer ts.Close()
+
+	sess := &session.Session{
+		Authentication: &auth.Authentication{
+			Environment:  ts.URL,
+			Access_token: "test_token",
+		},
+	}
+
+	_, err := vehicles.GetVehicle(context.Background(), sess, "unknown_vehicle_id")
+	if err == nil {
+		t.Fatal("Expected error, but got nil")
+	}
+
+	var problem *models.Problem
+	if !errors.As(err, &problem) || problem.Title != "Not Found" {
+		t.Errorf("Expected problem details in error, but got: %v", err)
+	}
+	expectedError := errors.Join(errors.New(vehicles.REST_VEHICLE_NOT_FOUND_ERROR), problem)
+	if err.Error() != expectedError.Error() {
+		t.Errorf("Expected error: %v, but got: %v", expectedError, err)
+	}
+}
@@ -0,0 +1,36 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package vehicles
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Paginated list of user vehicles.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - userId: The userId of the request.
+  - params: The optional query parameters of the request, may be nil.
+
+Returns:
+  - A pointer to the models.PaginatedVehicleList object returned by the API.
+  - An error if any occurred during the request.
+*/
+func ListUserVehicles(ctx context.Context, sess *session.Session, userId string, params *models.PaginationParams) (*models.PaginatedVehicleList, error) {
+	path := fmt.Sprintf("/users/%s/vehicles", url.PathEscape(userId))
+
+	var result models.PaginatedVehicleList
+	if err := do(ctx, sess, http.MethodGet, path, params.Values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,38 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package vehicles
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Experimental: Set the max current the vehicle is allowed to consume during charging. Currently only supported for Tesla vehicles and behind a feature flag. Reach out to Customer Success to get access.
+
+This request creates an Action that will retry until the vehicles's `maxCurrent` matches the expected value. The Action must complete before any further commands are sent to the vehicle. Only one Action can be active for a specific vehicle at a time. If a new Action is created, the previous Action will be automatically cancelled and transitioned to the `CANCELLED` state. Transitions can be tracked via the `user:vendor-action:updated` webhook event or [Get Vehicle Action](/api/reference#getVehiclesAction).
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - vehicleId: The vehicleId of the request.
+  - payload: The request body sent to the API.
+
+Returns:
+  - A pointer to the models.MaxCurrentAction object returned by the API.
+  - An error if any occurred during the request.
+*/
+func SetVehicleMaxCurrent(ctx context.Context, sess *session.Session, vehicleId string, payload *models.TargetMaxCurrent) (*models.MaxCurrentAction, error) {
+	path := fmt.Sprintf("/vehicles/%s/max-current", url.PathEscape(vehicleId))
+
+	var result models.MaxCurrentAction
+	if err := do(ctx, sess, http.MethodPost, path, nil, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
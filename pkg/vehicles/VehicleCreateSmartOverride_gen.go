@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package vehicles
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Overrides an active smart feature by forcing the vehicle to start charging. This feature is meant to be used in situations where the user wants to charge immediately without disabling other smart features. The override remains active until the vehicle stops charging, or until [End Smart Override](/api/reference#vehicleEndSmartOverride) is called. When the override ends, the overriden smart feature will regain control of the vehicle. This endpoint should not be used for standard charge control, use [Control Charging](/api/reference#postVehiclesVehicleidCharging) instead.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - vehicleId: The vehicleId of the request.
+
+Returns:
+  - A pointer to the models.VehicleCreateSmartOverrideResponse object returned by the API.
+  - An error if any occurred during the request.
+*/
+func VehicleCreateSmartOverride(ctx context.Context, sess *session.Session, vehicleId string) (*models.VehicleCreateSmartOverrideResponse, error) {
+	path := fmt.Sprintf("/vehicles/%s/smart-override", url.PathEscape(vehicleId))
+
+	var result models.VehicleCreateSmartOverrideResponse
+	if err := do(ctx, sess, http.MethodPost, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
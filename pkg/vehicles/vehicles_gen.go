@@ -0,0 +1,82 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+// Package vehicles wraps the endpoints of the Enode API for the vehicles of a user.
+package vehicles
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/internal/rest"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+const (
+	REST_VEHICLE_TRANSFER_ERROR      string = "vehicles: could not transfer request"
+	REST_VEHICLE_READ_ERROR          string = "vehicles: could not read response body"
+	REST_VEHICLE_PARSE_ERROR         string = "vehicles: unable to parse response data"
+	REST_VEHICLE_PAYLOAD_ERROR       string = "vehicles: unable to create request payload"
+	REST_VEHICLE_UNAUTHORIZED_ERROR  string = "vehicles: unauthorized access"
+	REST_VEHICLE_FORBIDDEN_ERROR     string = "vehicles: access to the resource is forbidden"
+	REST_VEHICLE_NOT_FOUND_ERROR     string = "vehicles: requested resource not found"
+	REST_VEHICLE_VALIDATION_ERROR    string = "vehicles: invalid request payload input"
+	REST_VEHICLE_CONFLICT_ERROR      string = "vehicles: request conflicts with the current state of the resource"
+	REST_VEHICLE_UNPROCESSABLE_ERROR string = "vehicles: request could not be processed for the target"
+	REST_VEHICLE_RATE_LIMIT_ERROR    string = "vehicles: too many requests"
+	REST_VEHICLE_GENERAL_ERROR       string = "vehicles: some kind of error occurred"
+)
+
+// do executes a request and decodes a successful response into result, if set.
+func do(ctx context.Context, sess *session.Session, method, path string, query url.Values, payload, result any) error {
+	req, err := rest.NewRequest(ctx, sess, method, path, query, payload)
+	if err != nil {
+		return errors.Join(errors.New(REST_VEHICLE_PAYLOAD_ERROR), err)
+	}
+
+	resp, err := sess.Do(req)
+	if err != nil {
+		return errors.Join(errors.New(REST_VEHICLE_TRANSFER_ERROR), err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Join(errors.New(REST_VEHICLE_READ_ERROR), err)
+	}
+
+	switch resp.StatusCode {
+	default:
+		return errors.Join(errors.New(REST_VEHICLE_GENERAL_ERROR), rest.Problem(resp, body))
+	case http.StatusBadRequest:
+		return errors.Join(errors.New(REST_VEHICLE_VALIDATION_ERROR), rest.Problem(resp, body))
+	case http.StatusUnauthorized:
+		return errors.Join(errors.New(REST_VEHICLE_UNAUTHORIZED_ERROR), rest.Problem(resp, body))
+	case http.StatusForbidden:
+		return errors.Join(errors.New(REST_VEHICLE_FORBIDDEN_ERROR), rest.Problem(resp, body))
+	case http.StatusNotFound:
+		return errors.Join(errors.New(REST_VEHICLE_NOT_FOUND_ERROR), rest.Problem(resp, body))
+	case http.StatusConflict:
+		return errors.Join(errors.New(REST_VEHICLE_CONFLICT_ERROR), rest.Problem(resp, body))
+	case http.StatusUnprocessableEntity:
+		return errors.Join(errors.New(REST_VEHICLE_UNPROCESSABLE_ERROR), rest.Problem(resp, body))
+	case http.StatusTooManyRequests:
+		return errors.Join(errors.New(REST_VEHICLE_RATE_LIMIT_ERROR), rest.Problem(resp, body))
+	case http.StatusBadGateway:
+		return errors.Join(errors.New(REST_VEHICLE_TRANSFER_ERROR), rest.Problem(resp, body))
+	case http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent:
+		if result == nil {
+			return nil
+		}
+		if len(body) == 0 {
+			return errors.Join(errors.New(REST_VEHICLE_READ_ERROR), io.EOF)
+		}
+		if err := json.Unmarshal(body, result); err != nil {
+			return errors.Join(errors.New(REST_VEHICLE_PARSE_ERROR), err)
+		}
+		return nil
+	}
+}
@@ -1,20 +1,20 @@
 package vendors
 
-type Vendor struct {
-	Vendor  string     `json:"vendor,omitempty"`
-	Type    VendorType `json:"vendorType,omitempty"`
-	IsValid bool       `json:"isValid,omitempty"`
-}
+import "github.com/addihorn/enode-gosdk/pkg/models"
 
-type VendorType string
+// Vendor is a vendor account linked by a user, as listed by users.GetUser.
+type Vendor = models.UserResponseLinkedVendor
+
+// VendorType is the type of devices provided by a vendor.
+type VendorType = models.VendorType
 
 const (
-	VEHICLE  VendorType = "vehicle"
-	CHARGER             = "charger"
-	HVAC                = "hvac"
-	INVERTER            = "inverter"
-	BATTERY             = "battery"
-	METER               = "meter"
+	VEHICLE  VendorType = models.VENDOR_TYPE_VEHICLE
+	CHARGER  VendorType = models.VENDOR_TYPE_CHARGER
+	HVAC     VendorType = models.VENDOR_TYPE_HVAC
+	INVERTER VendorType = models.VENDOR_TYPE_INVERTER
+	BATTERY  VendorType = models.VENDOR_TYPE_BATTERY
+	METER    VendorType = models.VENDOR_TYPE_METER
 )
 
 const (
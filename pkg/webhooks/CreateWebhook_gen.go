@@ -0,0 +1,33 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package webhooks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Create a webhook.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - payload: The request body sent to the API.
+
+Returns:
+  - A pointer to the models.WebhookResponse object returned by the API.
+  - An error if any occurred during the request.
+*/
+func CreateWebhook(ctx context.Context, sess *session.Session, payload *models.WebhookCreatePayload) (*models.WebhookResponse, error) {
+	path := "/webhooks"
+
+	var result models.WebhookResponse
+	if err := do(ctx, sess, http.MethodPost, path, nil, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,30 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package webhooks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Delete Firehose Webhook
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - payload: The request body sent to the API.
+
+Returns:
+  - An error if any occurred during the request. If the request is successful, it returns nil.
+
+Deprecated: DeleteFirehoseWebhook is deprecated by the Enode API.
+*/
+func DeleteFirehoseWebhook(ctx context.Context, sess *session.Session, payload *models.DeleteFirehoseWebhook) error {
+	path := "/webhooks/firehose"
+
+	return do(ctx, sess, http.MethodDelete, path, nil, payload, nil)
+}
@@ -0,0 +1,29 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Deletes webhook with the given ID, if it exists.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - webhookId: The webhookId of the request.
+
+Returns:
+  - An error if any occurred during the request. If the request is successful, it returns nil.
+*/
+func DeleteWebhook(ctx context.Context, sess *session.Session, webhookId string) error {
+	path := fmt.Sprintf("/webhooks/%s", url.PathEscape(webhookId))
+
+	return do(ctx, sess, http.MethodDelete, path, nil, nil, nil)
+}
@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Returns webhook with the given ID, if it exists.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - webhookId: The webhookId of the request.
+
+Returns:
+  - A pointer to the models.WebhookResponse object returned by the API.
+  - An error if any occurred during the request.
+*/
+func GetWebhook(ctx context.Context, sess *session.Session, webhookId string) (*models.WebhookResponse, error) {
+	path := fmt.Sprintf("/webhooks/%s", url.PathEscape(webhookId))
+
+	var result models.WebhookResponse
+	if err := do(ctx, sess, http.MethodGet, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
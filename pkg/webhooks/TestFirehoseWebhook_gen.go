@@ -0,0 +1,34 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package webhooks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Trigger a `enode:firehose:test` payload to be sent to your configured Webhook URL. This will reset your webhook to a healthy state on success.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+
+Returns:
+  - A pointer to the models.TestFirehoseWebhook object returned by the API.
+  - An error if any occurred during the request.
+
+Deprecated: TestFirehoseWebhook is deprecated by the Enode API.
+*/
+func TestFirehoseWebhook(ctx context.Context, sess *session.Session) (*models.TestFirehoseWebhook, error) {
+	path := "/webhooks/firehose/test"
+
+	var result models.TestFirehoseWebhook
+	if err := do(ctx, sess, http.MethodPost, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
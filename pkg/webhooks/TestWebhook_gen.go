@@ -0,0 +1,35 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Send a `enode:webhook:test` payload to the webhook. If the webhook is inactive, it'll be reactivated on success.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - webhookId: The webhookId of the request.
+
+Returns:
+  - A pointer to the models.TestFirehoseWebhook object returned by the API.
+  - An error if any occurred during the request.
+*/
+func TestWebhook(ctx context.Context, sess *session.Session, webhookId string) (*models.TestFirehoseWebhook, error) {
+	path := fmt.Sprintf("/webhooks/%s/test", url.PathEscape(webhookId))
+
+	var result models.TestFirehoseWebhook
+	if err := do(ctx, sess, http.MethodPost, path, nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,36 @@
+// Code generated by enode-gen from refs/openapi3_1.json. DO NOT EDIT.
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+)
+
+/*
+Update a webhook.
+
+Parameters:
+  - ctx: The context of the request, used for cancellation and deadlines.
+  - sess: A pointer to the session object containing authentication and environment details.
+  - webhookId: The webhookId of the request.
+  - payload: The request body sent to the API.
+
+Returns:
+  - A pointer to the models.WebhookResponse object returned by the API.
+  - An error if any occurred during the request.
+*/
+func UpdateWebhook(ctx context.Context, sess *session.Session, webhookId string, payload *models.WebhookUpdatePayload) (*models.WebhookResponse, error) {
+	path := fmt.Sprintf("/webhooks/%s", url.PathEscape(webhookId))
+
+	var result models.WebhookResponse
+	if err := do(ctx, sess, http.MethodPatch, path, nil, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,145 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/actions"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+)
+
+const (
+	// SIGNATURE_HEADER carries the HMAC-SHA1 of the request body, keyed with the webhook secret.
+	SIGNATURE_HEADER = "X-Enode-Signature"
+
+	WEBHOOK_SIGNATURE_ERROR string = "webhooks: invalid webhook signature"
+	WEBHOOK_PARSE_ERROR     string = "webhooks: unable to parse webhook events"
+
+	// MAX_BODY_SIZE limits the size of webhook requests accepted by a Receiver.
+	MAX_BODY_SIZE = 10 << 20
+)
+
+// ErrInvalidSignature is returned for requests whose signature does not match the secret.
+var ErrInvalidSignature = errors.New(WEBHOOK_SIGNATURE_ERROR)
+
+// Event is an event delivered to a webhook. Decode it into the model of its
+// type, e.g. models.UserVehicleDiscovered for user:vehicle:discovered.
+type Event struct {
+	Event     string                     `json:"event"`
+	Version   string                     `json:"version"`
+	CreatedAt time.Time                  `json:"createdAt"`
+	User      models.SystemHeartbeatUser `json:"user"`
+	// Raw is the event as delivered.
+	Raw json.RawMessage `json:"-"`
+}
+
+// Decode unmarshals the event into target.
+func (e *Event) Decode(target any) error {
+	if err := json.Unmarshal(e.Raw, target); err != nil {
+		return errors.Join(errors.New(WEBHOOK_PARSE_ERROR), err)
+	}
+	return nil
+}
+
+// Sign returns the value of SIGNATURE_HEADER for a webhook body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	return "sha1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the valid SIGNATURE_HEADER of body.
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(strings.TrimSpace(signature)))
+}
+
+/*
+Parses the events of a webhook request, verifying its signature.
+
+Parameters:
+  - secret: The secret of the webhook.
+  - body: The request body, a JSON array of events.
+  - signature: The value of SIGNATURE_HEADER.
+
+Returns:
+  - The events of the request.
+  - ErrInvalidSignature if the signature is invalid, or an error with WEBHOOK_PARSE_ERROR.
+*/
+func ParseEvents(secret string, body []byte, signature string) ([]*Event, error) {
+	if !Verify(secret, body, signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	var raw []json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, errors.Join(errors.New(WEBHOOK_PARSE_ERROR), err)
+	}
+	events := make([]*Event, 0, len(raw))
+	for _, message := range raw {
+		event := &Event{Raw: message}
+		if err := json.Unmarshal(message, event); err != nil {
+			return nil, errors.Join(errors.New(WEBHOOK_PARSE_ERROR), err)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// Receiver is an http.Handler receiving the events of a webhook. Requests
+// with an invalid signature are rejected with 401.
+type Receiver struct {
+	Secret string
+	// Handle is called for each event. An error responds with 500, so Enode
+	// delivers the request again later.
+	Handle func(ctx context.Context, event *Event) error
+	// Actions receives the user:vendor-action:updated events if set, resolving
+	// actions waiting for them.
+	Actions *actions.Dispatcher
+}
+
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(req.Body, MAX_BODY_SIZE))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	events, err := ParseEvents(r.Secret, body, req.Header.Get(SIGNATURE_HEADER))
+	switch {
+	case errors.Is(err, ErrInvalidSignature):
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, event := range events {
+		if r.Actions != nil && event.Event == string(models.USER_ACTION_UPDATED_EVENT_USER_VENDOR_ACTION_UPDATED) {
+			var updated models.UserActionUpdated
+			if err := event.Decode(&updated); err == nil {
+				r.Actions.Dispatch(&updated)
+			}
+		}
+		if r.Handle != nil {
+			if err := r.Handle(req.Context(), event); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,108 @@
+package webhooks_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/addihorn/enode-gosdk/pkg/actions"
+	"github.com/addihorn/enode-gosdk/pkg/auth"
+	"github.com/addihorn/enode-gosdk/pkg/enodetest"
+	"github.com/addihorn/enode-gosdk/pkg/models"
+	"github.com/addihorn/enode-gosdk/pkg/session"
+	"github.com/addihorn/enode-gosdk/pkg/vehicles"
+	"github.com/addihorn/enode-gosdk/pkg/webhooks"
+)
+
+func TestReceiver_Signature(t *testing.T) {
+	handled := 0
+	receiver := &webhooks.Receiver{Secret: "test_secret", Handle: func(ctx context.Context, event *webhooks.Event) error {
+		handled++
+		if event.Event != "enode:webhook:test" || event.User.Id != "test_user_id" {
+			t.Errorf("Unexpected event: %+v", event)
+		}
+		return nil
+	}}
+
+	body := `[{"event":"enode:webhook:test","version":"2024-01-01","createdAt":"2024-01-01T10:00:00Z","user":{"id":"test_user_id"}}]`
+	tests := []struct {
+		secret string
+		status int
+	}{
+		{"test_secret", http.StatusNoContent},
+		{"wrong_secret", http.StatusUnauthorized},
+	}
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(webhooks.SIGNATURE_HEADER, webhooks.Sign(test.secret, []byte(body)))
+		rec := httptest.NewRecorder()
+		receiver.ServeHTTP(rec, req)
+		if rec.Code != test.status {
+			t.Errorf("Expected status %d for secret %s, got %d", test.status, test.secret, rec.Code)
+		}
+	}
+	if handled != 1 {
+		t.Errorf("Expected 1 handled event, got %d", handled)
+	}
+}
+
+func TestParseEvents_Errors(t *testing.T) {
+	body := []byte(`[{"event":"enode:webhook:test"}]`)
+	if _, err := webhooks.ParseEvents("test_secret", body, webhooks.Sign("wrong_secret", body)); !errors.Is(err, webhooks.ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+
+	invalid := []byte(`{"event":"enode:webhook:test"}`)
+	_, err := webhooks.ParseEvents("test_secret", invalid, webhooks.Sign("test_secret", invalid))
+	if err == nil || errors.Is(err, webhooks.ErrInvalidSignature) || !strings.Contains(err.Error(), webhooks.WEBHOOK_PARSE_ERROR) {
+		t.Errorf("Expected a parse error, got %v", err)
+	}
+}
+
+func TestReceiver_ResolvesActions(t *testing.T) {
+	server := enodetest.NewServer()
+	defer server.Close()
+	server.ConfirmAfter = 0
+	vehicle := server.AddVehicle("user_1", models.VehicleWithLocation{})
+
+	dispatcher := actions.NewDispatcher()
+	receiver := httptest.NewServer(&webhooks.Receiver{Secret: "test_secret", Actions: dispatcher})
+	defer receiver.Close()
+
+	authentication, err := auth.NewAuthentication(enodetest.CLIENT_ID, enodetest.CLIENT_SECRET, server.URL, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sess := session.NewSession(authentication)
+	if _, err := webhooks.CreateWebhook(context.Background(), sess, &models.WebhookCreatePayload{Url: receiver.URL, Secret: "test_secret"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	created, err := vehicles.ControlVehicleCharging(context.Background(), sess, vehicle.Id,
+		&models.ControlChargingPayload{Action: models.CHARGING_ACTION_START})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	action := actions.VehicleCharging(sess, created)
+	action.Events = dispatcher
+	action.MaxInterval = time.Hour
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		server.SettleAction(created.Id, models.ACTION_STATE_CONFIRMED, nil)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	result, err := action.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.State != models.ACTION_STATE_CONFIRMED {
+		t.Errorf("Expected a confirmed action, got %s", result.State)
+	}
+}